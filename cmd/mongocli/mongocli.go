@@ -31,7 +31,7 @@ var (
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	rootCmd := root.Builder(&profile, os.Args[1:])
-	if err := rootCmd.Execute(); err != nil {
+	if err := root.Execute(rootCmd); err != nil {
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,43 @@
+// Copyright 2020 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"net/http/httptest"
+	"os"
+
+	"github.com/mongodb/mongocli/internal/fakeapi"
+)
+
+const (
+	fakePublicKey  = "e2e-public-key"
+	fakePrivateKey = "e2e-private-key"
+)
+
+// FakeAPI starts an in-memory fake of the public API for the given service
+// and returns the environment to run the binary against it offline.
+// Call the returned function to stop the server.
+func FakeAPI(service string) (srv *fakeapi.Server, env []string, stop func()) {
+	srv = fakeapi.New(fakePublicKey, fakePrivateKey)
+	ts := httptest.NewServer(srv)
+	env = append(os.Environ(),
+		"MCLI_SERVICE="+service,
+		"MCLI_OPS_MANAGER_URL="+ts.URL+"/",
+		"MCLI_PUBLIC_API_KEY="+fakePublicKey,
+		"MCLI_PRIVATE_API_KEY="+fakePrivateKey,
+		"MCLI_ORG_ID="+srv.DefaultOrgID,
+	)
+	return srv, env, ts.Close
+}
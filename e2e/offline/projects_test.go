@@ -0,0 +1,108 @@
+// Copyright 2020 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// +build e2e offline
+
+package offline_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/mongodb/mongocli/e2e"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	iamEntity        = "iam"
+	projectsEntity   = "projects"
+	opsManagerEntity = "ops-manager"
+)
+
+func TestProjects(t *testing.T) {
+	req := require.New(t)
+	a := assert.New(t)
+
+	cliPath, err := e2e.Bin()
+	req.NoError(err)
+
+	_, env, stop := e2e.FakeAPI("ops-manager")
+	defer stop()
+
+	var projectID string
+	t.Run("Create", func(t *testing.T) {
+		cmd := exec.Command(cliPath,
+			iamEntity,
+			projectsEntity,
+			"create",
+			"offline",
+			"-o=json")
+		cmd.Env = env
+		resp, err := cmd.CombinedOutput()
+		req.NoError(err, string(resp))
+
+		var project opsmngr.Project
+		req.NoError(json.Unmarshal(resp, &project), string(resp))
+		a.Equal("offline", project.Name)
+		projectID = project.ID
+	})
+
+	t.Run("List", func(t *testing.T) {
+		cmd := exec.Command(cliPath,
+			iamEntity,
+			projectsEntity,
+			"ls",
+			"-o=json")
+		cmd.Env = env
+		resp, err := cmd.CombinedOutput()
+		req.NoError(err, string(resp))
+
+		var projects opsmngr.Projects
+		req.NoError(json.Unmarshal(resp, &projects), string(resp))
+		a.Equal(1, projects.TotalCount)
+	})
+
+	t.Run("Automation Status", func(t *testing.T) {
+		cmd := exec.Command(cliPath,
+			opsManagerEntity,
+			"automation",
+			"status",
+			"--projectId",
+			projectID,
+			"-o=json")
+		cmd.Env = env
+		resp, err := cmd.CombinedOutput()
+		req.NoError(err, string(resp))
+
+		var status opsmngr.AutomationStatus
+		req.NoError(json.Unmarshal(resp, &status), string(resp))
+		a.Equal(1, status.GoalVersion)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		cmd := exec.Command(cliPath,
+			iamEntity,
+			projectsEntity,
+			"delete",
+			projectID,
+			"--force")
+		cmd.Env = env
+		resp, err := cmd.CombinedOutput()
+		req.NoError(err, string(resp))
+		a.Equal(fmt.Sprintf("Project '%s' deleted\n", projectID), string(resp))
+	})
+}
@@ -15,6 +15,7 @@
 package alerts
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -38,10 +39,12 @@ type AcknowledgeOpts struct {
 	store   store.AlertAcknowledger
 }
 
-func (opts *AcknowledgeOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *AcknowledgeOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var ackTemplate = "Alert '{{.ID}}' acknowledged until {{.AcknowledgedUntil}}\n"
@@ -84,7 +87,7 @@ func AcknowledgeBuilder() *cobra.Command {
 			}
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), ackTemplate),
 			)
 		},
@@ -15,6 +15,8 @@
 package alerts
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -31,10 +33,12 @@ type DescribeOpts struct {
 	store   store.AlertDescriber
 }
 
-func (opts *DescribeOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *DescribeOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var describeTemplate = `ID	TYPE	METRIC	STATUS
@@ -61,7 +65,7 @@ func DescribeBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), describeTemplate),
 			)
 		},
@@ -15,6 +15,8 @@
 package alerts
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -32,10 +34,12 @@ type GlobalListOpts struct {
 	store  store.GlobalAlertLister
 }
 
-func (opts *GlobalListOpts) init() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *GlobalListOpts) init(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *GlobalListOpts) Run() error {
@@ -66,7 +70,7 @@ func GlobalListBuilder() *cobra.Command {
 		Args:    require.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			opts.OutWriter = cmd.OutOrStdout()
-			return opts.init()
+			return opts.init(cmd.Context())()
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return opts.Run()
@@ -15,6 +15,8 @@
 package alerts
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -29,24 +31,38 @@ type ListOpts struct {
 	cli.GlobalOpts
 	cli.OutputOpts
 	cli.ListOpts
+	cli.AllProjectsOpts
 	status string
 	store  store.AlertLister
 }
 
-func (opts *ListOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *ListOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		s, err := store.New(config.Default(), store.WithContext(ctx))
+		opts.store, opts.ProjectStore = s, s
+		return err
+	}
 }
 
 var listTemplate = `ID	TYPE	STATUS{{range .Results}}
 {{.ID}}	{{.EventTypeName}}	{{.Status}}{{end}}
 `
 
+var listAllProjectsTemplate = `PROJECT	ID	TYPE	STATUS{{range .}}{{$project := .ProjectName}}{{range .Result.Results}}
+{{$project}}	{{.ID}}	{{.EventTypeName}}	{{.Status}}{{end}}{{end}}
+`
+
 func (opts *ListOpts) Run() error {
-	listOpts := opts.newAlertsListOptions()
-	r, err := opts.store.Alerts(opts.ConfigProjectID(), listOpts)
+	if opts.AllProjects {
+		r, err := opts.ForEachProject(opts.ConfigOrgID(), opts.list)
+		if err != nil {
+			return err
+		}
+		opts.Template = listAllProjectsTemplate
+		return opts.Print(r)
+	}
 
+	r, err := opts.list(opts.ConfigProjectID())
 	if err != nil {
 		return err
 	}
@@ -54,6 +70,10 @@ func (opts *ListOpts) Run() error {
 	return opts.Print(r)
 }
 
+func (opts *ListOpts) list(projectID string) (interface{}, error) {
+	return opts.store.Alerts(projectID, opts.newAlertsListOptions())
+}
+
 func (opts *ListOpts) newAlertsListOptions() *atlas.AlertsListOptions {
 	o := &atlas.AlertsListOptions{
 		Status:      opts.status,
@@ -73,8 +93,8 @@ func ListBuilder() *cobra.Command {
 		Args:    require.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
-				opts.ValidateProjectID,
-				opts.initStore,
+				opts.ValidateProjects(&opts.GlobalOpts),
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), listTemplate),
 			)
 		},
@@ -88,6 +108,10 @@ func ListBuilder() *cobra.Command {
 	cmd.Flags().StringVar(&opts.status, flag.Status, "", usage.Status)
 
 	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().BoolVar(&opts.AllProjects, flag.AllProjects, false, usage.AllProjects)
+	cmd.Flags().StringVar(&opts.OrgID, flag.OrgID, "", usage.OrgID)
+	cmd.Flags().StringVar(&opts.ProjectName, flag.ProjectName, "", usage.ProjectNamePattern)
+	cmd.Flags().StringVar(&opts.ProjectTag, flag.ProjectTag, "", usage.ProjectTag)
 	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
 
 	return cmd
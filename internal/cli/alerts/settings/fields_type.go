@@ -15,6 +15,8 @@
 package settings
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -29,10 +31,12 @@ type FieldsTypeOpts struct {
 	store store.MatcherFieldsLister
 }
 
-func (opts *FieldsTypeOpts) init() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *FieldsTypeOpts) init(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var matcherFieldsTemplate = "{{range .}}{{.}}\n{{end}}"
@@ -57,7 +61,7 @@ func FieldsTypeBuilder() *cobra.Command {
 		Args:    require.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			opts.OutWriter = cmd.OutOrStdout()
-			return opts.init()
+			return opts.init(cmd.Context())()
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return opts.Run()
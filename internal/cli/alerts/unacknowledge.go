@@ -15,6 +15,8 @@
 package alerts
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -33,10 +35,12 @@ type UnacknowledgeOpts struct {
 	store   store.AlertAcknowledger
 }
 
-func (opts *UnacknowledgeOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *UnacknowledgeOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var unackTemplate = "Alert '{{.ID}}' unacknowledged\n"
@@ -69,7 +73,7 @@ func UnacknowledgeBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), unackTemplate),
 			)
 		},
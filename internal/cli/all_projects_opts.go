@@ -0,0 +1,180 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	maxProjectsPerPage = 500
+	// maxConcurrentProjects bounds the requests in flight when running for every project
+	maxConcurrentProjects = 5
+)
+
+// AllProjectsOpts runs a project command for every project of an organization,
+// optionally only for the projects matching a name pattern or a tag.
+type AllProjectsOpts struct {
+	AllProjects  bool
+	ProjectName  string
+	ProjectTag   string
+	ProjectStore store.ProjectLister
+}
+
+// ProjectResult is the result of a command for one project
+type ProjectResult struct {
+	ProjectID   string      `json:"projectId"`
+	ProjectName string      `json:"projectName"`
+	Result      interface{} `json:"result"`
+}
+
+type orgProject struct {
+	id   string
+	name string
+	tags []string
+}
+
+// ValidateProjects validates the project ID or, when running for every project, the organization ID
+func (opts *AllProjectsOpts) ValidateProjects(g *GlobalOpts) func() error {
+	return func() error {
+		if !opts.AllProjects {
+			if opts.ProjectName != "" || opts.ProjectTag != "" {
+				return fmt.Errorf("--%s and --%s require --%s", flag.ProjectName, flag.ProjectTag, flag.AllProjects)
+			}
+			return g.ValidateProjectID()
+		}
+		if g.ProjectID != "" {
+			return fmt.Errorf("--%s and --%s are mutually exclusive", flag.ProjectID, flag.AllProjects)
+		}
+		if _, err := path.Match(opts.ProjectName, ""); err != nil {
+			return fmt.Errorf("invalid project name pattern %q", opts.ProjectName)
+		}
+		return g.ValidateOrgID()
+	}
+}
+
+// ForEachProject calls f for every selected project of the organization, at most maxConcurrentProjects at a time.
+// Results are in the order of the projects. The first error stops the command, the remaining projects
+// aren't started and only the calls already in flight finish.
+func (opts *AllProjectsOpts) ForEachProject(orgID string, f func(projectID string) (interface{}, error)) ([]*ProjectResult, error) {
+	projects, err := opts.projects(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*ProjectResult, len(projects))
+	var (
+		mu       sync.Mutex
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+	sem := make(chan struct{}, maxConcurrentProjects)
+	for i, p := range projects {
+		sem <- struct{}{}
+		if failed() {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(i int, p orgProject) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			r, err := f(p.id)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("project %s: %w", p.name, err)
+				}
+				mu.Unlock()
+				return
+			}
+			results[i] = &ProjectResult{ProjectID: p.id, ProjectName: p.name, Result: r}
+		}(i, p)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return results, nil
+}
+
+// projects lists the projects of the organization matching the name pattern and tag
+func (opts *AllProjectsOpts) projects(orgID string) ([]orgProject, error) {
+	var result []orgProject
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxProjectsPerPage}
+	for listOpts.PageNum = 1; ; listOpts.PageNum++ {
+		r, err := opts.ProjectStore.GetOrgProjects(orgID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		var total, count int
+		switch projects := r.(type) {
+		case *atlas.Projects:
+			if opts.ProjectTag != "" {
+				return nil, fmt.Errorf("--%s is not supported for Atlas projects", flag.ProjectTag)
+			}
+			for _, p := range projects.Results {
+				result = opts.appendMatching(result, orgProject{id: p.ID, name: p.Name})
+			}
+			total, count = projects.TotalCount, len(projects.Results)
+		case *opsmngr.Projects:
+			for _, p := range projects.Results {
+				op := orgProject{id: p.ID, name: p.Name}
+				for _, t := range p.Tags {
+					if t != nil {
+						op.tags = append(op.tags, *t)
+					}
+				}
+				result = opts.appendMatching(result, op)
+			}
+			total, count = projects.TotalCount, len(projects.Results)
+		}
+		if count < maxProjectsPerPage || listOpts.PageNum*maxProjectsPerPage >= total {
+			return result, nil
+		}
+	}
+}
+
+func (opts *AllProjectsOpts) appendMatching(projects []orgProject, p orgProject) []orgProject {
+	if opts.ProjectName != "" {
+		if ok, _ := path.Match(opts.ProjectName, p.name); !ok {
+			return projects
+		}
+	}
+	if opts.ProjectTag == "" {
+		return append(projects, p)
+	}
+	for _, t := range p.tags {
+		if t == opts.ProjectTag {
+			return append(projects, p)
+		}
+	}
+	return projects
+}
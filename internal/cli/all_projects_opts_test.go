@@ -0,0 +1,174 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package cli
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/mocks"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const testOrgID = "5a0a1e7e0f2912c554080adc"
+
+func TestAllProjectsOpts_ValidateProjects(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    AllProjectsOpts
+		global  GlobalOpts
+		wantErr bool
+	}{
+		{
+			name:   "single project",
+			global: GlobalOpts{ProjectID: "5e98249d937cfc52efdc2a9f"},
+		},
+		{
+			name:    "pattern without all projects",
+			opts:    AllProjectsOpts{ProjectName: "prod-*"},
+			global:  GlobalOpts{ProjectID: "5e98249d937cfc52efdc2a9f"},
+			wantErr: true,
+		},
+		{
+			name:    "all projects and project ID",
+			opts:    AllProjectsOpts{AllProjects: true},
+			global:  GlobalOpts{ProjectID: "5e98249d937cfc52efdc2a9f", OrgID: testOrgID},
+			wantErr: true,
+		},
+		{
+			name:    "invalid pattern",
+			opts:    AllProjectsOpts{AllProjects: true, ProjectName: "prod-["},
+			global:  GlobalOpts{OrgID: testOrgID},
+			wantErr: true,
+		},
+		{
+			name:   "all projects",
+			opts:   AllProjectsOpts{AllProjects: true, ProjectName: "prod-*"},
+			global: GlobalOpts{OrgID: testOrgID},
+		},
+	}
+	for _, tt := range tests {
+		opts := tt.opts
+		global := tt.global
+		wantErr := tt.wantErr
+		t.Run(tt.name, func(t *testing.T) {
+			if err := opts.ValidateProjects(&global)(); (err != nil) != wantErr {
+				t.Errorf("ValidateProjects() error = %v, wantErr %v", err, wantErr)
+			}
+		})
+	}
+}
+
+func TestAllProjectsOpts_ForEachProject(t *testing.T) {
+	tag := "prod"
+	other := "dev"
+	projects := &opsmngr.Projects{
+		Results: []*opsmngr.Project{
+			{ID: "1", Name: "a", Tags: []*string{&tag}},
+			{ID: "2", Name: "b", Tags: []*string{&other}},
+			{ID: "3", Name: "c", Tags: []*string{&other, &tag}},
+		},
+		TotalCount: 3,
+	}
+
+	t.Run("tag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockProjectLister(ctrl)
+		defer ctrl.Finish()
+
+		mockStore.
+			EXPECT().
+			GetOrgProjects(testOrgID, &atlas.ListOptions{PageNum: 1, ItemsPerPage: maxProjectsPerPage}).
+			Return(projects, nil).
+			Times(1)
+
+		opts := &AllProjectsOpts{AllProjects: true, ProjectTag: tag, ProjectStore: mockStore}
+		results, err := opts.ForEachProject(testOrgID, func(projectID string) (interface{}, error) {
+			return "result " + projectID, nil
+		})
+		if err != nil {
+			t.Fatalf("ForEachProject() unexpected error: %v", err)
+		}
+		if len(results) != 2 || results[0].ProjectName != "a" || results[1].ProjectName != "c" || results[1].Result != "result 3" {
+			t.Errorf("unexpected results %+v %+v", results[0], results[1])
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockProjectLister(ctrl)
+		defer ctrl.Finish()
+
+		mockStore.
+			EXPECT().
+			GetOrgProjects(testOrgID, gomock.Any()).
+			Return(projects, nil).
+			Times(1)
+
+		opts := &AllProjectsOpts{AllProjects: true, ProjectStore: mockStore}
+		_, err := opts.ForEachProject(testOrgID, func(projectID string) (interface{}, error) {
+			if projectID == "2" {
+				return nil, errors.New("forbidden")
+			}
+			return nil, nil
+		})
+		if err == nil || err.Error() != "project b: forbidden" {
+			t.Errorf("got error %v, want project b: forbidden", err)
+		}
+	})
+
+	t.Run("error stops the remaining projects", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockProjectLister(ctrl)
+		defer ctrl.Finish()
+
+		many := &opsmngr.Projects{TotalCount: 3 * maxConcurrentProjects}
+		for i := 1; i <= many.TotalCount; i++ {
+			many.Results = append(many.Results, &opsmngr.Project{ID: strconv.Itoa(i), Name: strconv.Itoa(i)})
+		}
+		mockStore.
+			EXPECT().
+			GetOrgProjects(testOrgID, gomock.Any()).
+			Return(many, nil).
+			Times(1)
+
+		// the other calls in flight only finish once the first project failed
+		release := make(chan struct{})
+		time.AfterFunc(100*time.Millisecond, func() { close(release) })
+		var calls int32
+		opts := &AllProjectsOpts{AllProjects: true, ProjectStore: mockStore}
+		_, err := opts.ForEachProject(testOrgID, func(projectID string) (interface{}, error) {
+			atomic.AddInt32(&calls, 1)
+			if projectID == "1" {
+				return nil, errors.New("forbidden")
+			}
+			<-release
+			return nil, nil
+		})
+		if err == nil || err.Error() != "project 1: forbidden" {
+			t.Errorf("got error %v, want project 1: forbidden", err)
+		}
+		if calls != maxConcurrentProjects {
+			t.Errorf("got %d calls, want only the %d already in flight", calls, maxConcurrentProjects)
+		}
+	})
+}
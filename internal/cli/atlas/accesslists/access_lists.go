@@ -32,6 +32,9 @@ func Builder() *cobra.Command {
 		ListBuilder(),
 		CreateBuilder(),
 		DeleteBuilder(),
+		ApplyBuilder(),
+		AddCurrentIPBuilder(),
+		RemoveCurrentIPBuilder(),
 	)
 
 	return cmd
@@ -0,0 +1,178 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslists
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+// maxTTL is how far in the future Atlas accepts a deleteAfterDate
+const maxTTL = 7 * 24 * time.Hour
+
+const addCurrentIPTemplate = `Current IP '{{.IPAddress}}' {{if .Changed}}added to{{else}}already in{{end}} the access list{{if .DeleteAfterDate}} until {{.DeleteAfterDate}}{{end}}.
+`
+
+// CurrentIPEntry is the access list entry of the caller's public IP
+type CurrentIPEntry struct {
+	IPAddress       string `json:"ipAddress"`
+	Comment         string `json:"comment,omitempty"`
+	DeleteAfterDate string `json:"deleteAfterDate,omitempty"`
+	Changed         bool   `json:"changed"`
+}
+
+type AddCurrentIPOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	ttl     time.Duration
+	comment string
+	now     func() time.Time
+	store   store.ProjectIPAccessListApplier
+	ipStore store.IPInfoDescriber
+}
+
+func (opts *AddCurrentIPOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		if opts.store, err = store.New(config.Default(), store.WithContext(ctx)); err != nil {
+			return err
+		}
+		opts.ipStore, err = store.NewPrivate(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *AddCurrentIPOpts) validateTTL() error {
+	if opts.ttl < 0 || opts.ttl > maxTTL {
+		return fmt.Errorf("--%s must be between 0 and %s", flag.TTL, maxTTL)
+	}
+	return nil
+}
+
+// Run adds the current IP, an entry already present is kept unless it expires before the requested TTL
+func (opts *AddCurrentIPOpts) Run() error {
+	ip, err := currentIP(opts.ipStore)
+	if err != nil {
+		return err
+	}
+	entries, err := allEntries(opts.store, opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+
+	result := &CurrentIPEntry{IPAddress: ip, Comment: opts.comment}
+	if opts.ttl > 0 {
+		result.DeleteAfterDate = opts.now().Add(opts.ttl).UTC().Format(time.RFC3339)
+	}
+	if e := findIPEntry(entries, ip); e != nil && !expiresBefore(e, result.DeleteAfterDate) {
+		result.Comment, result.DeleteAfterDate = e.Comment, e.DeleteAfterDate
+		return opts.Print(result)
+	}
+
+	_, err = opts.store.CreateProjectIPAccessList([]*atlas.ProjectIPAccessList{{
+		GroupID:         opts.ConfigProjectID(),
+		IPAddress:       ip,
+		Comment:         result.Comment,
+		DeleteAfterDate: result.DeleteAfterDate,
+	}})
+	if err != nil {
+		return err
+	}
+	result.Changed = true
+	return opts.Print(result)
+}
+
+// expiresBefore reports whether a temporary entry expires before the given date, an empty date being never.
+// A permanent entry never expires before the date while a temporary entry always does when a permanent one is requested.
+func expiresBefore(e *atlas.ProjectIPAccessList, date string) bool {
+	if e.DeleteAfterDate == "" {
+		return false
+	}
+	if date == "" {
+		return true
+	}
+	expiry, err := time.Parse(time.RFC3339, e.DeleteAfterDate)
+	if err != nil {
+		return true
+	}
+	requested, _ := time.Parse(time.RFC3339, date)
+	return expiry.Before(requested)
+}
+
+func currentIP(s store.IPInfoDescriber) (string, error) {
+	info, err := s.IPInfo()
+	if err != nil {
+		return "", err
+	}
+	if info.CurrentIPv4Address == "" {
+		return "", errors.New("couldn't find your public IP address")
+	}
+	return info.CurrentIPv4Address, nil
+}
+
+// findIPEntry returns the entry of the single address, nil when there's none
+func findIPEntry(entries []atlas.ProjectIPAccessList, ip string) *atlas.ProjectIPAccessList {
+	for i := range entries {
+		if entries[i].IPAddress == ip || entries[i].CIDRBlock == ip+"/32" {
+			return &entries[i]
+		}
+	}
+	return nil
+}
+
+// mongocli atlas accessList(s) addCurrentIp [--ttl ttl] [--comment comment] [--projectId projectId]
+func AddCurrentIPBuilder() *cobra.Command {
+	opts := &AddCurrentIPOpts{
+		now: time.Now,
+	}
+	cmd := &cobra.Command{
+		Use:   "addCurrentIp",
+		Short: "Add your public IP address to the IP access list of your project.",
+		Long: `Add your public IP address, with an expiry when --ttl is given.
+When the address is already in the access list nothing changes, unless the entry expires before the requested TTL, then it's extended.`,
+		Example: `  $ mongocli atlas accessLists addCurrentIp --ttl 4h --comment oncall --projectId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return opts.PreRunE(
+				opts.validateTTL,
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), addCurrentIPTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().DurationVar(&opts.ttl, flag.TTL, 0, usage.CurrentIPTTL)
+	cmd.Flags().StringVar(&opts.comment, flag.Comment, "", usage.Comment)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	return cmd
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package accesslists
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const currentIPAddress = "203.0.113.7"
+
+func TestAddCurrentIP_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectIPAccessListApplier(ctrl)
+	mockIPStore := mocks.NewMockIPInfoDescriber(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &AddCurrentIPOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: addCurrentIPTemplate, OutWriter: buf},
+		ttl:        4 * time.Hour,
+		comment:    "oncall",
+		now:        func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) },
+		store:      mockStore,
+		ipStore:    mockIPStore,
+	}
+
+	mockIPStore.
+		EXPECT().
+		IPInfo().
+		Return(&atlas.IPInfo{CurrentIPv4Address: currentIPAddress}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(opts.ProjectID, gomock.Any()).
+		Return(&atlas.ProjectIPAccessLists{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateProjectIPAccessList([]*atlas.ProjectIPAccessList{{
+			GroupID:         opts.ProjectID,
+			IPAddress:       currentIPAddress,
+			Comment:         "oncall",
+			DeleteAfterDate: "2021-05-01T04:00:00Z",
+		}}).
+		Return(&atlas.ProjectIPAccessLists{}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "Current IP '203.0.113.7' added to the access list until 2021-05-01T04:00:00Z.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestAddCurrentIP_RunAlreadyPresent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectIPAccessListApplier(ctrl)
+	mockIPStore := mocks.NewMockIPInfoDescriber(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &AddCurrentIPOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: addCurrentIPTemplate, OutWriter: buf},
+		ttl:        4 * time.Hour,
+		comment:    "oncall",
+		now:        func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) },
+		store:      mockStore,
+		ipStore:    mockIPStore,
+	}
+
+	mockIPStore.
+		EXPECT().
+		IPInfo().
+		Return(&atlas.IPInfo{CurrentIPv4Address: currentIPAddress}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(opts.ProjectID, gomock.Any()).
+		Return(&atlas.ProjectIPAccessLists{Results: []atlas.ProjectIPAccessList{{CIDRBlock: currentIPAddress + "/32", IPAddress: currentIPAddress, Comment: "home"}}, TotalCount: 1}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "Current IP '203.0.113.7' already in the access list.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestAddCurrentIP_RunExtends(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectIPAccessListApplier(ctrl)
+	mockIPStore := mocks.NewMockIPInfoDescriber(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &AddCurrentIPOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: addCurrentIPTemplate, OutWriter: buf},
+		ttl:        4 * time.Hour,
+		comment:    "oncall",
+		now:        func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) },
+		store:      mockStore,
+		ipStore:    mockIPStore,
+	}
+
+	mockIPStore.
+		EXPECT().
+		IPInfo().
+		Return(&atlas.IPInfo{CurrentIPv4Address: currentIPAddress}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(opts.ProjectID, gomock.Any()).
+		Return(&atlas.ProjectIPAccessLists{Results: []atlas.ProjectIPAccessList{{CIDRBlock: currentIPAddress + "/32", IPAddress: currentIPAddress, DeleteAfterDate: "2021-05-01T01:00:00Z"}}, TotalCount: 1}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateProjectIPAccessList(gomock.Any()).
+		Return(&atlas.ProjectIPAccessLists{}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+}
+
+func TestAddCurrentIP_RunMakesPermanent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectIPAccessListApplier(ctrl)
+	mockIPStore := mocks.NewMockIPInfoDescriber(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &AddCurrentIPOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: addCurrentIPTemplate, OutWriter: buf},
+		comment:    "oncall",
+		now:        func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) },
+		store:      mockStore,
+		ipStore:    mockIPStore,
+	}
+
+	mockIPStore.
+		EXPECT().
+		IPInfo().
+		Return(&atlas.IPInfo{CurrentIPv4Address: currentIPAddress}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(opts.ProjectID, gomock.Any()).
+		Return(&atlas.ProjectIPAccessLists{Results: []atlas.ProjectIPAccessList{{CIDRBlock: currentIPAddress + "/32", IPAddress: currentIPAddress, DeleteAfterDate: "2021-05-01T01:00:00Z"}}, TotalCount: 1}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateProjectIPAccessList([]*atlas.ProjectIPAccessList{{
+			GroupID:   opts.ProjectID,
+			IPAddress: currentIPAddress,
+			Comment:   "oncall",
+		}}).
+		Return(&atlas.ProjectIPAccessLists{}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+}
+
+func TestAddCurrentIPBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		AddCurrentIPBuilder(),
+		0,
+		[]string{flag.TTL, flag.Comment, flag.ProjectID, flag.Output},
+	)
+}
@@ -0,0 +1,258 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslists
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/convert"
+	"github.com/mongodb/mongocli/internal/file"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	createAction = "create"
+	updateAction = "update"
+	deleteAction = "delete"
+
+	maxItemsPerPage  = 500
+	maxEntriesPerReq = 100
+)
+
+var applyTemplate = `ACTION	ENTRY	COMMENT	DELETE AFTER{{range .}}
+{{.Action}}	{{.Entry}}	{{.Comment}}	{{.DeleteAfterDate}}{{end}}
+`
+
+// EntryChange is an entry the file creates, updates or deletes
+type EntryChange struct {
+	Action          string `json:"action"`
+	Entry           string `json:"entry"`
+	Comment         string `json:"comment,omitempty"`
+	DeleteAfterDate string `json:"deleteAfterDate,omitempty"`
+}
+
+type ApplyOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	cli.PlanOpts
+	filename string
+	prune    bool
+	now      func() time.Time
+	fs       afero.Fs
+	store    store.ProjectIPAccessListApplier
+}
+
+func (opts *ApplyOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ApplyOpts) Run() error {
+	accessList := new(convert.AccessListConfig)
+	if err := file.Load(opts.fs, opts.filename, accessList); err != nil {
+		return err
+	}
+	if err := accessList.Validate(); err != nil {
+		return err
+	}
+
+	current, err := allEntries(opts.store, opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+	changes, upserts, deletes := opts.plan(accessList, current)
+	if err := opts.Print(changes); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Apply %d changes to the IP access list?", len(changes))
+	if ok, err := opts.Proceed(len(changes), message, "IP access list not applied"); err != nil || !ok {
+		return err
+	}
+
+	// temporary entries made permanent are deleted before they're created again
+	for _, entry := range deletes {
+		if err := opts.store.DeleteProjectIPAccessList(opts.ConfigProjectID(), entry); err != nil {
+			return fmt.Errorf("delete %s: %w", entry, err)
+		}
+	}
+	for start := 0; start < len(upserts); start += maxEntriesPerReq {
+		end := start + maxEntriesPerReq
+		if end > len(upserts) {
+			end = len(upserts)
+		}
+		if _, err := opts.store.CreateProjectIPAccessList(upserts[start:end]); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(opts.Progress, "IP access list applied, %d entries changed.\n", len(changes))
+	return err
+}
+
+// allEntries lists every entry of the project
+func allEntries(s store.ProjectIPAccessListLister, projectID string) ([]atlas.ProjectIPAccessList, error) {
+	var entries []atlas.ProjectIPAccessList
+	for page := 1; ; page++ {
+		r, err := s.ProjectIPAccessLists(projectID, &atlas.ListOptions{PageNum: page, ItemsPerPage: maxItemsPerPage})
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, r.Results...)
+		if len(r.Results) < maxItemsPerPage || len(entries) >= r.TotalCount {
+			return entries, nil
+		}
+	}
+}
+
+// plan creates the missing entries and updates the ones with another comment or an expiry due for renewal,
+// Atlas updates existing entries on creation so both are sent together.
+// Creating an entry doesn't clear its expiry, so temporary entries the file makes permanent are deleted and created again.
+// It returns the changes, the entries to send and the entries to delete.
+func (opts *ApplyOpts) plan(desired *convert.AccessListConfig, current []atlas.ProjectIPAccessList) (changes []*EntryChange, upserts []*atlas.ProjectIPAccessList, deletes []string) {
+	changes = []*EntryChange{}
+	existing := map[string]*atlas.ProjectIPAccessList{}
+	for i := range current {
+		e := &current[i]
+		if e.AwsSecurityGroup != "" {
+			existing[e.AwsSecurityGroup] = e
+		} else {
+			existing[e.CIDRBlock] = e
+		}
+	}
+
+	now := opts.now()
+	wanted := map[string]bool{}
+	for _, e := range desired.Entries {
+		key := e.Key()
+		wanted[key] = true
+		entry := e.NewProjectIPAccessList(opts.ConfigProjectID(), now)
+		var renew bool
+		if e.DeleteAfter != "" {
+			d, _ := time.ParseDuration(e.DeleteAfter)
+			renew = dueForRenewal(existing[key], now, d)
+		}
+
+		action := ""
+		switch c, ok := existing[key]; {
+		case !ok:
+			action = createAction
+		case e.DeleteAfter == "" && c.DeleteAfterDate != "":
+			action = updateAction
+			deletes = append(deletes, deleteEntry(c))
+		case c.Comment != e.Comment || renew:
+			action = updateAction
+		default:
+			continue
+		}
+		changes = append(changes, &EntryChange{Action: action, Entry: key, Comment: e.Comment, DeleteAfterDate: entry.DeleteAfterDate})
+		upserts = append(upserts, entry)
+	}
+
+	if !opts.prune {
+		return changes, upserts, deletes
+	}
+	for i := range current {
+		c := &current[i]
+		key := c.CIDRBlock
+		if c.AwsSecurityGroup != "" {
+			key = c.AwsSecurityGroup
+		}
+		if wanted[key] {
+			continue
+		}
+		changes = append(changes, &EntryChange{Action: deleteAction, Entry: key, Comment: c.Comment, DeleteAfterDate: c.DeleteAfterDate})
+		deletes = append(deletes, deleteEntry(c))
+	}
+	return changes, upserts, deletes
+}
+
+// deleteEntry returns the value to delete an existing entry by, single addresses are deleted by address
+func deleteEntry(e *atlas.ProjectIPAccessList) string {
+	switch {
+	case e.IPAddress != "":
+		return e.IPAddress
+	case e.AwsSecurityGroup != "":
+		return e.AwsSecurityGroup
+	}
+	return e.CIDRBlock
+}
+
+// dueForRenewal reports whether an existing entry has no expiry or expires within half of its duration,
+// so applying the same file again doesn't update every temporary entry.
+func dueForRenewal(e *atlas.ProjectIPAccessList, now time.Time, d time.Duration) bool {
+	if e == nil {
+		return false
+	}
+	expiry, err := time.Parse(time.RFC3339, e.DeleteAfterDate)
+	if err != nil {
+		return true
+	}
+	return expiry.Before(now.Add(d / 2))
+}
+
+// mongocli atlas accessList(s) apply --file entries.yaml [--prune] [--dryRun] [--force] [--projectId projectId]
+func ApplyBuilder() *cobra.Command {
+	opts := &ApplyOpts{
+		now: time.Now,
+		fs:  afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create, update and delete the IP access list entries of your project from a file.",
+		Long: `The file lists entries with a cidrBlock, an ipAddress or an awsSecurityGroup, an optional comment and an optional deleteAfter duration, like 720h.
+Missing entries are created and entries with another comment are updated, entries with a deleteAfter duration are renewed
+when they have no expiry or expire within half of their duration. Temporary entries listed without a deleteAfter duration
+are deleted and created again as permanent entries. Entries not in the file are only deleted with --prune.
+The changes are printed and confirmed before they are applied.`,
+		Example: `  $ mongocli atlas accessLists apply --file entries.yaml --prune --projectId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.Progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), applyTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.AccessListFile)
+	cmd.Flags().BoolVar(&opts.prune, flag.Prune, false, usage.AccessListPrune)
+	cmd.Flags().BoolVar(&opts.DryRun, flag.DryRun, false, usage.ApplyDryRun)
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.File)
+	_ = cmd.MarkFlagFilename(flag.File)
+
+	return cmd
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package accesslists
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/spf13/afero"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const entriesFile = `entries:
+  - cidrBlock: 192.168.1.0/24
+    comment: office
+  - ipAddress: 10.0.0.1
+    comment: vpn
+    deleteAfter: 720h
+  - awsSecurityGroup: sg-1234
+`
+
+func currentEntries() *atlas.ProjectIPAccessLists {
+	return &atlas.ProjectIPAccessLists{
+		Results: []atlas.ProjectIPAccessList{
+			{CIDRBlock: "192.168.1.0/24", Comment: "office"},
+			{CIDRBlock: "10.0.0.1/32", IPAddress: "10.0.0.1", Comment: "vpn", DeleteAfterDate: "2021-05-03T00:00:00Z"},
+			{CIDRBlock: "172.16.0.1/32", IPAddress: "172.16.0.1", Comment: "old office"},
+		},
+		TotalCount: 3,
+	}
+}
+
+func TestApply_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectIPAccessListApplier(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "entries.yaml", []byte(entriesFile), 0600)
+	opts := &ApplyOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: buf},
+		PlanOpts:   cli.PlanOpts{Confirm: true, Progress: new(bytes.Buffer)},
+		filename:   "entries.yaml",
+		prune:      true,
+		now:        func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) },
+		fs:         appFS,
+		store:      mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(opts.ProjectID, &atlas.ListOptions{PageNum: 1, ItemsPerPage: maxItemsPerPage}).
+		Return(currentEntries(), nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateProjectIPAccessList([]*atlas.ProjectIPAccessList{
+			{GroupID: opts.ProjectID, IPAddress: "10.0.0.1", Comment: "vpn", DeleteAfterDate: "2021-05-31T00:00:00Z"},
+			{GroupID: opts.ProjectID, AwsSecurityGroup: "sg-1234"},
+		}).
+		Return(&atlas.ProjectIPAccessLists{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DeleteProjectIPAccessList(opts.ProjectID, "172.16.0.1").
+		Return(nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `ACTION   ENTRY           COMMENT      DELETE AFTER
+update   10.0.0.1/32     vpn          2021-05-31T00:00:00Z
+create   sg-1234                      
+delete   172.16.0.1/32   old office   
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestApply_RunWithoutPrune(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectIPAccessListApplier(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "entries.yaml", []byte(entriesFile), 0600)
+	opts := &ApplyOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: buf},
+		PlanOpts:   cli.PlanOpts{DryRun: true, Progress: new(bytes.Buffer)},
+		filename:   "entries.yaml",
+		now:        func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) },
+		fs:         appFS,
+		store:      mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(opts.ProjectID, gomock.Any()).
+		Return(currentEntries(), nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `ACTION   ENTRY         COMMENT   DELETE AFTER
+update   10.0.0.1/32   vpn       2021-05-31T00:00:00Z
+create   sg-1234                 
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestApply_RunPermanentEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectIPAccessListApplier(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "entries.yaml", []byte("entries:\n  - ipAddress: 10.0.0.1\n    comment: vpn\n"), 0600)
+	opts := &ApplyOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: buf},
+		PlanOpts:   cli.PlanOpts{Confirm: true, Progress: new(bytes.Buffer)},
+		filename:   "entries.yaml",
+		now:        func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) },
+		fs:         appFS,
+		store:      mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(opts.ProjectID, gomock.Any()).
+		Return(&atlas.ProjectIPAccessLists{
+			Results: []atlas.ProjectIPAccessList{
+				{CIDRBlock: "10.0.0.1/32", IPAddress: "10.0.0.1", Comment: "vpn", DeleteAfterDate: "2021-05-03T00:00:00Z"},
+			},
+			TotalCount: 1,
+		}, nil).
+		Times(1)
+	gomock.InOrder(
+		mockStore.
+			EXPECT().
+			DeleteProjectIPAccessList(opts.ProjectID, "10.0.0.1").
+			Return(nil).
+			Times(1),
+		mockStore.
+			EXPECT().
+			CreateProjectIPAccessList([]*atlas.ProjectIPAccessList{
+				{GroupID: opts.ProjectID, IPAddress: "10.0.0.1", Comment: "vpn"},
+			}).
+			Return(&atlas.ProjectIPAccessLists{}, nil).
+			Times(1),
+	)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `ACTION   ENTRY         COMMENT   DELETE AFTER
+update   10.0.0.1/32   vpn       
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestApplyBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ApplyBuilder(),
+		0,
+		[]string{flag.File, flag.Prune, flag.DryRun, flag.Force, flag.ProjectID, flag.Output},
+	)
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslists
+
+import (
+	"context"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+)
+
+const removeCurrentIPTemplate = `Current IP '{{.IPAddress}}' {{if .Changed}}removed from{{else}}isn't in{{end}} the access list.
+`
+
+type RemoveCurrentIPOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	store   store.ProjectIPAccessListApplier
+	ipStore store.IPInfoDescriber
+}
+
+func (opts *RemoveCurrentIPOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		if opts.store, err = store.New(config.Default(), store.WithContext(ctx)); err != nil {
+			return err
+		}
+		opts.ipStore, err = store.NewPrivate(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *RemoveCurrentIPOpts) Run() error {
+	ip, err := currentIP(opts.ipStore)
+	if err != nil {
+		return err
+	}
+	entries, err := allEntries(opts.store, opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+
+	result := &CurrentIPEntry{IPAddress: ip}
+	e := findIPEntry(entries, ip)
+	if e == nil {
+		return opts.Print(result)
+	}
+	if err := opts.store.DeleteProjectIPAccessList(opts.ConfigProjectID(), ip); err != nil {
+		return err
+	}
+	result.Comment, result.DeleteAfterDate, result.Changed = e.Comment, e.DeleteAfterDate, true
+	return opts.Print(result)
+}
+
+// mongocli atlas accessList(s) removeCurrentIp [--projectId projectId]
+func RemoveCurrentIPBuilder() *cobra.Command {
+	opts := &RemoveCurrentIPOpts{}
+	cmd := &cobra.Command{
+		Use:     "removeCurrentIp",
+		Short:   "Remove your public IP address from the IP access list of your project.",
+		Example: `  $ mongocli atlas accessLists removeCurrentIp --projectId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), removeCurrentIPTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package accesslists
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestRemoveCurrentIP_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectIPAccessListApplier(ctrl)
+	mockIPStore := mocks.NewMockIPInfoDescriber(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &RemoveCurrentIPOpts{
+		OutputOpts: cli.OutputOpts{Template: removeCurrentIPTemplate, OutWriter: buf},
+		store:      mockStore,
+		ipStore:    mockIPStore,
+	}
+	opts.ProjectID = "5a0a1e7e0f2912c554080adc"
+
+	mockIPStore.
+		EXPECT().
+		IPInfo().
+		Return(&atlas.IPInfo{CurrentIPv4Address: currentIPAddress}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(opts.ProjectID, gomock.Any()).
+		Return(&atlas.ProjectIPAccessLists{Results: []atlas.ProjectIPAccessList{{CIDRBlock: currentIPAddress + "/32", IPAddress: currentIPAddress}}, TotalCount: 1}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DeleteProjectIPAccessList(opts.ProjectID, currentIPAddress).
+		Return(nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "Current IP '203.0.113.7' removed from the access list.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRemoveCurrentIPBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		RemoveCurrentIPBuilder(),
+		0,
+		[]string{flag.ProjectID, flag.Output},
+	)
+}
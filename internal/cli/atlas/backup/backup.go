@@ -17,6 +17,7 @@ package backup
 import (
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/atlas/backup/snapshots"
+	"github.com/mongodb/mongocli/internal/cli/backupreport"
 	"github.com/spf13/cobra"
 )
 
@@ -31,6 +32,7 @@ func Builder() *cobra.Command {
 	cmd.AddCommand(
 		snapshots.Builder(),
 		RestoresBuilder(),
+		backupreport.Builder(),
 	)
 
 	return cmd
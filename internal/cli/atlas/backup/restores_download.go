@@ -0,0 +1,215 @@
+// Copyright 2020 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"crypto/md5" //nolint:gosec // only used to compare against the checksum reported by the server
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+const (
+	maxDownloadAttempts = 5
+	partSuffix          = ".part"
+)
+
+// downloadArchives saves the archives of a download restore,
+// a sharded cluster has an archive per shard so --out must be a directory.
+func (opts *RestoresStartOpts) downloadArchives(jobID string, urls []string) error {
+	if len(urls) == 0 {
+		return errors.New("the restore job has no archive to download")
+	}
+	isDir, err := opts.outIsDir()
+	if err != nil {
+		return err
+	}
+	if len(urls) > 1 && !isDir {
+		if err := opts.Fs.MkdirAll(opts.Out, 0755); err != nil {
+			return err
+		}
+		isDir = true
+	}
+	for _, u := range urls {
+		dest := opts.Out
+		if isDir {
+			name, err := archiveName(u)
+			if err != nil {
+				return err
+			}
+			dest = filepath.Join(opts.Out, name)
+		}
+		if err := opts.downloadArchive(u, dest, partName(dest, jobID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (opts *RestoresStartOpts) outIsDir() (bool, error) {
+	fi, err := opts.Fs.Stat(opts.Out)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return fi.IsDir(), nil
+}
+
+func archiveName(u string) (string, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "", err
+	}
+	name := path.Base(parsed.Path)
+	if name == "/" || name == "." {
+		return "", fmt.Errorf("can't find the archive name of %s", parsed.Host)
+	}
+	return name, nil
+}
+
+// partName is where the archive of a restore job is downloaded to, a part file
+// left by another job is never resumed as its archive is a different one.
+func partName(dest, jobID string) string {
+	return dest + "." + jobID + partSuffix
+}
+
+// downloadArchive streams the archive to part, resuming from what's already there,
+// and moves it to dest once the download is complete and its checksum verified.
+func (opts *RestoresStartOpts) downloadArchive(u, dest, part string) error {
+	if _, err := opts.Fs.Stat(dest); err == nil && !opts.Force {
+		return fmt.Errorf("%s already exists, use --force to overwrite it", dest)
+	}
+	expectedMD5 := ""
+	size := int64(-1)
+	for attempt := 1; ; attempt++ {
+		partMD5, partSize, err := opts.downloadPart(u, dest, part, size)
+		// a resumed download may not report the checksum or the size again
+		if expectedMD5 == "" {
+			expectedMD5 = partMD5
+		}
+		if size < 0 {
+			size = partSize
+		}
+		if err == nil {
+			break
+		}
+		if attempt == maxDownloadAttempts {
+			return err
+		}
+		opts.printProgress("Download of %s interrupted, resuming: %v\n", dest, err)
+	}
+
+	md5Sum, sha256Sum, written, err := opts.checksums(part)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && written != size {
+		return fmt.Errorf("downloaded %d bytes of %s, expected %d", written, dest, size)
+	}
+	if expectedMD5 != "" && md5Sum != expectedMD5 {
+		_ = opts.Fs.Remove(part)
+		return fmt.Errorf("checksum mismatch for %s: got md5 %s, expected %s", dest, md5Sum, expectedMD5)
+	}
+	if err := opts.Fs.Rename(part, dest); err != nil {
+		return err
+	}
+	opts.printProgress("Download of %s completed, sha256 %s\n", dest, sha256Sum)
+	return nil
+}
+
+// downloadPart appends the rest of the archive to part, it returns the checksum and size
+// the server reported for the whole archive, if any, size is the one already known.
+func (opts *RestoresStartOpts) downloadPart(u, dest, part string, size int64) (string, int64, error) {
+	var offset int64
+	if fi, err := opts.Fs.Stat(part); err == nil {
+		offset = fi.Size()
+	}
+	archive, err := opts.store.DownloadRestoreArchive(u, offset)
+	if err != nil {
+		return "", -1, err
+	}
+	defer archive.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if archive.Offset == 0 {
+		// the server sent the whole archive
+		flags |= os.O_TRUNC
+	}
+	f, err := opts.Fs.OpenFile(part, flags, 0600)
+	if err != nil {
+		return "", -1, err
+	}
+	total := archive.Size
+	if total < 0 {
+		total = size
+	}
+	p := &progressWriter{opts: opts, name: dest, written: archive.Offset, total: total}
+	_, err = io.Copy(f, io.TeeReader(archive, p))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return archive.MD5, archive.Size, err
+}
+
+func (opts *RestoresStartOpts) checksums(name string) (md5Sum, sha256Sum string, size int64, err error) {
+	f, err := opts.Fs.Open(name)
+	if err != nil {
+		return "", "", 0, err
+	}
+	defer f.Close()
+	m := md5.New() //nolint:gosec // only used to compare against the checksum reported by the server
+	s := sha256.New()
+	if size, err = io.Copy(io.MultiWriter(m, s), f); err != nil {
+		return "", "", 0, err
+	}
+	return hex.EncodeToString(m.Sum(nil)), hex.EncodeToString(s.Sum(nil)), size, nil
+}
+
+func (opts *RestoresStartOpts) printProgress(format string, a ...interface{}) {
+	if opts.progress != nil {
+		_, _ = fmt.Fprintf(opts.progress, format, a...)
+	}
+}
+
+// progressWriter reports every 10% of an archive downloaded.
+type progressWriter struct {
+	opts     *RestoresStartOpts
+	name     string
+	written  int64
+	total    int64
+	reported int64
+}
+
+const progressStep = 10
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.written += int64(len(b))
+	if p.total <= 0 {
+		return len(b), nil
+	}
+	if pct := p.written * 100 / p.total; pct >= p.reported+progressStep {
+		p.reported = pct - pct%progressStep
+		p.opts.printProgress("Downloaded %d%% of %s (%d of %d bytes)\n", p.reported, p.name, p.written, p.total)
+	}
+	return len(b), nil
+}
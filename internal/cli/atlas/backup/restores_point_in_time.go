@@ -0,0 +1,126 @@
+// Copyright 2020 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	completedSnapshot = "completed"
+	hoursPerDay       = 24
+	maxItemsPerPage   = 500
+)
+
+// parseAt parses an RFC3339 time or a duration relative to now, like -30m.
+func parseAt(at string, now time.Time) (time.Time, error) {
+	if strings.HasPrefix(at, "-") {
+		d, err := time.ParseDuration(at)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid relative time %s: %w", at, err)
+		}
+		return now.Add(d), nil
+	}
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %s, use RFC3339 like 2021-04-01T10:15:00Z or a relative time like -30m", at)
+	}
+	return t, nil
+}
+
+// oldestCompletedSnapshot returns when the oldest completed snapshot was taken, going through every page of snapshots.
+func (opts *RestoresStartOpts) oldestCompletedSnapshot() (time.Time, error) {
+	var oldest time.Time
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxItemsPerPage}
+	for listOpts.PageNum = 1; ; listOpts.PageNum++ {
+		r, err := opts.store.Snapshots(opts.ConfigProjectID(), opts.clusterName, listOpts)
+		if err != nil {
+			return oldest, err
+		}
+		for _, s := range r.Results {
+			if s.Status != completedSnapshot {
+				continue
+			}
+			createdAt, err := time.Parse(time.RFC3339, s.CreatedAt)
+			if err != nil {
+				continue
+			}
+			if oldest.IsZero() || createdAt.Before(oldest) {
+				oldest = createdAt
+			}
+		}
+		if len(r.Results) < maxItemsPerPage || listOpts.PageNum*maxItemsPerPage >= r.TotalCount {
+			return oldest, nil
+		}
+	}
+}
+
+// restorableWindow returns the window continuous cloud backup can restore to,
+// it starts at the oldest completed snapshot within the restore window days of the backup policy.
+func (opts *RestoresStartOpts) restorableWindow(now time.Time) (start, end time.Time, err error) {
+	cluster, err := opts.store.AtlasCluster(opts.ConfigProjectID(), opts.clusterName)
+	if err != nil {
+		return start, end, err
+	}
+	if cluster.PitEnabled == nil || !*cluster.PitEnabled {
+		return start, end, fmt.Errorf("continuous cloud backup is not enabled for cluster %s", opts.clusterName)
+	}
+
+	policy, err := opts.store.BackupPolicy(opts.ConfigProjectID(), opts.clusterName)
+	if err != nil {
+		return start, end, err
+	}
+	if policy.RestoreWindowDays == nil {
+		return start, end, fmt.Errorf("the backup policy of cluster %s has no restore window", opts.clusterName)
+	}
+	start = now.Add(-time.Duration(*policy.RestoreWindowDays) * hoursPerDay * time.Hour)
+
+	oldest, err := opts.oldestCompletedSnapshot()
+	if err != nil {
+		return start, end, err
+	}
+	if oldest.IsZero() {
+		return start, end, errors.New("there is no completed snapshot to restore from yet")
+	}
+	if oldest.After(start) {
+		start = oldest
+	}
+	return start, now, nil
+}
+
+// validatePointInTime checks the point in time is within the restorable window,
+// the error shows the nearest point that can be restored.
+func (opts *RestoresStartOpts) validatePointInTime(t, now time.Time) error {
+	start, end, err := opts.restorableWindow(now)
+	if err != nil {
+		return err
+	}
+	nearest := t
+	if t.Before(start) {
+		nearest = start
+	} else if t.After(end) {
+		nearest = end
+	}
+	if nearest.Equal(t) {
+		return nil
+	}
+	return fmt.Errorf("%s is outside the restorable window from %s to %s, the nearest restorable point in time is %s",
+		t.UTC().Format(time.RFC3339), start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339), nearest.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package backup
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/openlyinc/pointy"
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestParseAt(t *testing.T) {
+	now := time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		at      string
+		want    time.Time
+		wantErr bool
+	}{
+		{at: "2021-04-01T10:15:00Z", want: time.Date(2021, 4, 1, 10, 15, 0, 0, time.UTC)},
+		{at: "-30m", want: now.Add(-30 * time.Minute)},
+		{at: "-2h", want: now.Add(-2 * time.Hour)},
+		{at: "yesterday", wantErr: true},
+		{at: "-2days", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.at, func(t *testing.T) {
+			got, err := parseAt(tt.at, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseAt() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestoresStart_RunPointInTime(t *testing.T) {
+	now := time.Date(2021, 4, 10, 12, 0, 0, 0, time.UTC)
+	oldest := "2021-04-05T00:00:00Z"
+
+	setup := func(ctrl *gomock.Controller, opts *RestoresStartOpts) *mocks.MockRestoreJobsStarter {
+		mockStore := mocks.NewMockRestoreJobsStarter(ctrl)
+		opts.store = mockStore
+		mockStore.
+			EXPECT().
+			AtlasCluster(opts.ProjectID, "Cluster0").
+			Return(&mongodbatlas.Cluster{PitEnabled: pointy.Bool(true)}, nil).
+			Times(1)
+		mockStore.
+			EXPECT().
+			BackupPolicy(opts.ProjectID, "Cluster0").
+			Return(&mongodbatlas.CloudProviderSnapshotBackupPolicy{RestoreWindowDays: pointy.Int64(7)}, nil).
+			Times(1)
+		// the oldest completed snapshot is on the second page
+		firstPage := make([]*mongodbatlas.CloudProviderSnapshot, maxItemsPerPage)
+		for i := range firstPage {
+			firstPage[i] = &mongodbatlas.CloudProviderSnapshot{CreatedAt: "2021-04-01T00:00:00Z", Status: "failed"}
+		}
+		firstPage[0].Status = completedSnapshot
+		firstPage[0].CreatedAt = now.Add(-time.Hour).Format(time.RFC3339)
+		mockStore.
+			EXPECT().
+			Snapshots(opts.ProjectID, "Cluster0", &mongodbatlas.ListOptions{PageNum: 1, ItemsPerPage: maxItemsPerPage}).
+			Return(&mongodbatlas.CloudProviderSnapshots{Results: firstPage, TotalCount: maxItemsPerPage + 1}, nil).
+			Times(1)
+		mockStore.
+			EXPECT().
+			Snapshots(opts.ProjectID, "Cluster0", &mongodbatlas.ListOptions{PageNum: 2, ItemsPerPage: maxItemsPerPage}).
+			Return(&mongodbatlas.CloudProviderSnapshots{Results: []*mongodbatlas.CloudProviderSnapshot{
+				{CreatedAt: oldest, Status: completedSnapshot},
+			}, TotalCount: maxItemsPerPage + 1}, nil).
+			Times(1)
+		return mockStore
+	}
+
+	t.Run("within the window", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		opts := &RestoresStartOpts{
+			method:      pointInTimeRestore,
+			clusterName: "Cluster0",
+			at:          "-30m",
+			now:         func() time.Time { return now },
+		}
+		mockStore := setup(ctrl, opts)
+		mockStore.
+			EXPECT().
+			CreateRestoreJobs(opts.ProjectID, "Cluster0", &mongodbatlas.CloudProviderSnapshotRestoreJob{
+				DeliveryType:          pointInTimeRestore,
+				PointInTimeUTCSeconds: now.Add(-30 * time.Minute).Unix(),
+			}).
+			Return(&mongodbatlas.CloudProviderSnapshotRestoreJob{}, nil).
+			Times(1)
+
+		if err := opts.Run(); err != nil {
+			t.Fatalf("Run() unexpected error: %v", err)
+		}
+	})
+
+	t.Run("before the oldest snapshot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		opts := &RestoresStartOpts{
+			method:      pointInTimeRestore,
+			clusterName: "Cluster0",
+			at:          "2021-04-02T00:00:00Z",
+			now:         func() time.Time { return now },
+		}
+		setup(ctrl, opts)
+
+		err := opts.Run()
+		if err == nil || !strings.Contains(err.Error(), "nearest restorable point in time is "+oldest) {
+			t.Fatalf("Run() error = %v; want the nearest restorable point", err)
+		}
+	})
+}
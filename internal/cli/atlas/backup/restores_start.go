@@ -15,8 +15,11 @@
 package backup
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
@@ -24,6 +27,7 @@ import (
 	"github.com/mongodb/mongocli/internal/flag"
 	"github.com/mongodb/mongocli/internal/store"
 	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -36,7 +40,10 @@ const (
 
 type RestoresStartOpts struct {
 	cli.GlobalOpts
-	cli.OutputOpts
+	cli.WatchOpts
+	cli.DownloaderOpts
+	wait                 bool
+	progress             io.Writer
 	method               string
 	clusterName          string
 	targetProjectID      string
@@ -45,18 +52,30 @@ type RestoresStartOpts struct {
 	oplogInc             int64
 	snapshotID           string
 	pointInTimeUTCMillis int64
-	store                store.RestoreJobsCreator
+	at                   string
+	pointInTime          time.Time
+	now                  func() time.Time
+	store                store.RestoreJobsStarter
 }
 
-func (opts *RestoresStartOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *RestoresStartOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var startTemplate = "Restore job '{{.ID}}' successfully started\n"
+var completedTemplate = "\nRestore job '{{.ID}}' completed\n"
 
 func (opts *RestoresStartOpts) Run() error {
+	if opts.at != "" {
+		if err := opts.initPointInTime(); err != nil {
+			return err
+		}
+	}
+
 	request := opts.newCloudProviderSnapshotRestoreJob()
 	r, err := opts.store.CreateRestoreJobs(opts.ConfigProjectID(), opts.clusterName, request)
 
@@ -64,9 +83,64 @@ func (opts *RestoresStartOpts) Run() error {
 		return err
 	}
 
+	if !opts.wait && opts.Out == "" {
+		return opts.Print(r)
+	}
+
+	if err := opts.Watch(opts.watcher(r)); err != nil {
+		return err
+	}
+
+	if opts.Out != "" {
+		if err := opts.downloadArchives(r.ID, r.DeliveryURL); err != nil {
+			return err
+		}
+	}
+
+	opts.Template = completedTemplate
 	return opts.Print(r)
 }
 
+// initPointInTime converts --at and checks it can be restored before the job is submitted.
+func (opts *RestoresStartOpts) initPointInTime() error {
+	now := time.Now
+	if opts.now != nil {
+		now = opts.now
+	}
+	current := now()
+	t, err := parseAt(opts.at, current)
+	if err != nil {
+		return err
+	}
+	if err := opts.validatePointInTime(t, current); err != nil {
+		return err
+	}
+	opts.pointInTime = t
+	return nil
+}
+
+// watcher follows the restore job, a download restore is done once its archives can be downloaded.
+// The job is updated in place so it can be printed once done.
+func (opts *RestoresStartOpts) watcher(job *atlas.CloudProviderSnapshotRestoreJob) cli.Watcher {
+	return func() (bool, error) {
+		result, err := opts.store.RestoreJob(opts.ConfigProjectID(), opts.clusterName, job.ID)
+		if err != nil {
+			return false, err
+		}
+		*job = *result
+		if job.Cancelled {
+			return false, fmt.Errorf("restore job '%s' was canceled", job.ID)
+		}
+		if job.Expired {
+			return false, fmt.Errorf("restore job '%s' expired", job.ID)
+		}
+		if opts.isDownloadRestore() && len(job.DeliveryURL) > 0 {
+			return true, nil
+		}
+		return job.FinishedAt != "", nil
+	}
+}
+
 func (opts *RestoresStartOpts) newCloudProviderSnapshotRestoreJob() *atlas.CloudProviderSnapshotRestoreJob {
 	request := new(atlas.CloudProviderSnapshotRestoreJob)
 	request.DeliveryType = opts.method
@@ -87,6 +161,8 @@ func (opts *RestoresStartOpts) newCloudProviderSnapshotRestoreJob() *atlas.Cloud
 	if opts.oplogTS != 0 && opts.oplogInc != 0 {
 		request.OplogTs = opts.oplogTS
 		request.OplogInc = opts.oplogInc
+	} else if !opts.pointInTime.IsZero() {
+		request.PointInTimeUTCSeconds = opts.pointInTime.Unix()
 	} else if opts.pointInTimeUTCMillis != 0 {
 		// Set only when oplogTS and oplogInc are not set
 		request.PointInTimeUTCSeconds = opts.pointInTimeUTCMillis
@@ -112,6 +188,18 @@ func (opts *RestoresStartOpts) validateParams() error {
 		return errors.New("needs clusterName")
 	}
 
+	if opts.at != "" && !opts.isPointInTimeRestore() {
+		return fmt.Errorf("--%s is only supported for %s restores", flag.At, pointInTimeRestore)
+	}
+
+	if opts.at != "" && (opts.oplogTS != 0 || opts.oplogInc != 0 || opts.pointInTimeUTCMillis != 0) {
+		return fmt.Errorf("--%s can't be used with --%s, --%s or --%s", flag.At, flag.OplogTS, flag.OplogInc, flag.PointInTimeUTCMillis)
+	}
+
+	if opts.Out != "" && !opts.isDownloadRestore() {
+		return fmt.Errorf("--%s is only supported for %s restores", flag.Out, downloadRestore)
+	}
+
 	return nil
 }
 
@@ -143,12 +231,20 @@ func markRequiredPointInTimeRestoreFlags(cmd *cobra.Command) error {
 	return cmd.MarkFlagRequired(flag.ClusterName)
 }
 
-// mongocli atlas backup(s) restore(s) job(s) start <automated|download|pointInTime>
+// mongocli atlas backup(s) restore(s) job(s) start <automated|download|pointInTime> [--wait] [--out out] [--force]
 func RestoresStartBuilder() *cobra.Command {
 	opts := new(RestoresStartOpts)
+	opts.Fs = afero.NewOsFs()
 	cmd := &cobra.Command{
-		Use:       fmt.Sprintf("start <%s|%s|%s>", automatedRestore, downloadRestore, pointInTimeRestore),
-		Short:     "Start a restore job for your project and cluster.",
+		Use:   fmt.Sprintf("start <%s|%s|%s>", automatedRestore, downloadRestore, pointInTimeRestore),
+		Short: "Start a restore job for your project and cluster.",
+		Long: `Start a restore job for your project and cluster.
+Use --wait to follow the restore job to completion. For download restores --out also downloads the archive,
+showing progress on stderr, resuming the download if it is interrupted and verifying its checksum when Atlas provides one.`,
+		Example: `  $ mongocli atlas backup restore start automated --clusterName Cluster0 --snapshotId 5e7e00128f8ce03996a47179 --targetProjectId 5e7e00128f8ce03996a47180 --targetClusterName Cluster1 --wait
+  $ mongocli atlas backup restore start download --clusterName Cluster0 --snapshotId 5e7e00128f8ce03996a47179 --out snapshot.tar.gz
+  $ mongocli atlas backup restore start pointInTime --clusterName Cluster0 --targetProjectId 5e7e00128f8ce03996a47180 --targetClusterName Cluster1 --at 2021-04-01T10:15:00Z
+  $ mongocli atlas backup restore start pointInTime --clusterName Cluster0 --targetProjectId 5e7e00128f8ce03996a47180 --targetClusterName Cluster1 --at -30m`,
 		Args:      require.ExactValidArgs(1),
 		ValidArgs: []string{automatedRestore, downloadRestore, pointInTimeRestore},
 		PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -172,12 +268,14 @@ func RestoresStartBuilder() *cobra.Command {
 
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), startTemplate),
 			)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.method = args[0]
+			opts.progress = cmd.ErrOrStderr()
 
 			if e := opts.validateParams(); e != nil {
 				return e
@@ -197,9 +295,16 @@ func RestoresStartBuilder() *cobra.Command {
 	cmd.Flags().Int64Var(&opts.oplogTS, flag.OplogTS, 0, usage.OplogTS)
 	cmd.Flags().Int64Var(&opts.oplogInc, flag.OplogInc, 0, usage.OplogInc)
 	cmd.Flags().Int64Var(&opts.pointInTimeUTCMillis, flag.PointInTimeUTCMillis, 0, usage.PointInTimeUTCMillis)
+	cmd.Flags().StringVar(&opts.at, flag.At, "", usage.RestoreAt)
+	cmd.Flags().BoolVar(&opts.wait, flag.Wait, false, usage.RestoreWait)
+	cmd.Flags().StringVar(&opts.Out, flag.Out, "", usage.RestoreOut)
+	cmd.Flags().BoolVar(&opts.Force, flag.Force, false, usage.ForceFile)
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
 
 	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
 	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
 
+	_ = cmd.MarkFlagFilename(flag.Out)
+
 	return cmd
 }
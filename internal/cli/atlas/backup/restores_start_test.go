@@ -17,16 +17,26 @@
 package backup
 
 import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
 	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/spf13/afero"
 	"go.mongodb.org/atlas/mongodbatlas"
 )
 
 func TestRestoresStart_Run(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	mockStore := mocks.NewMockRestoreJobsCreator(ctrl)
+	mockStore := mocks.NewMockRestoreJobsStarter(ctrl)
 	defer ctrl.Finish()
 
 	expected := &mongodbatlas.CloudProviderSnapshotRestoreJob{}
@@ -67,3 +77,124 @@ func TestRestoresStart_Run(t *testing.T) {
 		}
 	})
 }
+
+func TestRestoresStart_RunWait(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockRestoreJobsStarter(ctrl)
+	defer ctrl.Finish()
+
+	opts := &RestoresStartOpts{
+		store:       mockStore,
+		method:      automatedRestore,
+		clusterName: "Cluster0",
+		wait:        true,
+		WatchOpts:   cli.WatchOpts{OutputOpts: cli.OutputOpts{OutWriter: new(bytes.Buffer)}},
+	}
+
+	mockStore.
+		EXPECT().
+		CreateRestoreJobs(opts.ProjectID, "Cluster0", opts.newCloudProviderSnapshotRestoreJob()).
+		Return(&mongodbatlas.CloudProviderSnapshotRestoreJob{ID: "1"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		RestoreJob(opts.ProjectID, "Cluster0", "1").
+		Return(&mongodbatlas.CloudProviderSnapshotRestoreJob{ID: "1", Cancelled: true}, nil).
+		Times(1)
+
+	if err := opts.Run(); err == nil {
+		t.Fatal("Run() expected an error for a canceled restore job")
+	}
+}
+
+// failingReader returns the content then fails, like a dropped connection.
+type failingReader struct {
+	io.Reader
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err == io.EOF {
+		return n, errors.New("connection reset by peer")
+	}
+	return n, err
+}
+
+func TestRestoresStart_RunDownload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockRestoreJobsStarter(ctrl)
+	defer ctrl.Finish()
+
+	const (
+		url     = "https://restore.example.com/restore-1.tar.gz"
+		content = "snapshot archive"
+		// md5 of content
+		checksum = "bb22082036a859e985b40a724baf905e"
+	)
+	fs := afero.NewMemMapFs()
+	// left by the download of another restore job, it must not be resumed
+	if err := afero.WriteFile(fs, "restore.tar.gz.0.part", []byte("stale"), 0600); err != nil {
+		t.Fatalf("WriteFile() unexpected error: %v", err)
+	}
+	progress := new(bytes.Buffer)
+	opts := &RestoresStartOpts{
+		store:          mockStore,
+		method:         downloadRestore,
+		clusterName:    "Cluster0",
+		progress:       progress,
+		WatchOpts:      cli.WatchOpts{OutputOpts: cli.OutputOpts{OutWriter: new(bytes.Buffer)}},
+		DownloaderOpts: cli.DownloaderOpts{Out: "restore.tar.gz", Fs: fs},
+	}
+
+	mockStore.
+		EXPECT().
+		CreateRestoreJobs(opts.ProjectID, "Cluster0", opts.newCloudProviderSnapshotRestoreJob()).
+		Return(&mongodbatlas.CloudProviderSnapshotRestoreJob{ID: "1"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		RestoreJob(opts.ProjectID, "Cluster0", "1").
+		Return(&mongodbatlas.CloudProviderSnapshotRestoreJob{ID: "1", DeliveryURL: []string{url}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DownloadRestoreArchive(url, int64(0)).
+		Return(&store.RestoreArchive{
+			ReadCloser: ioutil.NopCloser(&failingReader{strings.NewReader(content[:8])}),
+			Size:       int64(len(content)),
+			MD5:        checksum,
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DownloadRestoreArchive(url, int64(8)).
+		Return(&store.RestoreArchive{
+			ReadCloser: ioutil.NopCloser(strings.NewReader(content[8:])),
+			Offset:     8,
+			Size:       -1,
+		}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	got, err := afero.ReadFile(fs, "restore.tar.gz")
+	if err != nil {
+		t.Fatalf("ReadFile() unexpected error: %v", err)
+	}
+	if string(got) != content {
+		t.Errorf("archive = %q; want %q", got, content)
+	}
+	if !strings.Contains(progress.String(), "resuming") || !strings.Contains(progress.String(), "100%") {
+		t.Errorf("progress = %q; want a resume and a complete download", progress.String())
+	}
+}
+
+func TestRestoresStartBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		RestoresStartBuilder(),
+		0,
+		[]string{flag.ClusterName, flag.SnapshotID, flag.At, flag.Wait, flag.Out, flag.Force, flag.Interval, flag.ProjectID, flag.Output},
+	)
+}
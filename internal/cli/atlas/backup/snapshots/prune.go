@@ -0,0 +1,245 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	onDemandSnapshot  = "onDemand"
+	completedSnapshot = "completed"
+	keepAction        = "keep"
+	deleteAction      = "delete"
+	maxItemsPerPage   = 500
+)
+
+// PruneDecision is what prune does with an on-demand snapshot and why.
+type PruneDecision struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	Action    string `json:"action"`
+	Reason    string `json:"reason"`
+}
+
+type PruneOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	*cli.DeleteOpts
+	clusterName string
+	keepDaily   int
+	keepWeekly  int
+	keepMonthly int
+	dryRun      bool
+	progress    io.Writer
+	store       store.SnapshotsPruner
+}
+
+func (opts *PruneOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *PruneOpts) validate() error {
+	if opts.keepDaily < 0 || opts.keepWeekly < 0 || opts.keepMonthly < 0 {
+		return errors.New("the number of snapshots to keep can't be negative")
+	}
+	if opts.keepDaily == 0 && opts.keepWeekly == 0 && opts.keepMonthly == 0 {
+		return fmt.Errorf("set at least one of --%s, --%s or --%s", flag.KeepDaily, flag.KeepWeekly, flag.KeepMonthly)
+	}
+	return nil
+}
+
+var pruneTemplate = `ID	CREATED AT	ACTION	REASON{{range .}}
+{{.ID}}	{{.CreatedAt}}	{{.Action}}	{{.Reason}}{{end}}
+`
+
+func (opts *PruneOpts) Run() error {
+	snapshots, err := opts.onDemandSnapshots()
+	if err != nil {
+		return err
+	}
+
+	decisions := evaluateRetention(snapshots, opts.keepDaily, opts.keepWeekly, opts.keepMonthly)
+	if err := opts.Print(decisions); err != nil {
+		return err
+	}
+
+	var toDelete []string
+	for _, d := range decisions {
+		if d.Action == deleteAction {
+			toDelete = append(toDelete, d.ID)
+		}
+	}
+	if opts.dryRun || len(toDelete) == 0 {
+		return nil
+	}
+
+	opts.Entry = opts.clusterName
+	if err := opts.PromptWithMessage(fmt.Sprintf("Are you sure you want to delete %d on-demand snapshots of %%s?", len(toDelete))); err != nil {
+		return err
+	}
+	if !opts.Confirm {
+		_, err := fmt.Fprintln(opts.progress, opts.FailMessage())
+		return err
+	}
+
+	for _, id := range toDelete {
+		if err := opts.store.DeleteSnapshot(opts.ConfigProjectID(), opts.clusterName, id); err != nil {
+			return fmt.Errorf("deleting snapshot %s: %w", id, err)
+		}
+		if _, err := fmt.Fprintf(opts.progress, opts.SuccessMessage(), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// onDemandSnapshots returns the on-demand snapshots of the cluster across all pages,
+// scheduled snapshots follow the backup policy of the cluster and are never pruned.
+func (opts *PruneOpts) onDemandSnapshots() ([]*atlas.CloudProviderSnapshot, error) {
+	var result []*atlas.CloudProviderSnapshot
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxItemsPerPage}
+	for listOpts.PageNum = 1; ; listOpts.PageNum++ {
+		r, err := opts.store.Snapshots(opts.ConfigProjectID(), opts.clusterName, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range r.Results {
+			if s.SnapshotType == onDemandSnapshot {
+				result = append(result, s)
+			}
+		}
+		if len(r.Results) < maxItemsPerPage || listOpts.PageNum*maxItemsPerPage >= r.TotalCount {
+			return result, nil
+		}
+	}
+}
+
+type retentionRule struct {
+	name   string
+	keep   int
+	period func(time.Time) string
+}
+
+// evaluateRetention applies a grandfather-father-son policy to the snapshots.
+// Walking from the newest snapshot, the first snapshot of each day, week and month is kept
+// until the number of periods of that kind is reached, everything else is deleted.
+// Snapshots that are not completed yet are always kept.
+func evaluateRetention(snapshots []*atlas.CloudProviderSnapshot, daily, weekly, monthly int) []*PruneDecision {
+	rules := []*retentionRule{
+		{name: "daily", keep: daily, period: func(t time.Time) string { return t.Format("2006-01-02") }},
+		{name: "weekly", keep: weekly, period: func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{name: "monthly", keep: monthly, period: func(t time.Time) string { return t.Format("2006-01") }},
+	}
+
+	type dated struct {
+		snapshot  *atlas.CloudProviderSnapshot
+		createdAt time.Time
+	}
+	sorted := make([]dated, 0, len(snapshots))
+	decisions := make([]*PruneDecision, 0, len(snapshots))
+	for _, s := range snapshots {
+		createdAt, err := time.Parse(time.RFC3339, s.CreatedAt)
+		if s.Status != completedSnapshot || err != nil {
+			decisions = append(decisions, &PruneDecision{ID: s.ID, CreatedAt: s.CreatedAt, Action: keepAction, Reason: "not completed"})
+			continue
+		}
+		sorted = append(sorted, dated{snapshot: s, createdAt: createdAt.UTC()})
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].createdAt.After(sorted[j].createdAt) })
+
+	kept := make([]int, len(rules))
+	last := make([]string, len(rules))
+	for _, d := range sorted {
+		var reasons []string
+		for i, r := range rules {
+			p := r.period(d.createdAt)
+			if kept[i] < r.keep && p != last[i] {
+				kept[i]++
+				last[i] = p
+				reasons = append(reasons, r.name)
+			}
+		}
+		decision := &PruneDecision{ID: d.snapshot.ID, CreatedAt: d.snapshot.CreatedAt, Action: keepAction, Reason: strings.Join(reasons, ", ")}
+		if len(reasons) == 0 {
+			decision.Action = deleteAction
+			decision.Reason = "outside retention"
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions
+}
+
+// mongocli atlas backups snapshots prune <clusterName> [--keepDaily N] [--keepWeekly N] [--keepMonthly N] [--dryRun] [--force] [--projectId projectId]
+func PruneBuilder() *cobra.Command {
+	opts := &PruneOpts{
+		DeleteOpts: cli.NewDeleteOpts("Snapshot '%s' deleted\n", "Snapshots not deleted"),
+	}
+	cmd := &cobra.Command{
+		Use:   "prune <clusterName>",
+		Short: "Delete the on-demand snapshots of a cluster that fall outside a retention policy.",
+		Long: `Keep the newest on-demand snapshot of each of the most recent days, weeks and months and delete the rest.
+Scheduled snapshots are managed by the backup policy of the cluster and are not affected.`,
+		Example: `  Preview which snapshots a 7 daily, 4 weekly and 12 monthly policy would delete:
+  $ mongocli atlas backups snapshots prune myCluster --keepDaily 7 --keepWeekly 4 --keepMonthly 12 --dryRun`,
+		Args: require.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.validate,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), pruneTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.clusterName = args[0]
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.keepDaily, flag.KeepDaily, 0, usage.KeepDaily)
+	cmd.Flags().IntVar(&opts.keepWeekly, flag.KeepWeekly, 0, usage.KeepWeekly)
+	cmd.Flags().IntVar(&opts.keepMonthly, flag.KeepMonthly, 0, usage.KeepMonthly)
+	cmd.Flags().BoolVar(&opts.dryRun, flag.DryRun, false, usage.PruneDryRun)
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	return cmd
+}
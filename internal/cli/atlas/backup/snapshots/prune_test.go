@@ -0,0 +1,178 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package snapshots
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+func snapshot(id, createdAt string) *mongodbatlas.CloudProviderSnapshot {
+	return &mongodbatlas.CloudProviderSnapshot{ID: id, CreatedAt: createdAt, SnapshotType: onDemandSnapshot, Status: completedSnapshot}
+}
+
+func actions(decisions []*PruneDecision) map[string]string {
+	result := map[string]string{}
+	for _, d := range decisions {
+		result[d.ID] = d.Action
+	}
+	return result
+}
+
+func TestEvaluateRetention(t *testing.T) {
+	snapshots := []*mongodbatlas.CloudProviderSnapshot{
+		snapshot("1", "2021-03-01T10:00:00Z"),
+		snapshot("2", "2021-03-31T10:00:00Z"),
+		snapshot("3", "2021-04-05T10:00:00Z"),
+		snapshot("4", "2021-04-12T09:00:00Z"),
+		snapshot("5", "2021-04-12T10:00:00Z"),
+		snapshot("6", "2021-04-13T10:00:00Z"),
+		{ID: "7", CreatedAt: "2021-04-13T11:00:00Z", SnapshotType: onDemandSnapshot, Status: "inProgress"},
+	}
+
+	t.Run("daily", func(t *testing.T) {
+		got := actions(evaluateRetention(snapshots, 2, 0, 0))
+		want := map[string]string{"1": deleteAction, "2": deleteAction, "3": deleteAction, "4": deleteAction, "5": keepAction, "6": keepAction, "7": keepAction}
+		assertActions(t, got, want)
+	})
+	t.Run("weekly and monthly", func(t *testing.T) {
+		got := actions(evaluateRetention(snapshots, 1, 2, 2))
+		want := map[string]string{"1": deleteAction, "2": keepAction, "3": keepAction, "4": deleteAction, "5": deleteAction, "6": keepAction, "7": keepAction}
+		assertActions(t, got, want)
+	})
+}
+
+func assertActions(t *testing.T, got, want map[string]string) {
+	t.Helper()
+	for id, action := range want {
+		if got[id] != action {
+			t.Errorf("snapshot %s: got %q, want %q", id, got[id], action)
+		}
+	}
+}
+
+func TestPrune_Run(t *testing.T) {
+	snapshots := &mongodbatlas.CloudProviderSnapshots{
+		Results: []*mongodbatlas.CloudProviderSnapshot{
+			snapshot("1", "2021-04-12T10:00:00Z"),
+			snapshot("2", "2021-04-13T10:00:00Z"),
+			{ID: "3", CreatedAt: "2021-04-10T10:00:00Z", SnapshotType: "scheduled", Status: completedSnapshot},
+		},
+		TotalCount: 3,
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockSnapshotsPruner(ctrl)
+		defer ctrl.Finish()
+
+		buf := new(bytes.Buffer)
+		opts := &PruneOpts{
+			OutputOpts:  cli.OutputOpts{Template: pruneTemplate, OutWriter: buf},
+			DeleteOpts:  cli.NewDeleteOpts("Snapshot '%s' deleted\n", "Snapshots not deleted"),
+			clusterName: "Cluster0",
+			keepDaily:   1,
+			dryRun:      true,
+			progress:    new(bytes.Buffer),
+			store:       mockStore,
+		}
+
+		mockStore.
+			EXPECT().
+			Snapshots(opts.ProjectID, "Cluster0", gomock.Any()).
+			Return(snapshots, nil).
+			Times(1)
+
+		if err := opts.Run(); err != nil {
+			t.Fatalf("Run() unexpected error: %v", err)
+		}
+		want := `ID    CREATED AT             ACTION   REASON
+2     2021-04-13T10:00:00Z   keep     daily
+1     2021-04-12T10:00:00Z   delete   outside retention
+`
+		if got := buf.String(); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockSnapshotsPruner(ctrl)
+		defer ctrl.Finish()
+
+		progress := new(bytes.Buffer)
+		opts := &PruneOpts{
+			OutputOpts:  cli.OutputOpts{Template: pruneTemplate, OutWriter: new(bytes.Buffer)},
+			DeleteOpts:  cli.NewDeleteOpts("Snapshot '%s' deleted\n", "Snapshots not deleted"),
+			clusterName: "Cluster0",
+			keepDaily:   1,
+			progress:    progress,
+			store:       mockStore,
+		}
+		opts.Confirm = true
+
+		mockStore.
+			EXPECT().
+			Snapshots(opts.ProjectID, "Cluster0", gomock.Any()).
+			Return(snapshots, nil).
+			Times(1)
+		mockStore.
+			EXPECT().
+			DeleteSnapshot(opts.ProjectID, "Cluster0", "1").
+			Return(nil).
+			Times(1)
+
+		if err := opts.Run(); err != nil {
+			t.Fatalf("Run() unexpected error: %v", err)
+		}
+		if got, want := progress.String(), "Snapshot '1' deleted\n"; got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+}
+
+func TestPruneOpts_validate(t *testing.T) {
+	opts := &PruneOpts{}
+	if err := opts.validate(); err == nil {
+		t.Error("validate() expected an error without a retention")
+	}
+	opts.keepWeekly = -1
+	opts.keepDaily = 7
+	if err := opts.validate(); err == nil {
+		t.Error("validate() expected an error for a negative retention")
+	}
+	opts.keepWeekly = 4
+	if err := opts.validate(); err != nil {
+		t.Errorf("validate() unexpected error: %v", err)
+	}
+}
+
+func TestPruneBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		PruneBuilder(),
+		0,
+		[]string{flag.KeepDaily, flag.KeepWeekly, flag.KeepMonthly, flag.DryRun, flag.Force, flag.ProjectID, flag.Output},
+	)
+}
@@ -33,6 +33,7 @@ func Builder() *cobra.Command {
 		DescribeBuilder(),
 		WatchBuilder(),
 		DeleteBuilder(),
+		PruneBuilder(),
 	)
 
 	return cmd
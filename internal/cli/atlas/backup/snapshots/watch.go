@@ -15,6 +15,8 @@
 package snapshots
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -32,10 +34,12 @@ type WatchOpts struct {
 	store       store.SnapshotsDescriber
 }
 
-func (opts *WatchOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *WatchOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *WatchOpts) watcher() (bool, error) {
@@ -64,7 +68,8 @@ func WatchBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), "\nSnapshot changes completed.\n"),
 			)
 		},
@@ -75,6 +80,8 @@ func WatchBuilder() *cobra.Command {
 	}
 	cmd.Flags().StringVar(&opts.clusterName, flag.ClusterName, "", usage.ClusterName)
 
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
+
 	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
 
 	_ = cmd.MarkFlagRequired(flag.ClusterName)
@@ -15,6 +15,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -38,10 +40,12 @@ type DeauthorizeOpts struct {
 	store store.CloudProviderAccessRoleDeauthorizer
 }
 
-func (opts *DeauthorizeOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *DeauthorizeOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *DeauthorizeOpts) Run() error {
@@ -75,7 +79,7 @@ func DeauthorizeBuilder() *cobra.Command {
 		Short: "Deauthorize an AWS IAM role.",
 		Args:  require.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return opts.PreRunE(opts.ValidateProjectID, opts.initStore)
+			return opts.PreRunE(opts.ValidateProjectID, opts.initStore(cmd.Context()))
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Entry = args[0]
@@ -42,6 +42,7 @@ func Builder() *cobra.Command {
 		StartBuilder(),
 		DeleteBuilder(),
 		LoadSampleDataBuilder(),
+		ConnectBuilder(),
 		indexes.Builder(),
 		search.Builder(),
 		onlinearchive.Builder(),
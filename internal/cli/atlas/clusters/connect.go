@@ -0,0 +1,107 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/atlas/clusters/connectionstring"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/convert"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type ConnectOpts struct {
+	cli.GlobalOpts
+	cli.ShellOpts
+	name   string
+	csType string
+	store  store.AtlasClusterDescriber
+}
+
+func (opts *ConnectOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ConnectOpts) connectionString() (string, error) {
+	r, err := opts.store.AtlasCluster(opts.ConfigProjectID(), opts.name)
+	if err != nil {
+		return "", err
+	}
+	return connectionstring.Resolve(r.ConnectionStrings, opts.csType)
+}
+
+func (opts *ConnectOpts) Run() error {
+	uri, err := opts.connectionString()
+	if err != nil {
+		return err
+	}
+	if err := opts.LoadCredential(); err != nil {
+		return err
+	}
+	return opts.RunShell(uri)
+}
+
+// mongocli atlas cluster(s) connect <clusterName> [--type standard|srv|nonSrv|private|privateEndpoint|privateEndpointSrv] [--shell mongosh|mongo]
+// [--username username] [--credentialFile file | --credentialHelper executable] [--projectId projectId] [-- shellArgs...]
+func ConnectBuilder() *cobra.Command {
+	opts := &ConnectOpts{}
+	opts.Fs = afero.NewOsFs()
+	cmd := &cobra.Command{
+		Use:   "connect <clusterName> [-- <shellArgs>...]",
+		Short: "Open a MongoDB shell connected to your cluster.",
+		Long: `The password of the user is prompted for, read from the file given with --credentialFile or asked to the executable given with --credentialHelper.
+It's handed to the shell in a script readable only by you, never on the command line, and the script is removed when the shell exits.
+Arguments after -- are passed to the shell, like the options of X.509 authentication.`,
+		Example: `  $ mongocli atlas clusters connect myCluster --username myUser --projectId 5e2211c17a3e5a48f5497de3
+  $ mongocli atlas clusters connect myCluster --type privateEndpointSrv --shell mongo -- --quiet`,
+		Args: require.MinimumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.PreRunE(
+				func() error { return opts.SetShellArgs(cmd, args, 1) },
+				opts.ValidateShell,
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.csType, flag.Type, connectionstring.SRV, usage.ConnectType)
+	cmd.Flags().StringVar(&opts.Shell, flag.Shell, cli.DefaultShell, usage.Shell)
+	cmd.Flags().StringVarP(&opts.Username, flag.Username, flag.UsernameShort, "", usage.DBUsername)
+	cmd.Flags().StringVar(&opts.AuthDB, flag.AuthDB, convert.AdminDB, usage.AuthDB)
+	cmd.Flags().StringVar(&opts.CredentialFile, flag.CredentialFile, "", usage.CredentialFile)
+	cmd.Flags().StringVar(&opts.CredentialHelper, flag.CredentialHelper, "", usage.ConnectCredentialHelper)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+
+	_ = cmd.MarkFlagFilename(flag.CredentialFile)
+
+	return cmd
+}
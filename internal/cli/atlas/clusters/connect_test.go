@@ -0,0 +1,63 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package clusters
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestConnect_connectionString(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAtlasClusterDescriber(ctrl)
+	defer ctrl.Finish()
+
+	opts := &ConnectOpts{
+		name:   "Cluster0",
+		csType: "srv",
+		store:  mockStore,
+	}
+	opts.ProjectID = "5a0a1e7e0f2912c554080adc"
+
+	mockStore.
+		EXPECT().
+		AtlasCluster(opts.ProjectID, opts.name).
+		Return(&mongodbatlas.Cluster{ConnectionStrings: &mongodbatlas.ConnectionStrings{StandardSrv: "mongodb+srv://cluster0.mongodb.net"}}, nil).
+		Times(1)
+
+	got, err := opts.connectionString()
+	if err != nil {
+		t.Fatalf("connectionString() unexpected error: %v", err)
+	}
+	if got != "mongodb+srv://cluster0.mongodb.net" {
+		t.Errorf("got %s, want the SRV connection string", got)
+	}
+}
+
+func TestConnectBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ConnectBuilder(),
+		0,
+		[]string{flag.Type, flag.Shell, flag.Username, flag.AuthDB, flag.CredentialFile, flag.CredentialHelper, flag.ProjectID},
+	)
+}
@@ -15,6 +15,11 @@
 package connectionstring
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -22,65 +27,174 @@ import (
 	"github.com/mongodb/mongocli/internal/store"
 	"github.com/mongodb/mongocli/internal/usage"
 	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	uriFormat              = "uri"
+	envFormat              = "env"
+	kubernetesSecretFormat = "kubernetesSecret"
 )
 
+var describeTemplateStandard = `STANDARD CONNECTION STRING
+{{.ConnectionString}}
+`
+
+var describeTemplatePrivate = `PRIVATE CONNECTION STRING
+{{.ConnectionString}}
+`
+
+var describeTemplateEnv = `MONGODB_URI={{.ShellQuoted}}
+`
+
+var describeTemplateKubernetesSecret = `apiVersion: v1
+kind: Secret
+metadata:
+  name: {{.SecretName}}
+type: Opaque
+stringData:
+  connectionString: {{.YAMLQuoted}}
+`
+
+var templates = map[string]string{
+	uriFormat:              describeTemplateStandard,
+	envFormat:              describeTemplateEnv,
+	kubernetesSecretFormat: describeTemplateKubernetesSecret,
+}
+
+// ConnectionString is the composed connection string of a cluster, along with all the connection strings of the cluster
+type ConnectionString struct {
+	*atlas.ConnectionStrings
+	Cluster          string `json:"cluster"`
+	Type             string `json:"type"`
+	ConnectionString string `json:"connectionString"`
+	SecretName       string `json:"-"`
+}
+
+// ShellQuoted returns the connection string single-quoted for a shell or a .env file
+func (c *ConnectionString) ShellQuoted() string {
+	return "'" + strings.ReplaceAll(c.ConnectionString, "'", `'\''`) + "'"
+}
+
+// YAMLQuoted returns the connection string as a single-quoted YAML scalar
+func (c *ConnectionString) YAMLQuoted() string {
+	return "'" + strings.ReplaceAll(c.ConnectionString, "'", "''") + "'"
+}
+
+// template returns the template of the format, the uri one keeps the header of the type of connection string
+func (opts *DescribeOpts) template() string {
+	if opts.format == uriFormat && strings.HasPrefix(opts.csType, Private) {
+		return describeTemplatePrivate
+	}
+	return templates[opts.format]
+}
+
 type DescribeOpts struct {
 	cli.GlobalOpts
 	cli.OutputOpts
-	name   string
-	store  store.AtlasClusterDescriber
-	csType string
+	Options
+	name        string
+	csType      string
+	passwordEnv string
+	format      string
+	store       store.AtlasClusterDescriber
 }
 
-func (opts *DescribeOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *DescribeOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
-var describeTemplateStandard = `STANDARD CONNECTION STRING
-{{.StandardSrv}}
-`
-
-var describeTemplatePrivate = `PRIVATE CONNECTION STRING
-{{.PrivateSrv}}
-`
+func (opts *DescribeOpts) validate() error {
+	if _, ok := templates[opts.format]; !ok {
+		return fmt.Errorf("invalid format %s, use %s, %s or %s", opts.format, uriFormat, envFormat, kubernetesSecretFormat)
+	}
+	if opts.passwordEnv == "" {
+		return nil
+	}
+	if opts.Username == "" {
+		return fmt.Errorf("--%s requires --%s", flag.PasswordEnv, flag.DBUser)
+	}
+	password, ok := os.LookupEnv(opts.passwordEnv)
+	if !ok {
+		return fmt.Errorf("environment variable %s not set", opts.passwordEnv)
+	}
+	opts.Password = password
+	return nil
+}
 
 func (opts *DescribeOpts) Run() error {
 	r, err := opts.store.AtlasCluster(opts.ConfigProjectID(), opts.name)
 	if err != nil {
 		return err
 	}
-	return opts.Print(r.ConnectionStrings)
+	uri, err := Resolve(r.ConnectionStrings, opts.csType)
+	if err != nil {
+		return err
+	}
+	uri, err = Compose(uri, &opts.Options)
+	if err != nil {
+		return err
+	}
+	return opts.Print(&ConnectionString{
+		ConnectionStrings: r.ConnectionStrings,
+		Cluster:           opts.name,
+		Type:              opts.csType,
+		ConnectionString:  uri,
+		SecretName:        strings.ToLower(opts.name) + "-connection-string",
+	})
 }
 
-// mongocli atlas cluster(s) connectionString describe <clusterName> --type standard|private --projectId projectId
+// mongocli atlas cluster(s) connectionString describe <clusterName> [--type standard|srv|nonSrv|private|privateEndpoint|privateEndpointSrv]
+// [--dbUser username [--passwordEnv variable]] [--retryWrites] [--writeConcern w] [--readPreference mode] [--authSource db] [--tls]
+// [--format uri|env|kubernetesSecret] --projectId projectId
 func DescribeBuilder() *cobra.Command {
 	opts := &DescribeOpts{}
+	var retryWrites, tls bool
 	cmd := &cobra.Command{
 		Use:     "describe <clusterName>",
 		Aliases: []string{"get"},
-		Short:   "Retrieve the SRV connection string of your MongoDB cluster.",
-		Args:    require.ExactArgs(1),
+		Short:   "Retrieve a connection string of your MongoDB cluster.",
+		Long: `The user and options given are added to the connection string, escaped as needed.
+The password is read from the environment variable given with --passwordEnv so it's never on the command line.
+The connection string is printed as is, as a .env file entry or as a Kubernetes Secret manifest.`,
+		Example: `  $ mongocli atlas clusters connectionStrings describe myCluster --dbUser app --passwordEnv APP_PASSWORD --writeConcern majority --format env
+  $ mongocli atlas clusters connectionStrings describe myCluster --type privateEndpointSrv --format kubernetesSecret | kubectl apply -f -`,
+		Args: require.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(flag.RetryWrites) {
+				opts.RetryWrites = &retryWrites
+			}
+			if cmd.Flags().Changed(flag.TLS) {
+				opts.TLS = &tls
+			}
 			return opts.PreRunE(
+				opts.validate,
 				opts.ValidateProjectID,
-				opts.initStore,
-				opts.InitOutput(cmd.OutOrStdout(), describeTemplateStandard),
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), opts.template()),
 			)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.name = args[0]
-
-			if opts.csType == "private" {
-				opts.Template = describeTemplatePrivate
-			}
 			return opts.Run()
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.csType, flag.Type, Standard, usage.ConnectionStringType)
+	cmd.Flags().StringVar(&opts.Username, flag.DBUser, "", usage.ConnectionStringDBUser)
+	cmd.Flags().StringVar(&opts.passwordEnv, flag.PasswordEnv, "", usage.ConnectionStringPasswordEnv)
+	cmd.Flags().BoolVar(&retryWrites, flag.RetryWrites, true, usage.ConnectionStringRetryWrites)
+	cmd.Flags().StringVar(&opts.W, flag.WriteConcern, "", usage.ConnectionStringWriteConcern)
+	cmd.Flags().StringVar(&opts.ReadPreference, flag.ReadPreference, "", usage.ConnectionStringReadPreference)
+	cmd.Flags().StringVar(&opts.AuthSource, flag.AuthSource, "", usage.ConnectionStringAuthSource)
+	cmd.Flags().BoolVar(&tls, flag.TLS, true, usage.ConnectionStringTLS)
+	cmd.Flags().StringVar(&opts.format, flag.Format, uriFormat, usage.ConnectionStringFormat)
+
 	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
-	cmd.Flags().StringVar(&opts.csType, flag.Type, "standard", usage.ConnectionStringType)
 	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
 
 	return cmd
@@ -0,0 +1,94 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectionstring
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Options are added to a connection string, options left empty or nil keep the value of the connection string
+type Options struct {
+	Username       string
+	Password       string
+	RetryWrites    *bool
+	W              string
+	ReadPreference string
+	AuthSource     string
+	TLS            *bool
+}
+
+// Compose adds the user and the options to the connection string, escaping them as needed
+func Compose(uri string, o *Options) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	userInfo := ""
+	switch {
+	case o.Username != "" && o.Password != "":
+		userInfo = escapeUserInfo(o.Username) + ":" + escapeUserInfo(o.Password)
+	case o.Username != "":
+		userInfo = escapeUserInfo(o.Username)
+	}
+	if userInfo != "" {
+		// url.URL keeps characters like $ ; & = + , unescaped in the user info, MongoDB drivers refuse them
+		u.User = nil
+	}
+
+	q := u.Query()
+	if o.RetryWrites != nil {
+		q.Set("retryWrites", strconv.FormatBool(*o.RetryWrites))
+	}
+	if o.W != "" {
+		q.Set("w", o.W)
+	}
+	if o.ReadPreference != "" {
+		q.Set("readPreference", o.ReadPreference)
+	}
+	if o.AuthSource != "" {
+		q.Set("authSource", o.AuthSource)
+	}
+	if o.TLS != nil {
+		// ssl is the legacy name of the tls option, drivers refuse conflicting values
+		q.Del("ssl")
+		q.Set("tls", strconv.FormatBool(*o.TLS))
+	}
+	u.RawQuery = q.Encode()
+	if u.RawQuery != "" && u.Path == "" {
+		// the options must follow a slash
+		u.Path = "/"
+	}
+	if userInfo == "" {
+		return u.String(), nil
+	}
+	return u.Scheme + "://" + userInfo + "@" + strings.TrimPrefix(u.String(), u.Scheme+"://"), nil
+}
+
+// escapeUserInfo percent-encodes all but the unreserved characters of RFC 3986
+func escapeUserInfo(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectionstring
+
+import (
+	"fmt"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+// Types of connection string, standard is the SRV one as it always was and nonSrv lists the hosts
+const (
+	Standard           = "standard"
+	SRV                = "srv"
+	NonSRV             = "nonSrv"
+	Private            = "private"
+	PrivateEndpoint    = "privateEndpoint"
+	PrivateEndpointSRV = "privateEndpointSrv"
+)
+
+// Resolve returns the connection string of the given type, private is the network peering one
+// and the private endpoint ones are of the first endpoint of the cluster.
+func Resolve(cs *atlas.ConnectionStrings, csType string) (string, error) {
+	if cs == nil {
+		return "", fmt.Errorf("the cluster has no connection strings yet")
+	}
+	var s string
+	switch csType {
+	case Standard, SRV:
+		s = cs.StandardSrv
+	case NonSRV:
+		s = cs.Standard
+	case Private:
+		s = cs.PrivateSrv
+	case PrivateEndpoint, PrivateEndpointSRV:
+		if len(cs.PrivateEndpoint) > 0 {
+			s = cs.PrivateEndpoint[0].ConnectionString
+			if csType == PrivateEndpointSRV {
+				s = cs.PrivateEndpoint[0].SRVConnectionString
+			}
+		}
+	default:
+		return "", fmt.Errorf("invalid connection string type %s, use %s, %s, %s, %s, %s or %s", csType, Standard, SRV, NonSRV, Private, PrivateEndpoint, PrivateEndpointSRV)
+	}
+	if s == "" {
+		return "", fmt.Errorf("the cluster has no %s connection string", csType)
+	}
+	return s, nil
+}
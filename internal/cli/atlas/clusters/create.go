@@ -15,6 +15,7 @@
 package clusters
 
 import (
+	"context"
 	"errors"
 
 	"github.com/mongodb/mongocli/internal/cli"
@@ -58,14 +59,26 @@ type CreateOpts struct {
 	store       store.ClusterCreator
 }
 
-func (opts *CreateOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *CreateOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var createTmpl = "Deploying cluster {{.Name}}.\n"
 
+// initMDBVersion defaults the version to the one Atlas deploys by default, Atlas picks it anyway when it's unknown
+func (opts *CreateOpts) initMDBVersion(ctx context.Context) func() error {
+	return func() error {
+		if opts.mdbVersion == "" && opts.filename == "" {
+			opts.mdbVersion, _ = DefaultMongoDBMajorVersion(ctx)
+		}
+		return nil
+	}
+}
+
 func (opts *CreateOpts) Run() error {
 	cluster, err := opts.newCluster()
 	if err != nil {
@@ -225,7 +238,8 @@ Some of the cluster configuration options are available via flags but for full c
 			}
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
+				opts.initMDBVersion(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), createTmpl),
 			)
 		},
@@ -233,14 +247,12 @@ Some of the cluster configuration options are available via flags but for full c
 			return opts.Run()
 		},
 	}
-	currentMDBVersion, _ := DefaultMongoDBMajorVersion()
-
 	cmd.Flags().StringVar(&opts.provider, flag.Provider, "", usage.Provider)
 	cmd.Flags().StringVarP(&opts.region, flag.Region, flag.RegionShort, "", usage.Region)
 	cmd.Flags().Int64VarP(&opts.members, flag.Members, flag.MembersShort, 3, usage.Members)
 	cmd.Flags().StringVar(&opts.tier, flag.Tier, atlasM2, usage.Tier)
 	cmd.Flags().Float64Var(&opts.diskSizeGB, flag.DiskSizeGB, 2, usage.DiskSizeGB)
-	cmd.Flags().StringVar(&opts.mdbVersion, flag.MDBVersion, currentMDBVersion, usage.MDBVersion)
+	cmd.Flags().StringVar(&opts.mdbVersion, flag.MDBVersion, "", usage.MDBVersion)
 	cmd.Flags().BoolVar(&opts.backup, flag.Backup, false, usage.Backup)
 	cmd.Flags().BoolVar(&opts.biConnector, flag.BIConnector, false, usage.BIConnector)
 	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.Filename)
@@ -255,17 +267,11 @@ Some of the cluster configuration options are available via flags but for full c
 	return cmd
 }
 
-var defaultMongoDBMajorVersion string
-
-func DefaultMongoDBMajorVersion() (string, error) {
-	if defaultMongoDBMajorVersion != "" {
-		return defaultMongoDBMajorVersion, nil
-	}
-	s, err := store.NewPrivateUnauth(config.Default())
+// DefaultMongoDBMajorVersion returns the MongoDB version Atlas deploys by default
+func DefaultMongoDBMajorVersion(ctx context.Context) (string, error) {
+	s, err := store.NewPrivateUnauth(config.Default(), store.WithContext(ctx))
 	if err != nil {
 		return "", err
 	}
-	defaultMongoDBMajorVersion, _ = s.DefaultMongoDBVersion()
-
-	return defaultMongoDBMajorVersion, nil
+	return s.DefaultMongoDBVersion()
 }
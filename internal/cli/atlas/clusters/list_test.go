@@ -17,6 +17,7 @@
 package clusters
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -48,11 +49,52 @@ func TestList_Run(t *testing.T) {
 	}
 }
 
+func TestList_RunAllProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockClusterLister(ctrl)
+	mockProjectStore := mocks.NewMockProjectLister(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	listOpts := &ListOpts{
+		store: mockStore,
+	}
+	listOpts.OrgID = "5a0a1e7e0f2912c554080adc"
+	listOpts.AllProjects = true
+	listOpts.ProjectName = "prod-*"
+	listOpts.ProjectStore = mockProjectStore
+	listOpts.OutWriter = buf
+
+	mockProjectStore.
+		EXPECT().
+		GetOrgProjects(listOpts.OrgID, gomock.Any()).
+		Return(&mongodbatlas.Projects{Results: []*mongodbatlas.Project{
+			{ID: "1", Name: "prod-a"},
+			{ID: "2", Name: "dev"},
+		}, TotalCount: 2}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectClusters("1", listOpts.NewListOptions()).
+		Return([]mongodbatlas.Cluster{{ID: "a", Name: "Cluster0", MongoDBVersion: "4.0.23", StateName: "IDLE"}}, nil).
+		Times(1)
+
+	if err := listOpts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `PROJECT   ID    NAME       MDB VER   STATE
+prod-a    a     Cluster0   4.0.23    IDLE
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
 func TestListBuilder(t *testing.T) {
 	test.CmdValidator(
 		t,
 		ListBuilder(),
 		0,
-		[]string{flag.Limit, flag.Page, flag.Output, flag.ProjectID},
+		[]string{flag.Limit, flag.Page, flag.Output, flag.ProjectID, flag.AllProjects, flag.OrgID, flag.ProjectName, flag.ProjectTag},
 	)
 }
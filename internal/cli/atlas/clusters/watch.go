@@ -15,6 +15,10 @@
 package clusters
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -27,53 +31,88 @@ import (
 type WatchOpts struct {
 	cli.GlobalOpts
 	cli.WatchOpts
-	name  string
+	names []string
+	state string
 	store store.AtlasClusterDescriber
 }
 
-func (opts *WatchOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *WatchOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
-func (opts *WatchOpts) watcher() (bool, error) {
-	result, err := opts.store.AtlasCluster(opts.ConfigProjectID(), opts.name)
+const (
+	idle   = "IDLE"
+	paused = "PAUSED"
+)
+
+// watcher returns the state of the cluster, an idle paused cluster is PAUSED.
+func (opts *WatchOpts) watcher(name string) (string, error) {
+	result, err := opts.store.AtlasCluster(opts.ConfigProjectID(), name)
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return result.StateName == "IDLE", nil
+	if result.StateName == idle && result.Paused != nil && *result.Paused && opts.state == paused {
+		return paused, nil
+	}
+	return result.StateName, nil
+}
+
+func (opts *WatchOpts) validateState() error {
+	opts.state = strings.ToUpper(opts.state)
+	switch opts.state {
+	case idle, paused, cli.DeletedState:
+		return nil
+	}
+	return fmt.Errorf("invalid state %s, use %s, %s or %s", opts.state, idle, paused, cli.DeletedState)
 }
 
 func (opts *WatchOpts) Run() error {
-	if err := opts.Watch(opts.watcher); err != nil {
+	if err := opts.WatchStates(opts.state, opts.watcher, opts.names...); err != nil {
 		return err
 	}
 
 	return opts.Print(nil)
 }
 
-// mongocli atlas cluster(s) watch <name> [--projectId projectId]
+// mongocli atlas cluster(s) watch <name>... [--state state] [--interval interval] [--projectId projectId]
 func WatchBuilder() *cobra.Command {
 	opts := &WatchOpts{}
 	cmd := &cobra.Command{
-		Use:   "watch <name>",
-		Short: "Watch for a cluster to be available.",
-		Args:  require.ExactArgs(1),
+		Use:   "watch <name>...",
+		Short: "Watch for one or more clusters to be available.",
+		Long: `Watch for clusters to reach a state, IDLE by default.
+Use --state PAUSED to wait for a paused cluster or --state DELETED to wait for a cluster to be gone.`,
+		Example: `  $ mongocli atlas clusters watch myCluster
+  $ mongocli atlas clusters watch clusterA clusterB --timeout 30m
+  $ mongocli atlas clusters watch myCluster --state DELETED -o json`,
+		Args: require.MinimumNArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
+				opts.validateState,
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), "\nCluster available.\n"),
 			)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.name = args[0]
+			opts.names = args
+			if opts.state != idle {
+				opts.Template = fmt.Sprintf("\nCluster state %s reached.\n", opts.state)
+			}
 			return opts.Run()
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.state, flag.State, idle, usage.WatchState)
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
+
 	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
 
 	return cmd
 }
@@ -34,13 +34,19 @@ func TestWatch_Run(t *testing.T) {
 	expected := &mongodbatlas.Cluster{StateName: "IDLE"}
 
 	opts := &WatchOpts{
-		name:  "test",
+		names: []string{"test", "test2"},
+		state: idle,
 		store: mockStore,
 	}
 
 	mockStore.
 		EXPECT().
-		AtlasCluster(opts.ProjectID, opts.name).
+		AtlasCluster(opts.ProjectID, "test").
+		Return(expected, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		AtlasCluster(opts.ProjectID, "test2").
 		Return(expected, nil).
 		Times(1)
 
@@ -49,11 +55,47 @@ func TestWatch_Run(t *testing.T) {
 	}
 }
 
+func TestWatch_RunPaused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAtlasClusterDescriber(ctrl)
+	defer ctrl.Finish()
+
+	paused := true
+	expected := &mongodbatlas.Cluster{StateName: "IDLE", Paused: &paused}
+
+	opts := &WatchOpts{
+		names: []string{"test"},
+		state: "PAUSED",
+		store: mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		AtlasCluster(opts.ProjectID, "test").
+		Return(expected, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+}
+
+func TestWatch_validateState(t *testing.T) {
+	opts := &WatchOpts{state: "deleted"}
+	if err := opts.validateState(); err != nil || opts.state != "DELETED" {
+		t.Errorf("validateState() = %v, state %s, want DELETED", err, opts.state)
+	}
+	opts.state = "IDEL"
+	if err := opts.validateState(); err == nil {
+		t.Error("validateState() expected an error for an unknown state")
+	}
+}
+
 func TestWatchBuilder(t *testing.T) {
 	test.CmdValidator(
 		t,
 		WatchBuilder(),
 		0,
-		[]string{flag.ProjectID},
+		[]string{flag.ProjectID, flag.Output, flag.State, flag.Interval},
 	)
 }
@@ -0,0 +1,238 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customdbroles
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/convert"
+	"github.com/mongodb/mongocli/internal/file"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	createAction = "create"
+	updateAction = "update"
+	deleteAction = "delete"
+)
+
+var applyTemplate = `ACTION	ROLE NAME	ACTIONS	INHERITED ROLES{{range .}}
+{{.Action}}	{{.RoleName}}	{{.Actions}}	{{.InheritedRoles}}{{end}}
+`
+
+// RoleChange is a custom database role the file creates, updates or deletes
+type RoleChange struct {
+	Action         string `json:"action"`
+	RoleName       string `json:"roleName"`
+	Actions        string `json:"actions,omitempty"`
+	InheritedRoles string `json:"inheritedRoles,omitempty"`
+}
+
+type ApplyOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	cli.PlanOpts
+	filename string
+	prune    bool
+	fs       afero.Fs
+	store    store.DatabaseRoleApplier
+}
+
+func (opts *ApplyOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ApplyOpts) Run() error {
+	roles := new(convert.CustomDBRolesConfig)
+	if err := file.Load(opts.fs, opts.filename, roles); err != nil {
+		return err
+	}
+	if err := roles.Validate(); err != nil {
+		return err
+	}
+
+	r, err := opts.store.DatabaseRoles(opts.ConfigProjectID(), nil)
+	if err != nil {
+		return err
+	}
+	var current []atlas.CustomDBRole
+	if r != nil {
+		current = *r
+	}
+	changes, operations := opts.plan(roles, current)
+	if err := opts.Print(changes); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Apply %d changes to the custom database roles?", len(changes))
+	if ok, err := opts.Proceed(len(changes), message, "Custom database roles not applied"); err != nil || !ok {
+		return err
+	}
+
+	describe := func(i int) string {
+		return fmt.Sprintf("%s %s", changes[i].Action, changes[i].RoleName)
+	}
+	if err := opts.Apply(operations, describe); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(opts.Progress, "Custom database roles applied, %d roles changed.\n", len(changes))
+	return err
+}
+
+// actionNames returns the actions in the action[@dbName.collection] format, one per resource, sorted
+func actionNames(actions []atlas.Action) []string {
+	names := []string{}
+	for _, a := range actions {
+		for _, r := range a.Resources {
+			switch {
+			case r.Cluster != nil && *r.Cluster:
+				names = append(names, a.Action)
+			case r.Collection != "":
+				names = append(names, a.Action+"@"+r.Db+"."+r.Collection)
+			default:
+				names = append(names, a.Action+"@"+r.Db)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// inheritedRoleNames returns the inherited roles in the roleName@dbName format, sorted
+func inheritedRoleNames(roles []atlas.InheritedRole) []string {
+	names := make([]string, len(roles))
+	for i, r := range roles {
+		names[i] = r.Role + "@" + r.Db
+	}
+	sort.Strings(names)
+	return names
+}
+
+// plan creates the missing roles in the order of the file, so roles can inherit from the ones listed before them,
+// and updates the others. With prune, roles not in the file are deleted.
+func (opts *ApplyOpts) plan(desired *convert.CustomDBRolesConfig, current []atlas.CustomDBRole) ([]*RoleChange, []func() error) {
+	changes := []*RoleChange{}
+	var operations []func() error
+	existing := map[string]*atlas.CustomDBRole{}
+	for i := range current {
+		existing[current[i].RoleName] = &current[i]
+	}
+
+	wanted := map[string]bool{}
+	for _, r := range desired.Roles {
+		wanted[r.Name] = true
+		role := r.NewCustomDBRole()
+		actions := actionNames(role.Actions)
+		inherited := inheritedRoleNames(role.InheritedRoles)
+		change := &RoleChange{RoleName: r.Name, Actions: strings.Join(actions, ","), InheritedRoles: strings.Join(inherited, ",")}
+
+		c, ok := existing[r.Name]
+		switch {
+		case !ok:
+			change.Action = createAction
+			operations = append(operations, func() error {
+				_, err := opts.store.CreateDatabaseRole(opts.ConfigProjectID(), role)
+				return err
+			})
+		case !reflect.DeepEqual(actionNames(c.Actions), actions) ||
+			!reflect.DeepEqual(inheritedRoleNames(c.InheritedRoles), inherited):
+			change.Action = updateAction
+			name := role.RoleName
+			role.RoleName = ""
+			operations = append(operations, func() error {
+				_, err := opts.store.UpdateDatabaseRole(opts.ConfigProjectID(), name, role)
+				return err
+			})
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	if !opts.prune {
+		return changes, operations
+	}
+	for i := range current {
+		c := current[i]
+		if wanted[c.RoleName] {
+			continue
+		}
+		changes = append(changes, &RoleChange{
+			Action:         deleteAction,
+			RoleName:       c.RoleName,
+			Actions:        strings.Join(actionNames(c.Actions), ","),
+			InheritedRoles: strings.Join(inheritedRoleNames(c.InheritedRoles), ","),
+		})
+		operations = append(operations, func() error {
+			return opts.store.DeleteDatabaseRole(opts.ConfigProjectID(), c.RoleName)
+		})
+	}
+	return changes, operations
+}
+
+// mongocli atlas customDbRole(s) apply --file roles.yaml [--prune] [--dryRun] [--force] [--projectId projectId]
+func ApplyBuilder() *cobra.Command {
+	opts := &ApplyOpts{
+		fs: afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create, update and delete the custom database roles of your project from a file.",
+		Long: `The file lists roles with their name, actions as action[@dbName.collection] and inherited roles as roleName@dbName.
+Missing roles are created in the order of the file and roles with other actions or inherited roles are updated.
+Roles not in the file are only deleted with --prune. The changes are printed and confirmed before they are applied.`,
+		Example: `  $ mongocli atlas customDbRoles apply --file roles.yaml --dryRun --projectId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.Progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), applyTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.DBRolesFile)
+	cmd.Flags().BoolVar(&opts.prune, flag.Prune, false, usage.DBRolesPrune)
+	cmd.Flags().BoolVar(&opts.DryRun, flag.DryRun, false, usage.ApplyDryRun)
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.File)
+	_ = cmd.MarkFlagFilename(flag.File)
+
+	return cmd
+}
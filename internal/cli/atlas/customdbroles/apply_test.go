@@ -0,0 +1,103 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package customdbroles
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/openlyinc/pointy"
+	"github.com/spf13/afero"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const rolesFile = `roles:
+  - name: reader
+    actions: [FIND@app.orders]
+  - name: monitor
+    actions: [SERVER_STATUS]
+  - name: support
+    inheritedRoles: [reader@admin]
+`
+
+func TestApply_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDatabaseRoleApplier(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &ApplyOpts{
+		OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: buf},
+		filename:   "roles.yaml",
+		prune:      true,
+		fs:         afero.NewMemMapFs(),
+		PlanOpts:   cli.PlanOpts{Confirm: true, Progress: new(bytes.Buffer)},
+		store:      mockStore,
+	}
+	opts.ProjectID = "5a0a1e7e0f2912c554080adc"
+	_ = afero.WriteFile(opts.fs, opts.filename, []byte(rolesFile), 0600)
+
+	current := []atlas.CustomDBRole{
+		{RoleName: "reader", Actions: []atlas.Action{{Action: "FIND", Resources: []atlas.Resource{{Db: "app", Collection: "orders"}}}}},
+		{RoleName: "monitor", Actions: []atlas.Action{{Action: "SERVER_STATUS", Resources: []atlas.Resource{{Cluster: pointy.Bool(true)}}}}, InheritedRoles: []atlas.InheritedRole{}},
+		{RoleName: "legacy", Actions: []atlas.Action{{Action: "INSERT", Resources: []atlas.Resource{{Db: "app"}}}}},
+	}
+	mockStore.
+		EXPECT().
+		DatabaseRoles(opts.ProjectID, nil).
+		Return(&current, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateDatabaseRole(opts.ProjectID, &atlas.CustomDBRole{
+			RoleName:       "support",
+			Actions:        []atlas.Action{},
+			InheritedRoles: []atlas.InheritedRole{{Db: "admin", Role: "reader"}},
+		}).
+		Return(&atlas.CustomDBRole{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DeleteDatabaseRole(opts.ProjectID, "legacy").
+		Return(nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `ACTION   ROLE NAME   ACTIONS      INHERITED ROLES
+create   support                  reader@admin
+delete   legacy      INSERT@app   
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestApplyBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ApplyBuilder(),
+		0,
+		[]string{flag.File, flag.Prune, flag.DryRun, flag.Force, flag.ProjectID, flag.Output},
+	)
+}
@@ -15,6 +15,7 @@
 package customdbroles
 
 import (
+	"context"
 	"errors"
 
 	"github.com/mongodb/mongocli/internal/cli"
@@ -39,10 +40,12 @@ type CreateOpts struct {
 	store          store.DatabaseRoleCreator
 }
 
-func (opts *CreateOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *CreateOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *CreateOpts) Run() error {
@@ -59,7 +62,7 @@ func (opts *CreateOpts) Run() error {
 func (opts *CreateOpts) newCustomDBRole() *atlas.CustomDBRole {
 	return &atlas.CustomDBRole{
 		RoleName:       opts.roleName,
-		Actions:        joinActions(convert.BuildAtlasActions(opts.action)),
+		Actions:        convert.JoinAtlasActions(convert.BuildAtlasActions(opts.action)),
 		InheritedRoles: convert.BuildAtlasInheritedRoles(opts.inheritedRoles),
 	}
 }
@@ -82,7 +85,7 @@ func CreateBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), createTemplate),
 				opts.validate,
 			)
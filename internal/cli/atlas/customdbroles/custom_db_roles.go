@@ -33,6 +33,7 @@ func Builder() *cobra.Command {
 		ListBuilder(),
 		DeleteBuilder(),
 		UpdateBuilder(),
+		ApplyBuilder(),
 	)
 
 	return cmd
@@ -59,19 +60,3 @@ func appendActions(existingActions, newActions []atlas.Action) []atlas.Action {
 	}
 	return out
 }
-
-// joinActions will merge the resources for a same action given actions must be unique.
-func joinActions(newActions []atlas.Action) []atlas.Action {
-	out := make([]atlas.Action, 0)
-	actionMap := make(map[string]atlas.Action)
-	for _, action := range newActions {
-		if a, ok := actionMap[action.Action]; ok {
-			action.Resources = append(action.Resources, a.Resources...)
-		}
-		actionMap[action.Action] = action
-	}
-	for _, action := range actionMap {
-		out = append(out, action)
-	}
-	return out
-}
@@ -28,7 +28,7 @@ func TestBuilder(t *testing.T) {
 	test.CmdValidator(
 		t,
 		Builder(),
-		5,
+		6,
 		[]string{},
 	)
 }
@@ -178,115 +178,3 @@ func Test_appendActions(t *testing.T) {
 		})
 	}
 }
-
-func Test_joinActions(t *testing.T) {
-	type args struct {
-		newActions []mongodbatlas.Action
-	}
-	tests := []struct {
-		name string
-		args args
-		want []mongodbatlas.Action
-	}{
-		{
-			name: "empty",
-			args: args{
-				newActions: []mongodbatlas.Action{},
-			},
-			want: []mongodbatlas.Action{},
-		},
-		{
-			name: "no duplicate",
-			args: args{
-				newActions: []mongodbatlas.Action{
-					{
-						Action: "TEST",
-						Resources: []mongodbatlas.Resource{
-							{
-								Collection: "test",
-								Db:         "test",
-							},
-						},
-					},
-					{
-						Action: "TEST2",
-						Resources: []mongodbatlas.Resource{
-							{
-								Collection: "test",
-								Db:         "test",
-							},
-						},
-					},
-				},
-			},
-			want: []mongodbatlas.Action{
-				{
-					Action: "TEST",
-					Resources: []mongodbatlas.Resource{
-						{
-							Collection: "test",
-							Db:         "test",
-						},
-					},
-				},
-				{
-					Action: "TEST2",
-					Resources: []mongodbatlas.Resource{
-						{
-							Collection: "test",
-							Db:         "test",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "duplicates",
-			args: args{
-				newActions: []mongodbatlas.Action{
-					{
-						Action: "TEST",
-						Resources: []mongodbatlas.Resource{
-							{
-								Collection: "test",
-								Db:         "test",
-							},
-						},
-					},
-					{
-						Action: "TEST",
-						Resources: []mongodbatlas.Resource{
-							{
-								Collection: "test",
-								Db:         "test1",
-							},
-						},
-					},
-				},
-			},
-			want: []mongodbatlas.Action{
-				{
-					Action: "TEST",
-					Resources: []mongodbatlas.Resource{
-						{
-							Collection: "test",
-							Db:         "test1",
-						},
-						{
-							Collection: "test",
-							Db:         "test",
-						},
-					},
-				},
-			},
-		},
-	}
-	for _, tt := range tests {
-		args := tt.args
-		want := tt.want
-		t.Run(tt.name, func(t *testing.T) {
-			got := joinActions(args.newActions)
-			assert.ElementsMatch(t, got, want)
-		})
-	}
-}
@@ -15,6 +15,7 @@
 package customdbroles
 
 import (
+	"context"
 	"errors"
 
 	"github.com/mongodb/mongocli/internal/cli"
@@ -40,10 +41,12 @@ type UpdateOpts struct {
 	store          store.DatabaseRoleUpdater
 }
 
-func (opts *UpdateOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *UpdateOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *UpdateOpts) Run() error {
@@ -67,7 +70,7 @@ func (opts *UpdateOpts) newCustomDBRole(existingRole *atlas.CustomDBRole) *atlas
 	out := &atlas.CustomDBRole{
 		InheritedRoles: convert.BuildAtlasInheritedRoles(opts.inheritedRoles),
 	}
-	actions := joinActions(convert.BuildAtlasActions(opts.action))
+	actions := convert.JoinAtlasActions(convert.BuildAtlasActions(opts.action))
 
 	if opts.append {
 		actions = appendActions(existingRole.Actions, actions)
@@ -95,7 +98,7 @@ func UpdateBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), updateTemplate),
 				opts.validate,
 			)
@@ -0,0 +1,224 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbusers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/convert"
+	"github.com/mongodb/mongocli/internal/file"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	createAction = "create"
+	updateAction = "update"
+	deleteAction = "delete"
+
+	maxItemsPerPage = 500
+)
+
+var applyTemplate = `ACTION	USERNAME	DATABASE	ROLES{{range .}}
+{{.Action}}	{{.Username}}	{{.Database}}	{{.Roles}}{{end}}
+`
+
+// UserChange is a database user the file creates, updates or deletes
+type UserChange struct {
+	Action   string `json:"action"`
+	Username string `json:"username"`
+	Database string `json:"database"`
+	Roles    string `json:"roles,omitempty"`
+}
+
+type ApplyOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	cli.PlanOpts
+	filename string
+	prune    bool
+	fs       afero.Fs
+	store    store.DatabaseUserApplier
+}
+
+func (opts *ApplyOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ApplyOpts) Run() error {
+	users := new(convert.DatabaseUsersConfig)
+	if err := file.Load(opts.fs, opts.filename, users); err != nil {
+		return err
+	}
+	if err := users.Validate(); err != nil {
+		return err
+	}
+	if err := users.ExpandPasswords(); err != nil {
+		return err
+	}
+
+	current, err := opts.currentUsers()
+	if err != nil {
+		return err
+	}
+	changes, operations, err := opts.plan(users, current)
+	if err != nil {
+		return err
+	}
+	if err := opts.Print(changes); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Apply %d changes to the database users?", len(changes))
+	if ok, err := opts.Proceed(len(changes), message, "Database users not applied"); err != nil || !ok {
+		return err
+	}
+
+	describe := func(i int) string {
+		return fmt.Sprintf("%s %s", changes[i].Action, changes[i].Username)
+	}
+	if err := opts.Apply(operations, describe); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(opts.Progress, "Database users applied, %d users changed.\n", len(changes))
+	return err
+}
+
+func (opts *ApplyOpts) currentUsers() ([]atlas.DatabaseUser, error) {
+	var users []atlas.DatabaseUser
+	for page := 1; ; page++ {
+		r, err := opts.store.DatabaseUsers(opts.ConfigProjectID(), &atlas.ListOptions{PageNum: page, ItemsPerPage: maxItemsPerPage})
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, r...)
+		if len(r) < maxItemsPerPage {
+			return users, nil
+		}
+	}
+}
+
+// plan creates the missing users and updates the roles and scopes of the others, passwords of existing users are left as they are.
+// With prune, users not in the file are deleted.
+func (opts *ApplyOpts) plan(desired *convert.DatabaseUsersConfig, current []atlas.DatabaseUser) ([]*UserChange, []func() error, error) {
+	changes := []*UserChange{}
+	var operations []func() error
+	existing := map[string]*atlas.DatabaseUser{}
+	for i := range current {
+		existing[current[i].DatabaseName+"/"+current[i].Username] = &current[i]
+	}
+
+	wanted := map[string]bool{}
+	for _, u := range desired.Users {
+		key := u.AuthDB() + "/" + u.Username
+		wanted[key] = true
+		user := u.NewAtlasDatabaseUser(opts.ConfigProjectID())
+		change := &UserChange{Username: u.Username, Database: u.AuthDB(), Roles: strings.Join(convert.AtlasRoleNames(user.Roles), ",")}
+
+		c, ok := existing[key]
+		switch {
+		case !ok:
+			if user.Password == "" && !u.External() {
+				return nil, nil, fmt.Errorf("user '%s' needs a password to be created", u.Username)
+			}
+			change.Action = createAction
+			operations = append(operations, func() error {
+				_, err := opts.store.CreateDatabaseUser(user)
+				return err
+			})
+		case !reflect.DeepEqual(convert.AtlasRoleNames(c.Roles), convert.AtlasRoleNames(user.Roles)) ||
+			!reflect.DeepEqual(convert.AtlasScopeNames(c.Scopes), convert.AtlasScopeNames(user.Scopes)):
+			change.Action = updateAction
+			user.Password = ""
+			operations = append(operations, func() error {
+				_, err := opts.store.UpdateDatabaseUser(user)
+				return err
+			})
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	if !opts.prune {
+		return changes, operations, nil
+	}
+	for i := range current {
+		c := current[i]
+		if wanted[c.DatabaseName+"/"+c.Username] {
+			continue
+		}
+		changes = append(changes, &UserChange{Action: deleteAction, Username: c.Username, Database: c.DatabaseName, Roles: strings.Join(convert.AtlasRoleNames(c.Roles), ",")})
+		operations = append(operations, func() error {
+			return opts.store.DeleteDatabaseUser(c.DatabaseName, opts.ConfigProjectID(), c.Username)
+		})
+	}
+	return changes, operations, nil
+}
+
+// mongocli atlas dbuser(s) apply --file users.yaml [--prune] [--dryRun] [--force] [--projectId projectId]
+func ApplyBuilder() *cobra.Command {
+	opts := &ApplyOpts{
+		fs: afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create, update and delete the database users of your project from a file.",
+		Long: `The file lists users with their username, authentication database, password, roles as roleName@dbName[.collection]
+and scopes as resourceName:resourceType. A password set to ${VARIABLE} is read from that environment variable.
+Missing users are created and users with other roles or scopes are updated, passwords of existing users are left as they are.
+Users not in the file are only deleted with --prune. The changes are printed and confirmed before they are applied.`,
+		Example: `  $ export APP_PASSWORD=secret
+  $ mongocli atlas dbusers apply --file users.yaml --dryRun --projectId 5e2211c17a3e5a48f5497de3`,
+		Args: require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.Progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), applyTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.DBUsersFile)
+	cmd.Flags().BoolVar(&opts.prune, flag.Prune, false, usage.DBUsersPrune)
+	cmd.Flags().BoolVar(&opts.DryRun, flag.DryRun, false, usage.ApplyDryRun)
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.File)
+	_ = cmd.MarkFlagFilename(flag.File)
+
+	return cmd
+}
@@ -0,0 +1,186 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package dbusers
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/spf13/afero"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const usersFile = `users:
+  - username: app
+    password: ${TEST_APP_PASSWORD}
+    roles: [readWrite@app]
+  - username: reporting
+    password: secret
+    roles: [read@app]
+    scopes: [Cluster0:CLUSTER]
+  - username: ci
+    x509Type: MANAGED
+    roles: [read@app]
+`
+
+func currentUsers() []atlas.DatabaseUser {
+	return []atlas.DatabaseUser{
+		{Username: "reporting", DatabaseName: "admin", Roles: []atlas.Role{{RoleName: "readWrite", DatabaseName: "app"}}},
+		{Username: "ci", DatabaseName: "$external", X509Type: "MANAGED", Roles: []atlas.Role{{RoleName: "read", DatabaseName: "app"}}},
+		{Username: "old", DatabaseName: "admin", Roles: []atlas.Role{{RoleName: "read", DatabaseName: "test"}}},
+	}
+}
+
+func TestApply_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDatabaseUserApplier(ctrl)
+	defer ctrl.Finish()
+
+	_ = os.Setenv("TEST_APP_PASSWORD", "appSecret")
+	defer os.Unsetenv("TEST_APP_PASSWORD")
+
+	buf := new(bytes.Buffer)
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "users.yaml", []byte(usersFile), 0600)
+	opts := &ApplyOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: buf},
+		PlanOpts:   cli.PlanOpts{Confirm: true, Progress: new(bytes.Buffer)},
+		filename:   "users.yaml",
+		prune:      true,
+		fs:         appFS,
+		store:      mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		DatabaseUsers(opts.ProjectID, &atlas.ListOptions{PageNum: 1, ItemsPerPage: maxItemsPerPage}).
+		Return(currentUsers(), nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateDatabaseUser(&atlas.DatabaseUser{
+			GroupID:      opts.ProjectID,
+			Username:     "app",
+			DatabaseName: "admin",
+			Password:     "appSecret",
+			Roles:        []atlas.Role{{RoleName: "readWrite", DatabaseName: "app"}},
+			Scopes:       []atlas.Scope{},
+		}).
+		Return(&atlas.DatabaseUser{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		UpdateDatabaseUser(&atlas.DatabaseUser{
+			GroupID:      opts.ProjectID,
+			Username:     "reporting",
+			DatabaseName: "admin",
+			Roles:        []atlas.Role{{RoleName: "read", DatabaseName: "app"}},
+			Scopes:       []atlas.Scope{{Name: "Cluster0", Type: "CLUSTER"}},
+		}).
+		Return(&atlas.DatabaseUser{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DeleteDatabaseUser("admin", opts.ProjectID, "old").
+		Return(nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `ACTION   USERNAME    DATABASE   ROLES
+create   app         admin      readWrite@app
+update   reporting   admin      read@app
+delete   old         admin      read@test
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestApply_Run_missingPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDatabaseUserApplier(ctrl)
+	defer ctrl.Finish()
+
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "users.yaml", []byte("users:\n  - username: app\n    roles: [read@app]\n"), 0600)
+	opts := &ApplyOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: new(bytes.Buffer)},
+		PlanOpts:   cli.PlanOpts{Confirm: true, Progress: new(bytes.Buffer)},
+		filename:   "users.yaml",
+		fs:         appFS,
+		store:      mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		DatabaseUsers(opts.ProjectID, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	if err := opts.Run(); err == nil {
+		t.Fatal("Run() expected an error for a new user without a password")
+	}
+}
+
+func TestApply_Run_dryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDatabaseUserApplier(ctrl)
+	defer ctrl.Finish()
+
+	_ = os.Setenv("TEST_APP_PASSWORD", "appSecret")
+	defer os.Unsetenv("TEST_APP_PASSWORD")
+
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "users.yaml", []byte(usersFile), 0600)
+	opts := &ApplyOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: new(bytes.Buffer)},
+		PlanOpts:   cli.PlanOpts{DryRun: true, Progress: new(bytes.Buffer)},
+		filename:   "users.yaml",
+		fs:         appFS,
+		store:      mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		DatabaseUsers(opts.ProjectID, gomock.Any()).
+		Return(currentUsers(), nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+}
+
+func TestApplyBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ApplyBuilder(),
+		0,
+		[]string{flag.File, flag.Prune, flag.DryRun, flag.Force, flag.ProjectID, flag.Output},
+	)
+}
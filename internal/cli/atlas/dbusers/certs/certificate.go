@@ -0,0 +1,146 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/spf13/afero"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	managedX509Type = "MANAGED"
+	hoursPerDay     = 24
+	maxItemsPerPage = 500
+)
+
+// CertificateFile is a certificate saved to disk
+type CertificateFile struct {
+	Username string `json:"username"`
+	Path     string `json:"path"`
+	NotAfter string `json:"notAfter,omitempty"`
+}
+
+// parseWindow parses a period in days, like 30d, or a duration, like 72h
+func parseWindow(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil || days <= 0 {
+			return 0, fmt.Errorf("invalid period %s, use days like 30d or a duration like 72h", s)
+		}
+		return time.Duration(days) * hoursPerDay * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid period %s, use days like 30d or a duration like 72h", s)
+	}
+	return d, nil
+}
+
+// notAfter returns the expiration of the first certificate of the PEM document
+func notAfter(certificate string) (time.Time, error) {
+	rest := []byte(certificate)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return time.Time{}, errors.New("no certificate found in the PEM document")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return c.NotAfter.UTC(), nil
+	}
+}
+
+// saveCertificate writes the certificate to <dir>/<username>.pem, readable only by the current user.
+// The file is replaced in a single rename so services never read a partial certificate.
+func saveCertificate(fs afero.Fs, dir, username, certificate string) (*CertificateFile, error) {
+	if err := fs.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, username+".pem")
+	tmp := path + ".tmp"
+	if err := afero.WriteFile(fs, tmp, []byte(certificate), 0600); err != nil {
+		return nil, err
+	}
+	if err := fs.Rename(tmp, path); err != nil {
+		return nil, err
+	}
+	f := &CertificateFile{Username: username, Path: path}
+	if t, err := notAfter(certificate); err == nil {
+		f.NotAfter = t.Format(time.RFC3339)
+	}
+	return f, nil
+}
+
+// managedUsers returns the usernames of the project users with Atlas-managed certificates
+func managedUsers(s store.DatabaseUserLister, projectID string) ([]string, error) {
+	var usernames []string
+	for page := 1; ; page++ {
+		users, err := s.DatabaseUsers(projectID, &atlas.ListOptions{PageNum: page, ItemsPerPage: maxItemsPerPage})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			if u.X509Type == managedX509Type {
+				usernames = append(usernames, u.Username)
+			}
+		}
+		if len(users) < maxItemsPerPage {
+			return usernames, nil
+		}
+	}
+}
+
+// expiresBefore reports whether the certificate expires before the deadline, certificates without a valid date are reported
+func expiresBefore(c *atlas.UserCertificate, deadline time.Time) bool {
+	t, err := time.Parse(time.RFC3339, c.NotAfter)
+	return err != nil || t.Before(deadline)
+}
+
+// allExpireBefore reports whether no certificate stays valid past the deadline, true when there's no certificate
+func allExpireBefore(certs []atlas.UserCertificate, deadline time.Time) bool {
+	for i := range certs {
+		if !expiresBefore(&certs[i], deadline) {
+			return false
+		}
+	}
+	return true
+}
+
+// lastCertificate returns the certificate of the user expiring last, one with only the username when there's none
+func lastCertificate(username string, certs []atlas.UserCertificate) atlas.UserCertificate {
+	last := atlas.UserCertificate{Username: username}
+	for _, c := range certs {
+		if last.NotAfter == "" || c.NotAfter > last.NotAfter {
+			last = c
+		}
+	}
+	return last
+}
@@ -15,12 +15,15 @@
 package certs
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
 	"github.com/mongodb/mongocli/internal/flag"
 	"github.com/mongodb/mongocli/internal/store"
 	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -30,12 +33,16 @@ type CreateOpts struct {
 	store             store.DBUserCertificateCreator
 	username          string
 	monthsUntilExpiry int
+	out               string
+	fs                afero.Fs
 }
 
-func (opts *CreateOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *CreateOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *CreateOpts) Run() error {
@@ -43,24 +50,42 @@ func (opts *CreateOpts) Run() error {
 	if err != nil {
 		return err
 	}
+	if opts.out == "" {
+		return opts.Print(r)
+	}
 
-	return opts.Print(r)
+	f, err := saveCertificate(opts.fs, opts.out, opts.username, r.Certificate)
+	if err != nil {
+		return err
+	}
+	return opts.Print(f)
 }
 
 var createTemplate = "{{.Certificate}}\n"
 
-// mongocli atlas dbuser(s) certs create --username <username> [--monthsUntilExpiration number] [--projectId projectId]
+var saveTemplate = "Certificate of '{{.Username}}' saved to {{.Path}}{{if .NotAfter}}, it expires at {{.NotAfter}}{{end}}.\n"
+
+// mongocli atlas dbuser(s) certs create --username <username> [--monthsUntilExpiration number] [--out dir] [--projectId projectId]
 func CreateBuilder() *cobra.Command {
-	opts := &CreateOpts{}
+	opts := &CreateOpts{
+		fs: afero.NewOsFs(),
+	}
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new Atlas-managed X.509 certificate for the specified database user.",
-		Args:  require.NoArgs,
+		Long: `The certificate and its private key are printed, or saved to <username>.pem in the directory given with --out,
+readable only by you, along with the expiration date of the certificate.`,
+		Example: `  $ mongocli atlas dbusers certs create --username myUser --out ~/.certs --projectId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			tmpl := createTemplate
+			if opts.out != "" {
+				tmpl = saveTemplate
+			}
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
-				opts.InitOutput(cmd.OutOrStdout(), createTemplate),
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), tmpl),
 			)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -70,11 +95,13 @@ func CreateBuilder() *cobra.Command {
 
 	cmd.Flags().IntVar(&opts.monthsUntilExpiry, flag.MonthsUntilExpiration, 3, usage.MonthsUntilExpiration)
 	cmd.Flags().StringVar(&opts.username, flag.Username, "", usage.DatabaseUser)
+	cmd.Flags().StringVar(&opts.out, flag.Out, "", usage.CertOut)
 
 	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
 	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
 
 	_ = cmd.MarkFlagRequired(flag.Username)
+	_ = cmd.MarkFlagDirname(flag.Out)
 
 	return cmd
 }
@@ -17,10 +17,14 @@
 package certs
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/spf13/afero"
 	"go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -51,3 +55,35 @@ func TestCreateBuilder(t *testing.T) {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 }
+
+func TestCreateOpts_Run_out(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDBUserCertificateCreator(ctrl)
+
+	defer ctrl.Finish()
+
+	expires := time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)
+	buf := new(bytes.Buffer)
+	createOpts := &CreateOpts{
+		OutputOpts:        cli.OutputOpts{Template: saveTemplate, OutWriter: buf},
+		store:             mockStore,
+		username:          "user",
+		monthsUntilExpiry: 3,
+		out:               "certs",
+		fs:                afero.NewMemMapFs(),
+	}
+
+	mockStore.
+		EXPECT().
+		CreateDBUserCertificate(createOpts.ProjectID, "user", 3).
+		Return(&mongodbatlas.UserCertificate{Certificate: testCertificate(t, expires)}, nil).
+		Times(1)
+
+	if err := createOpts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := "Certificate of 'user' saved to certs/user.pem, it expires at 2021-08-01T00:00:00Z.\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -15,6 +15,11 @@
 package certs
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -22,49 +27,103 @@ import (
 	"github.com/mongodb/mongocli/internal/store"
 	"github.com/mongodb/mongocli/internal/usage"
 	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 type ListOpts struct {
 	cli.GlobalOpts
 	cli.OutputOpts
 	cli.ListOpts
-	store    store.DBUserCertificateLister
-	username string
+	store          store.DBUserCertificateLister
+	userStore      store.DatabaseUserLister
+	username       string
+	expiringWithin string
+	now            func() time.Time
 }
 
-func (opts *ListOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
+func (opts *ListOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		s, err := store.New(config.Default(), store.WithContext(ctx))
+		opts.store = s
+		opts.userStore = s
+		return err
+	}
+}
+
+func (opts *ListOpts) validate() error {
+	if opts.username == "" && opts.expiringWithin == "" {
+		return fmt.Errorf("a username is required unless --%s is given", flag.ExpiringWithin)
+	}
+	if opts.expiringWithin == "" {
+		return nil
+	}
+	_, err := parseWindow(opts.expiringWithin)
 	return err
 }
 
 func (opts *ListOpts) Run() error {
-	r, err := opts.store.DBUserCertificates(opts.ConfigProjectID(), opts.username)
+	if opts.expiringWithin == "" {
+		r, err := opts.store.DBUserCertificates(opts.ConfigProjectID(), opts.username)
+		if err != nil {
+			return err
+		}
+		return opts.Print(r)
+	}
+
+	window, err := parseWindow(opts.expiringWithin)
 	if err != nil {
 		return err
 	}
+	usernames := []string{opts.username}
+	if opts.username == "" {
+		if usernames, err = managedUsers(opts.userStore, opts.ConfigProjectID()); err != nil {
+			return err
+		}
+	}
 
-	return opts.Print(r)
+	deadline := opts.now().Add(window)
+	expiring := []atlas.UserCertificate{}
+	for _, u := range usernames {
+		r, err := opts.store.DBUserCertificates(opts.ConfigProjectID(), u)
+		if err != nil {
+			return err
+		}
+		// a user is only reported when no certificate stays valid past the deadline, the way renew does
+		if allExpireBefore(r, deadline) {
+			expiring = append(expiring, lastCertificate(u, r))
+		}
+	}
+	sort.SliceStable(expiring, func(i, j int) bool { return expiring[i].NotAfter < expiring[j].NotAfter })
+	return opts.Print(expiring)
 }
 
-var listTemplate = `ID USERNAME	SUBJECT CREATED AT{{range .}}
-{{.ID}}	{{.Username}}	{{.Subject}}	{{.CreatedAt}}{{end}}
+var listTemplate = `ID	USERNAME	SUBJECT	CREATED AT	NOT AFTER{{range .}}
+{{.ID}}	{{.Username}}	{{.Subject}}	{{.CreatedAt}}	{{.NotAfter}}{{end}}
 `
 
-// mongocli atlas dbuser(s) certs list|ls <username> [--projectId projectId]
+// mongocli atlas dbuser(s) certs list|ls [<username>] [--expiringWithin period] [--projectId projectId]
 func ListBuilder() *cobra.Command {
-	opts := &ListOpts{}
+	opts := &ListOpts{
+		now: time.Now,
+	}
 	cmd := &cobra.Command{
-		Use:     "list <username>",
+		Use:     "list [<username>]",
 		Aliases: []string{"ls"},
 		Short:   "List of all Atlas-managed, unexpired certificates for a database user.",
-		Args:    require.ExactArgs(1),
+		Long: `With --expiringWithin only the users left without a certificate valid past that period are listed,
+with their certificate expiring last, soonest first.
+Without a username, the certificates of every user with Atlas-managed certificates are checked.`,
+		Example: `  $ mongocli atlas dbusers certs list --expiringWithin 30d --projectId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.MaximumNArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			opts.username = args[0]
+			if len(args) > 0 {
+				opts.username = args[0]
+			}
 
 			return opts.PreRunE(
+				opts.validate,
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), listTemplate),
 			)
 		},
@@ -73,6 +132,8 @@ func ListBuilder() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.expiringWithin, flag.ExpiringWithin, "", usage.CertExpiringWithin)
+
 	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
 	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
 
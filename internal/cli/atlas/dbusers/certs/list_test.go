@@ -17,9 +17,12 @@
 package certs
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/mocks"
 	"go.mongodb.org/atlas/mongodbatlas"
 )
@@ -49,3 +52,54 @@ func TestListBuilder(t *testing.T) {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 }
+
+func TestListOpts_Run_expiringWithin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDBUserCertificateRenewer(ctrl)
+
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	listOpts := &ListOpts{
+		OutputOpts:     cli.OutputOpts{Template: listTemplate, OutWriter: buf},
+		store:          mockStore,
+		userStore:      mockStore,
+		expiringWithin: "30d",
+		now:            func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) },
+	}
+
+	mockStore.
+		EXPECT().
+		DatabaseUsers(listOpts.ProjectID, gomock.Any()).
+		Return([]mongodbatlas.DatabaseUser{
+			{Username: "user", X509Type: managedX509Type},
+			{Username: "expiring", X509Type: managedX509Type},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DBUserCertificates(listOpts.ProjectID, "user").
+		Return([]mongodbatlas.UserCertificate{
+			{Username: "user", NotAfter: "2021-08-01T00:00:00Z"},
+			{Username: "user", NotAfter: "2021-05-10T00:00:00Z"},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DBUserCertificates(listOpts.ProjectID, "expiring").
+		Return([]mongodbatlas.UserCertificate{
+			{Username: "expiring", NotAfter: "2021-05-20T00:00:00Z"},
+			{Username: "expiring", NotAfter: "2021-05-10T00:00:00Z"},
+		}, nil).
+		Times(1)
+
+	if err := listOpts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `ID      USERNAME   SUBJECT   CREATED AT   NOT AFTER
+<nil>   expiring                          2021-05-20T00:00:00Z
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
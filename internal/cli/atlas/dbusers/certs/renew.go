@@ -0,0 +1,145 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"context"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var renewTemplate = `USERNAME	PATH	NOT AFTER{{range .}}
+{{.Username}}	{{.Path}}	{{.NotAfter}}{{end}}
+`
+
+type RenewOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	usernames         []string
+	expiringWithin    string
+	monthsUntilExpiry int
+	out               string
+	now               func() time.Time
+	fs                afero.Fs
+	store             store.DBUserCertificateRenewer
+}
+
+func (opts *RenewOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *RenewOpts) validate() error {
+	_, err := parseWindow(opts.expiringWithin)
+	return err
+}
+
+// needsRenewal reports whether the newest unexpired certificate of the user expires before the deadline,
+// users left with no unexpired certificate are renewed too.
+func (opts *RenewOpts) needsRenewal(username string, deadline time.Time) (bool, error) {
+	r, err := opts.store.DBUserCertificates(opts.ConfigProjectID(), username)
+	if err != nil {
+		return false, err
+	}
+	return allExpireBefore(r, deadline), nil
+}
+
+func (opts *RenewOpts) Run() error {
+	usernames := opts.usernames
+	if len(usernames) == 0 {
+		window, err := parseWindow(opts.expiringWithin)
+		if err != nil {
+			return err
+		}
+		managed, err := managedUsers(opts.store, opts.ConfigProjectID())
+		if err != nil {
+			return err
+		}
+		deadline := opts.now().Add(window)
+		for _, u := range managed {
+			renew, err := opts.needsRenewal(u, deadline)
+			if err != nil {
+				return err
+			}
+			if renew {
+				usernames = append(usernames, u)
+			}
+		}
+	}
+
+	renewed := []*CertificateFile{}
+	for _, u := range usernames {
+		r, err := opts.store.CreateDBUserCertificate(opts.ConfigProjectID(), u, opts.monthsUntilExpiry)
+		if err != nil {
+			return err
+		}
+		f, err := saveCertificate(opts.fs, opts.out, u, r.Certificate)
+		if err != nil {
+			return err
+		}
+		renewed = append(renewed, f)
+	}
+	return opts.Print(renewed)
+}
+
+// mongocli atlas dbuser(s) certs renew [<username>...] --out dir [--expiringWithin period] [--monthsUntilExpiration number] [--projectId projectId]
+func RenewBuilder() *cobra.Command {
+	opts := &RenewOpts{
+		now: time.Now,
+		fs:  afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "renew [<username>...]",
+		Short: "Create replacement Atlas-managed X.509 certificates before the current ones expire.",
+		Long: `Without usernames, every user with Atlas-managed certificates whose newest certificate expires within --expiringWithin
+is renewed, so the command can run on a schedule. The given users are always renewed.
+The new certificates replace <username>.pem in the directory given with --out, readable only by you. Previous certificates stay valid until they expire.`,
+		Example: `  $ mongocli atlas dbusers certs renew --expiringWithin 30d --out /etc/mongodb/certs --projectId 5e2211c17a3e5a48f5497de3`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.usernames = args
+			return opts.PreRunE(
+				opts.validate,
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), renewTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.expiringWithin, flag.ExpiringWithin, "30d", usage.CertRenewExpiringWithin)
+	cmd.Flags().IntVar(&opts.monthsUntilExpiry, flag.MonthsUntilExpiration, 3, usage.MonthsUntilExpiration)
+	cmd.Flags().StringVar(&opts.out, flag.Out, "", usage.CertRenewOut)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.Out)
+	_ = cmd.MarkFlagDirname(flag.Out)
+
+	return cmd
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package certs
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/spf13/afero"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const projectID = "5a0a1e7e0f2912c554080adc"
+
+func testCertificate(t *testing.T, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "user"},
+		NotBefore:    notAfter.AddDate(0, -3, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestParseWindow(t *testing.T) {
+	tests := map[string]time.Duration{
+		"30d": 30 * hoursPerDay * time.Hour,
+		"72h": 72 * time.Hour,
+	}
+	for input, want := range tests {
+		if got, err := parseWindow(input); err != nil || got != want {
+			t.Errorf("parseWindow(%s) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"d", "-1d", "soon"} {
+		if _, err := parseWindow(input); err == nil {
+			t.Errorf("parseWindow(%s) expected an error", input)
+		}
+	}
+}
+
+func TestRenew_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDBUserCertificateRenewer(ctrl)
+	defer ctrl.Finish()
+
+	now := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	opts := &RenewOpts{
+		OutputOpts:        cli.OutputOpts{Template: renewTemplate, OutWriter: new(bytes.Buffer)},
+		expiringWithin:    "30d",
+		monthsUntilExpiry: 3,
+		out:               "/certs",
+		now:               func() time.Time { return now },
+		fs:                afero.NewMemMapFs(),
+		store:             mockStore,
+	}
+	opts.ProjectID = projectID
+
+	mockStore.
+		EXPECT().
+		DatabaseUsers(projectID, &atlas.ListOptions{PageNum: 1, ItemsPerPage: maxItemsPerPage}).
+		Return([]atlas.DatabaseUser{
+			{Username: "expiring", X509Type: managedX509Type},
+			{Username: "fresh", X509Type: managedX509Type},
+			{Username: "password"},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DBUserCertificates(projectID, "expiring").
+		Return([]atlas.UserCertificate{{Username: "expiring", NotAfter: "2021-05-10T00:00:00Z"}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DBUserCertificates(projectID, "fresh").
+		Return([]atlas.UserCertificate{
+			{Username: "fresh", NotAfter: "2021-05-10T00:00:00Z"},
+			{Username: "fresh", NotAfter: "2021-08-01T00:00:00Z"},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateDBUserCertificate(projectID, "expiring", 3).
+		Return(&atlas.UserCertificate{Certificate: testCertificate(t, now.AddDate(0, 3, 0))}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	info, err := opts.fs.Stat("/certs/expiring.pem")
+	if err != nil {
+		t.Fatalf("expected the renewed certificate to be saved: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("got permissions %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestRenewBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		RenewBuilder(),
+		0,
+		[]string{flag.ExpiringWithin, flag.MonthsUntilExpiration, flag.Out, flag.ProjectID, flag.Output},
+	)
+}
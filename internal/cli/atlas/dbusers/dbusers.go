@@ -37,6 +37,8 @@ A user’s roles apply to all the clusters in the project.`,
 		CreateBuilder(),
 		DeleteBuilder(),
 		UpdateBuilder(),
+		RotatePasswordBuilder(),
+		ApplyBuilder(),
 		certs.Builder(),
 	)
 
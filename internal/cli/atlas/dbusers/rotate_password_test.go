@@ -0,0 +1,136 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// +build unit
+
+package dbusers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/convert"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/spf13/afero"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestRotatePassword_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDatabaseUserPasswordRotator(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &RotatePasswordOpts{
+		OutputOpts: cli.OutputOpts{Template: rotatePasswordTemplate, OutWriter: buf},
+		username:   "myUser",
+		store:      mockStore,
+	}
+	opts.ProjectID = "5a0a1e7e0f2912c554080adc"
+
+	mockStore.
+		EXPECT().
+		DatabaseUser(convert.AdminDB, opts.ProjectID, opts.username).
+		Return(&atlas.DatabaseUser{Username: opts.username, DatabaseName: convert.AdminDB, X509Type: noAuthType}, nil).
+		Times(1)
+	var password string
+	mockStore.
+		EXPECT().
+		UpdateDatabaseUser(gomock.Any()).
+		DoAndReturn(func(u *atlas.DatabaseUser) (*atlas.DatabaseUser, error) {
+			password = u.Password
+			return u, nil
+		}).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if password == "" {
+		t.Fatal("expected a new password")
+	}
+	if got, want := buf.String(), "New password of database user 'myUser': "+password+"\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRotatePassword_RunSaveFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDatabaseUserPasswordRotator(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &RotatePasswordOpts{
+		OutputOpts:     cli.OutputOpts{Template: rotatePasswordTemplate, OutWriter: buf},
+		CredentialOpts: cli.CredentialOpts{Out: "credential.json", Fs: afero.NewReadOnlyFs(afero.NewMemMapFs())},
+		username:       "myUser",
+		store:          mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		DatabaseUser(convert.AdminDB, opts.ProjectID, opts.username).
+		Return(&atlas.DatabaseUser{Username: opts.username, DatabaseName: convert.AdminDB, X509Type: noAuthType}, nil).
+		Times(1)
+	var password string
+	mockStore.
+		EXPECT().
+		UpdateDatabaseUser(gomock.Any()).
+		DoAndReturn(func(u *atlas.DatabaseUser) (*atlas.DatabaseUser, error) {
+			password = u.Password
+			return u, nil
+		}).
+		Times(1)
+
+	if err := opts.Run(); err == nil || !strings.Contains(err.Error(), "not saved") {
+		t.Fatalf("Run() expected a save error, got %v", err)
+	}
+	if !strings.Contains(buf.String(), password) {
+		t.Errorf("expected the rotated password to be printed, got %q", buf.String())
+	}
+}
+
+func TestRotatePassword_RunX509(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockDatabaseUserPasswordRotator(ctrl)
+	defer ctrl.Finish()
+
+	opts := &RotatePasswordOpts{
+		username: "myUser",
+		store:    mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		DatabaseUser(convert.AdminDB, opts.ProjectID, opts.username).
+		Return(&atlas.DatabaseUser{Username: opts.username, X509Type: "MANAGED"}, nil).
+		Times(1)
+
+	if err := opts.Run(); err == nil || !strings.Contains(err.Error(), "password") {
+		t.Errorf("Run() expected a password error, got %v", err)
+	}
+}
+
+func TestRotatePasswordBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		RotatePasswordBuilder(),
+		0,
+		[]string{flag.Out, flag.CredentialHelper, flag.ProjectID, flag.Output},
+	)
+}
@@ -15,6 +15,8 @@
 package maintenance
 
 import (
+	"context"
+
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/config"
@@ -32,10 +34,12 @@ type ClearOpts struct {
 	store   store.MaintenanceWindowClearer
 }
 
-func (opts *ClearOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *ClearOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var clearTemplate = "Maintenance window removed.\n"
@@ -69,7 +73,7 @@ func ClearBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), clearTemplate),
 				opts.Prompt,
 			)
@@ -15,6 +15,8 @@
 package metrics
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -33,10 +35,12 @@ type DatabasesListsOpts struct {
 	store store.ProcessDatabaseLister
 }
 
-func (opts *DatabasesListsOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *DatabasesListsOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var databasesListTemplate = `{{range .Results}}
@@ -64,7 +68,7 @@ func DatabasesListBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), databasesListTemplate),
 			)
 		},
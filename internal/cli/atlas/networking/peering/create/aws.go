@@ -15,6 +15,7 @@
 package create
 
 import (
+	"context"
 	"strings"
 
 	"github.com/mongodb/mongocli/internal/cli"
@@ -38,10 +39,12 @@ type AWSOpts struct {
 	store               store.AWSPeeringConnectionCreator
 }
 
-func (opts *AWSOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *AWSOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *AWSOpts) Run() error {
@@ -124,7 +127,7 @@ func AwsBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), createTemplate),
 			)
 		},
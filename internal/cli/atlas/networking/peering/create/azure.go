@@ -15,6 +15,7 @@
 package create
 
 import (
+	"context"
 	"strings"
 
 	"github.com/mongodb/mongocli/internal/cli"
@@ -39,10 +40,12 @@ type AzureOpts struct {
 	store          store.AzurePeeringConnectionCreator
 }
 
-func (opts *AzureOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *AzureOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var createTemplate = "Network peering connection '{{.ID}}' created.\n"
@@ -124,7 +127,7 @@ func AzureBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), createTemplate),
 			)
 		},
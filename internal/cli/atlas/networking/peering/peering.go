@@ -16,6 +16,7 @@ package peering
 
 import (
 	"github.com/mongodb/mongocli/internal/cli/atlas/networking/peering/create"
+	"github.com/mongodb/mongocli/internal/cli/atlas/networking/peering/setup"
 	"github.com/spf13/cobra"
 )
 
@@ -29,6 +30,7 @@ func Builder() *cobra.Command {
 	cmd.AddCommand(
 		ListBuilder(),
 		create.Builder(),
+		setup.Builder(),
 		WatchBuilder(),
 		DeleteBuilder(),
 	)
@@ -0,0 +1,273 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	provider          = "AWS"
+	pendingAcceptance = "PENDING_ACCEPTANCE"
+	available         = "AVAILABLE"
+	failed            = "FAILED"
+)
+
+var awsTemplate = `{{if eq .StatusName "AVAILABLE"}}
+Peering connection '{{.ID}}' to {{.VpcID}} is AVAILABLE.
+{{else}}
+Peering connection '{{.ID}}' is {{.StatusName}}, accept it and route the Atlas CIDR block through it with the AWS CLI:
+
+{{.AWSCLI}}
+
+or with Terraform:
+
+{{.Terraform}}
+
+Then wait for the peering connection to be available:
+
+{{.Next}}
+{{end}}`
+
+// AWSPeering is a peering connection with an AWS VPC and the steps left to use it
+type AWSPeering struct {
+	*atlas.Peer
+	AtlasCIDRBlock string `json:"atlasCidrBlock,omitempty"`
+	AWSCLI         string `json:"awsCli,omitempty"`
+	Terraform      string `json:"terraform,omitempty"`
+	Next           string `json:"-"`
+}
+
+type AWSOpts struct {
+	cli.GlobalOpts
+	cli.WatchOpts
+	region              string
+	accountID           string
+	vpcID               string
+	routeTableCidrBlock string
+	routeTableID        string
+	atlasCIDRBlock      string
+	progress            io.Writer
+	store               store.AWSPeeringConnectionProvisioner
+}
+
+func (opts *AWSOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+// atlasRegion returns the region as Atlas names it, US_EAST_1 for us-east-1
+func atlasRegion(region string) string {
+	return strings.ReplaceAll(strings.ToUpper(region), "-", "_")
+}
+
+// awsRegion returns the region as AWS names it, us-east-1 for US_EAST_1
+func awsRegion(region string) string {
+	return strings.ReplaceAll(strings.ToLower(region), "_", "-")
+}
+
+// container returns the container of the region, creating it when there's none,
+// as there can only be one per provider and region
+func (opts *AWSOpts) container() (*atlas.Container, error) {
+	r, err := opts.store.AWSContainers(opts.ConfigProjectID())
+	if err != nil {
+		return nil, err
+	}
+	for i := range r {
+		if r[i].RegionName == atlasRegion(opts.region) {
+			_, err = fmt.Fprintf(opts.progress, "Using container '%s' of region %s.\n", r[i].ID, r[i].RegionName)
+			return &r[i], err
+		}
+	}
+	if opts.atlasCIDRBlock == "" {
+		return nil, fmt.Errorf("there's no container in region %s, use --%s to create one", atlasRegion(opts.region), flag.AtlasCIDRBlock)
+	}
+	c, err := opts.store.CreateContainer(opts.ConfigProjectID(), &atlas.Container{
+		AtlasCIDRBlock: opts.atlasCIDRBlock,
+		RegionName:     atlasRegion(opts.region),
+		ProviderName:   provider,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(opts.progress, "Container '%s' created.\n", c.ID)
+	return c, err
+}
+
+// peer returns the peering connection of the container with the VPC, creating it when there's none
+func (opts *AWSOpts) peer(containerID string) (*atlas.Peer, error) {
+	r, err := opts.store.PeeringConnections(opts.ConfigProjectID(), &atlas.ContainersListOptions{ProviderName: provider})
+	if err != nil {
+		return nil, err
+	}
+	for i := range r {
+		if r[i].ContainerID == containerID && r[i].VpcID == opts.vpcID && r[i].AWSAccountID == opts.accountID {
+			_, err = fmt.Fprintf(opts.progress, "Using peering connection '%s' to %s.\n", r[i].ID, opts.vpcID)
+			return &r[i], err
+		}
+	}
+	p, err := opts.store.CreatePeeringConnection(opts.ConfigProjectID(), &atlas.Peer{
+		AccepterRegionName:  awsRegion(opts.region),
+		AWSAccountID:        opts.accountID,
+		ContainerID:         containerID,
+		RouteTableCIDRBlock: opts.routeTableCidrBlock,
+		VpcID:               opts.vpcID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(opts.progress, "Peering connection '%s' created.\n", p.ID)
+	return p, err
+}
+
+// waitForPeer waits for the peering connection to be ready for AWS to accept it
+func (opts *AWSOpts) waitForPeer(id string) (*atlas.Peer, error) {
+	var r *atlas.Peer
+	err := opts.Watch(func() (bool, error) {
+		var err error
+		r, err = opts.store.PeeringConnection(opts.ConfigProjectID(), id)
+		if err != nil {
+			return false, err
+		}
+		return r.StatusName == pendingAcceptance || r.StatusName == available || r.StatusName == failed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusName == failed {
+		return nil, fmt.Errorf("peering connection %s failed: %s", id, r.ErrorStateName)
+	}
+	return r, nil
+}
+
+// allowVPC adds the CIDR block of the VPC to the access list of the project
+func (opts *AWSOpts) allowVPC() error {
+	if _, err := opts.store.CreateProjectIPAccessList([]*atlas.ProjectIPAccessList{{
+		GroupID:   opts.ConfigProjectID(),
+		CIDRBlock: opts.routeTableCidrBlock,
+		Comment:   fmt.Sprintf("VPC peering %s", opts.vpcID),
+	}}); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(opts.progress, "Access list entry '%s' added.\n", opts.routeTableCidrBlock)
+	return err
+}
+
+// newAWSPeering returns the AWS CLI commands and the Terraform resources that accept the peering connection
+// and route the Atlas CIDR block through it, and the command that waits for it to be available
+func (opts *AWSOpts) newAWSPeering(p *atlas.Peer, c *atlas.Container) *AWSPeering {
+	routeTableID := opts.routeTableID
+	if routeTableID == "" {
+		routeTableID = "<RouteTableId>"
+	}
+	awsCLI := fmt.Sprintf(`aws ec2 accept-vpc-peering-connection --region %[1]s --vpc-peering-connection-id %[2]s
+aws ec2 create-route --region %[1]s --route-table-id %[3]s --destination-cidr-block %[4]s --vpc-peering-connection-id %[2]s`,
+		awsRegion(opts.region), p.ConnectionID, routeTableID, c.AtlasCIDRBlock)
+	terraform := fmt.Sprintf(`resource "aws_vpc_peering_connection_accepter" "atlas" {
+  vpc_peering_connection_id = %[1]q
+  auto_accept               = true
+}
+
+resource "aws_route" "atlas" {
+  route_table_id            = %[2]q
+  destination_cidr_block    = %[3]q
+  vpc_peering_connection_id = %[1]q
+}`, p.ConnectionID, routeTableID, c.AtlasCIDRBlock)
+	return &AWSPeering{
+		Peer:           p,
+		AtlasCIDRBlock: c.AtlasCIDRBlock,
+		AWSCLI:         awsCLI,
+		Terraform:      terraform,
+		Next:           fmt.Sprintf("mongocli atlas networking peering watch %s --projectId %s", p.ID, opts.ConfigProjectID()),
+	}
+}
+
+func (opts *AWSOpts) Run() error {
+	c, err := opts.container()
+	if err != nil {
+		return err
+	}
+	p, err := opts.peer(c.ID)
+	if err != nil {
+		return err
+	}
+	if p, err = opts.waitForPeer(p.ID); err != nil {
+		return err
+	}
+	if err := opts.allowVPC(); err != nil {
+		return err
+	}
+	return opts.Print(opts.newAWSPeering(p, c))
+}
+
+// mongocli atlas networking peering setup aws --region region --accountId accountId --vpcId vpcId --routeTableCidrBlock cidrBlock
+// [--routeTableId routeTableId] [--atlasCidrBlock cidrBlock] [--projectId projectId]
+func AWSBuilder() *cobra.Command {
+	opts := &AWSOpts{}
+	cmd := &cobra.Command{
+		Use:   "aws",
+		Short: "Connect your AWS VPC to your project with a network peering connection.",
+		Long: `The container of the region is created, or reused when there's one already, then the peering connection with your VPC is created, or reused, and the command waits for AWS to be able to accept it.
+The CIDR block of your VPC is added to the access list of the project.
+It then prints the AWS CLI commands and the Terraform resources that accept the peering connection and route the Atlas CIDR block through it.`,
+		Example: `  $ mongocli atlas networking peering setup aws --region us-east-1 --accountId 123456789012 --vpcId vpc-0e5d1a9d3c0a1b2c3 --routeTableCidrBlock 10.0.0.0/16 --routeTableId rtb-0a1b2c3d --atlasCidrBlock 192.168.248.0/21`,
+		Args:    require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), awsTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.region, flag.Region, "", usage.ContainerRegion)
+	cmd.Flags().StringVar(&opts.accountID, flag.AccountID, "", usage.AccountID)
+	cmd.Flags().StringVar(&opts.vpcID, flag.VpcID, "", usage.VpcID)
+	cmd.Flags().StringVar(&opts.routeTableCidrBlock, flag.RouteTableCidrBlock, "", usage.RouteTableCidrBlock)
+	cmd.Flags().StringVar(&opts.routeTableID, flag.RouteTableID, "", usage.RouteTableID)
+	cmd.Flags().StringVar(&opts.atlasCIDRBlock, flag.AtlasCIDRBlock, "", usage.AtlasCIDRBlock)
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.Region)
+	_ = cmd.MarkFlagRequired(flag.AccountID)
+	_ = cmd.MarkFlagRequired(flag.VpcID)
+	_ = cmd.MarkFlagRequired(flag.RouteTableCidrBlock)
+
+	return cmd
+}
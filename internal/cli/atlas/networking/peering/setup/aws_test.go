@@ -0,0 +1,137 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package setup
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestAWSOpts_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAWSPeeringConnectionProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &AWSOpts{
+		GlobalOpts:          cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:           cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: awsTemplate, OutWriter: buf}},
+		region:              "us-east-1",
+		accountID:           "123456789012",
+		vpcID:               "vpc-1",
+		routeTableCidrBlock: "10.0.0.0/16",
+		routeTableID:        "rtb-1",
+		atlasCIDRBlock:      "192.168.248.0/21",
+		progress:            new(bytes.Buffer),
+		store:               mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		AWSContainers(opts.ProjectID).
+		Return([]atlas.Container{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateContainer(opts.ProjectID, &atlas.Container{AtlasCIDRBlock: "192.168.248.0/21", RegionName: "US_EAST_1", ProviderName: provider}).
+		Return(&atlas.Container{ID: "c1", AtlasCIDRBlock: "192.168.248.0/21"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PeeringConnections(opts.ProjectID, &atlas.ContainersListOptions{ProviderName: provider}).
+		Return([]atlas.Peer{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreatePeeringConnection(opts.ProjectID, &atlas.Peer{
+			AccepterRegionName:  "us-east-1",
+			AWSAccountID:        "123456789012",
+			ContainerID:         "c1",
+			RouteTableCIDRBlock: "10.0.0.0/16",
+			VpcID:               "vpc-1",
+		}).
+		Return(&atlas.Peer{ID: "p1", StatusName: "INITIATING"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PeeringConnection(opts.ProjectID, "p1").
+		Return(&atlas.Peer{ID: "p1", ConnectionID: "pcx-1", StatusName: pendingAcceptance}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateProjectIPAccessList([]*atlas.ProjectIPAccessList{{GroupID: opts.ProjectID, CIDRBlock: "10.0.0.0/16", Comment: "VPC peering vpc-1"}}).
+		Return(&atlas.ProjectIPAccessLists{}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"aws ec2 accept-vpc-peering-connection --region us-east-1 --vpc-peering-connection-id pcx-1\n",
+		"aws ec2 create-route --region us-east-1 --route-table-id rtb-1 --destination-cidr-block 192.168.248.0/21 --vpc-peering-connection-id pcx-1\n",
+		"mongocli atlas networking peering watch p1",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("got\n%s\nwant it to contain\n%s", buf.String(), want)
+		}
+	}
+}
+
+func TestAWSOpts_RunNoContainer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAWSPeeringConnectionProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	opts := &AWSOpts{
+		GlobalOpts:          cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:           cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: awsTemplate, OutWriter: new(bytes.Buffer)}},
+		region:              "us-east-1",
+		accountID:           "123456789012",
+		vpcID:               "vpc-1",
+		routeTableCidrBlock: "10.0.0.0/16",
+		routeTableID:        "rtb-1",
+		progress:            new(bytes.Buffer),
+		store:               mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		AWSContainers(opts.ProjectID).
+		Return([]atlas.Container{{ID: "c1", RegionName: "EU_WEST_1"}}, nil).
+		Times(1)
+
+	if err := opts.Run(); err == nil {
+		t.Fatal("Run() expected an error without a container or an Atlas CIDR block")
+	}
+}
+
+func TestAWSBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		AWSBuilder(),
+		0,
+		[]string{flag.Region, flag.AccountID, flag.VpcID, flag.RouteTableCidrBlock, flag.RouteTableID, flag.AtlasCIDRBlock, flag.Interval, flag.ProjectID, flag.Output},
+	)
+}
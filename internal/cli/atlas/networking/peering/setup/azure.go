@@ -0,0 +1,304 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	azureProvider = "AZURE"
+	// atlasAzureAppID is the application of the service principal Atlas peers VNets with
+	atlasAzureAppID = "e90a1407-55c3-432d-9cb1-3638900a9d22"
+)
+
+var azureTemplate = `{{if .Peer}}
+Peering connection '{{.ID}}' to {{.VNetName}} is {{.Status}}.
+{{else}}
+Container '{{.ContainerID}}' is ready, grant Atlas access to your VNet with the Azure CLI:
+
+{{.AzureCLI}}
+
+or with Terraform:
+
+{{.Terraform}}
+
+Then create the peering connection:
+
+{{.Next}}
+{{end}}`
+
+// AzurePeering is a peering connection with an Azure VNet, or the steps left to create it
+type AzurePeering struct {
+	*atlas.Peer
+	ContainerID    string `json:"containerId,omitempty"`
+	AtlasCIDRBlock string `json:"atlasCidrBlock,omitempty"`
+	AzureCLI       string `json:"azureCli,omitempty"`
+	Terraform      string `json:"terraform,omitempty"`
+	Next           string `json:"-"`
+}
+
+type AzureOpts struct {
+	cli.GlobalOpts
+	cli.WatchOpts
+	region         string
+	directoryID    string
+	subscriptionID string
+	resourceGroup  string
+	vNetName       string
+	atlasCIDRBlock string
+	accessGranted  bool
+	progress       io.Writer
+	store          store.AzurePeeringConnectionProvisioner
+}
+
+func (opts *AzureOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+// container returns the container of the region, creating it when there's none,
+// as there can only be one per provider and region
+func (opts *AzureOpts) container() (*atlas.Container, error) {
+	r, err := opts.store.AzureContainers(opts.ConfigProjectID())
+	if err != nil {
+		return nil, err
+	}
+	for i := range r {
+		if r[i].Region == atlasRegion(opts.region) {
+			_, err = fmt.Fprintf(opts.progress, "Using container '%s' of region %s.\n", r[i].ID, r[i].Region)
+			return &r[i], err
+		}
+	}
+	if opts.atlasCIDRBlock == "" {
+		return nil, fmt.Errorf("there's no container in region %s, use --%s to create one", atlasRegion(opts.region), flag.AtlasCIDRBlock)
+	}
+	c, err := opts.store.CreateContainer(opts.ConfigProjectID(), &atlas.Container{
+		AtlasCIDRBlock: opts.atlasCIDRBlock,
+		Region:         atlasRegion(opts.region),
+		ProviderName:   azureProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(opts.progress, "Container '%s' created.\n", c.ID)
+	return c, err
+}
+
+// peer returns the peering connection of the container with the VNet, nil when there's none
+func (opts *AzureOpts) peer(containerID string) (*atlas.Peer, error) {
+	r, err := opts.store.PeeringConnections(opts.ConfigProjectID(), &atlas.ContainersListOptions{ProviderName: azureProvider})
+	if err != nil {
+		return nil, err
+	}
+	for i := range r {
+		if r[i].ContainerID == containerID &&
+			r[i].AzureSubscriptionID == opts.subscriptionID &&
+			strings.EqualFold(r[i].ResourceGroupName, opts.resourceGroup) &&
+			r[i].VNetName == opts.vNetName {
+			_, err = fmt.Fprintf(opts.progress, "Using peering connection '%s' to %s.\n", r[i].ID, opts.vNetName)
+			return &r[i], err
+		}
+	}
+	return nil, nil
+}
+
+func (opts *AzureOpts) createPeer(containerID string) (*atlas.Peer, error) {
+	p, err := opts.store.CreatePeeringConnection(opts.ConfigProjectID(), &atlas.Peer{
+		AzureDirectoryID:    opts.directoryID,
+		AzureSubscriptionID: opts.subscriptionID,
+		ContainerID:         containerID,
+		ProviderName:        azureProvider,
+		ResourceGroupName:   strings.ToLower(opts.resourceGroup),
+		VNetName:            opts.vNetName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(opts.progress, "Peering connection '%s' created.\n", p.ID)
+	return p, err
+}
+
+// waitForPeer waits for Azure to peer the VNet
+func (opts *AzureOpts) waitForPeer(id string) (*atlas.Peer, error) {
+	var r *atlas.Peer
+	err := opts.Watch(func() (bool, error) {
+		var err error
+		r, err = opts.store.PeeringConnection(opts.ConfigProjectID(), id)
+		if err != nil {
+			return false, err
+		}
+		return r.Status == available || r.Status == failed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == failed {
+		return nil, fmt.Errorf("peering connection %s failed: %s", id, r.ErrorState)
+	}
+	return r, nil
+}
+
+func quote(values []string) string {
+	q := make([]string, len(values))
+	for i, v := range values {
+		q[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(q, ", ")
+}
+
+// newAzureAccess returns the Azure CLI commands and the Terraform resources that let Atlas peer the VNet,
+// and the command that creates the peering connection then
+func (opts *AzureOpts) newAzureAccess(c *atlas.Container) *AzurePeering {
+	scope := fmt.Sprintf(
+		"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s",
+		opts.subscriptionID,
+		opts.resourceGroup,
+		opts.vNetName,
+	)
+	role := fmt.Sprintf("AtlasPeering/%s/%s/%s", opts.subscriptionID, opts.resourceGroup, opts.vNetName)
+	actions := []string{
+		"Microsoft.Network/virtualNetworks/virtualNetworkPeerings/read",
+		"Microsoft.Network/virtualNetworks/virtualNetworkPeerings/write",
+		"Microsoft.Network/virtualNetworks/virtualNetworkPeerings/delete",
+		"Microsoft.Network/virtualNetworks/peer/action",
+	}
+	azureCLI := fmt.Sprintf(`az ad sp create --id %[1]s
+az role definition create --role-definition '{"Name": %[2]q, "IsCustom": true, "Description": "Grants MongoDB Atlas access to manage peering connections on network %[3]s", "Actions": [%[4]s], "AssignableScopes": [%[3]q]}'
+az role assignment create --role %[2]q --assignee %[1]s --scope %[3]s`,
+		atlasAzureAppID, role, scope, quote(actions))
+	terraform := fmt.Sprintf(`resource "azuread_service_principal" "atlas" {
+  application_id = %[1]q
+}
+
+resource "azurerm_role_definition" "atlas" {
+  name              = %[2]q
+  scope             = %[3]q
+  assignable_scopes = [%[3]q]
+
+  permissions {
+    actions = [%[4]s]
+  }
+}
+
+resource "azurerm_role_assignment" "atlas" {
+  scope              = %[3]q
+  role_definition_id = azurerm_role_definition.atlas.role_definition_resource_id
+  principal_id       = azuread_service_principal.atlas.id
+}`, atlasAzureAppID, role, scope, quote(actions))
+	next := fmt.Sprintf(
+		"mongocli atlas networking peering setup azure --region %s --directoryId %s --subscriptionId %s --resourceGroup %s --vnet %s --%s --projectId %s",
+		atlasRegion(opts.region),
+		opts.directoryID,
+		opts.subscriptionID,
+		opts.resourceGroup,
+		opts.vNetName,
+		flag.AccessGranted,
+		opts.ConfigProjectID(),
+	)
+	return &AzurePeering{
+		ContainerID:    c.ID,
+		AtlasCIDRBlock: c.AtlasCIDRBlock,
+		AzureCLI:       azureCLI,
+		Terraform:      terraform,
+		Next:           next,
+	}
+}
+
+func (opts *AzureOpts) Run() error {
+	c, err := opts.container()
+	if err != nil {
+		return err
+	}
+	p, err := opts.peer(c.ID)
+	if err != nil {
+		return err
+	}
+	if p == nil && !opts.accessGranted {
+		return opts.Print(opts.newAzureAccess(c))
+	}
+	if p == nil {
+		if p, err = opts.createPeer(c.ID); err != nil {
+			return err
+		}
+	}
+	if p, err = opts.waitForPeer(p.ID); err != nil {
+		return err
+	}
+	return opts.Print(&AzurePeering{Peer: p, ContainerID: c.ID, AtlasCIDRBlock: c.AtlasCIDRBlock})
+}
+
+// mongocli atlas networking peering setup azure --region region --directoryId directoryId --subscriptionId subscriptionId
+// --resourceGroup resourceGroup --vnet vnet [--atlasCidrBlock cidrBlock] [--accessGranted] [--projectId projectId]
+func AzureBuilder() *cobra.Command {
+	opts := &AzureOpts{}
+	cmd := &cobra.Command{
+		Use:   "azure",
+		Short: "Connect your Azure VNet to your project with a network peering connection.",
+		Long: `The container of the region is created, or reused when there's one already.
+Atlas can only peer your VNet once granted access to it, the command prints the Azure CLI commands and the Terraform resources that do that.
+Then run the command again with --accessGranted to create the peering connection, or reuse it, and wait for it to be available.`,
+		Example: `  $ mongocli atlas networking peering setup azure --region US_EAST_2 --directoryId 4e2a7f1c --subscriptionId 9b1d3e5f --resourceGroup myGroup --vnet myVNet --atlasCidrBlock 192.168.248.0/21
+  $ mongocli atlas networking peering setup azure --region US_EAST_2 --directoryId 4e2a7f1c --subscriptionId 9b1d3e5f --resourceGroup myGroup --vnet myVNet --accessGranted`,
+		Args: require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), azureTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.region, flag.Region, "", usage.ContainerRegion)
+	cmd.Flags().StringVar(&opts.directoryID, flag.DirectoryID, "", usage.DirectoryID)
+	cmd.Flags().StringVar(&opts.subscriptionID, flag.SubscriptionID, "", usage.SubscriptionID)
+	cmd.Flags().StringVar(&opts.resourceGroup, flag.ResourceGroup, "", usage.ResourceGroup)
+	cmd.Flags().StringVar(&opts.vNetName, flag.VNet, "", usage.VNet)
+	cmd.Flags().StringVar(&opts.atlasCIDRBlock, flag.AtlasCIDRBlock, "", usage.AtlasCIDRBlock)
+	cmd.Flags().BoolVar(&opts.accessGranted, flag.AccessGranted, false, usage.AccessGranted)
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.Region)
+	_ = cmd.MarkFlagRequired(flag.DirectoryID)
+	_ = cmd.MarkFlagRequired(flag.SubscriptionID)
+	_ = cmd.MarkFlagRequired(flag.ResourceGroup)
+	_ = cmd.MarkFlagRequired(flag.VNet)
+
+	return cmd
+}
@@ -0,0 +1,145 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package setup
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestAzureOpts_RunGrantAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAzurePeeringConnectionProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &AzureOpts{
+		GlobalOpts:     cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:      cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: azureTemplate, OutWriter: buf}},
+		region:         "US_EAST_2",
+		directoryID:    "d1",
+		subscriptionID: "s1",
+		resourceGroup:  "myGroup",
+		vNetName:       "myVNet",
+		atlasCIDRBlock: "192.168.248.0/21",
+		progress:       new(bytes.Buffer),
+		store:          mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		AzureContainers(opts.ProjectID).
+		Return([]atlas.Container{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateContainer(opts.ProjectID, &atlas.Container{AtlasCIDRBlock: "192.168.248.0/21", Region: "US_EAST_2", ProviderName: azureProvider}).
+		Return(&atlas.Container{ID: "c1", AtlasCIDRBlock: "192.168.248.0/21"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PeeringConnections(opts.ProjectID, &atlas.ContainersListOptions{ProviderName: azureProvider}).
+		Return([]atlas.Peer{}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"az ad sp create --id " + atlasAzureAppID + "\n",
+		`az role assignment create --role "AtlasPeering/s1/myGroup/myVNet" --assignee ` + atlasAzureAppID + " --scope /subscriptions/s1/resourceGroups/myGroup/providers/Microsoft.Network/virtualNetworks/myVNet\n",
+		"--vnet myVNet --accessGranted",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("got\n%s\nwant it to contain\n%s", buf.String(), want)
+		}
+	}
+}
+
+func TestAzureOpts_RunAccessGranted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAzurePeeringConnectionProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &AzureOpts{
+		GlobalOpts:     cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:      cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: azureTemplate, OutWriter: buf}},
+		region:         "US_EAST_2",
+		directoryID:    "d1",
+		subscriptionID: "s1",
+		resourceGroup:  "myGroup",
+		vNetName:       "myVNet",
+		atlasCIDRBlock: "192.168.248.0/21",
+		progress:       new(bytes.Buffer),
+		accessGranted:  true,
+		store:          mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		AzureContainers(opts.ProjectID).
+		Return([]atlas.Container{{ID: "c1", Region: "US_EAST_2"}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PeeringConnections(opts.ProjectID, &atlas.ContainersListOptions{ProviderName: azureProvider}).
+		Return([]atlas.Peer{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreatePeeringConnection(opts.ProjectID, &atlas.Peer{
+			AzureDirectoryID:    "d1",
+			AzureSubscriptionID: "s1",
+			ContainerID:         "c1",
+			ProviderName:        azureProvider,
+			ResourceGroupName:   "mygroup",
+			VNetName:            "myVNet",
+		}).
+		Return(&atlas.Peer{ID: "p1", Status: "ADDING_PEER"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PeeringConnection(opts.ProjectID, "p1").
+		Return(&atlas.Peer{ID: "p1", VNetName: "myVNet", Status: available}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := "\nPeering connection 'p1' to myVNet is AVAILABLE.\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestAzureBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		AzureBuilder(),
+		0,
+		[]string{flag.Region, flag.DirectoryID, flag.SubscriptionID, flag.ResourceGroup, flag.VNet, flag.AtlasCIDRBlock, flag.AccessGranted, flag.Interval, flag.ProjectID, flag.Output},
+	)
+}
@@ -0,0 +1,233 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setup
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	gcpProvider    = "GCP"
+	waitingForUser = "WAITING_FOR_USER"
+)
+
+var gcpTemplate = `{{if eq .Status "AVAILABLE"}}
+Peering connection '{{.ID}}' to {{.NetworkName}} is AVAILABLE.
+{{else}}
+Peering connection '{{.ID}}' is {{.Status}}, peer your network with the Atlas one with the Google Cloud CLI:
+
+{{.GCloud}}
+
+or with Terraform:
+
+{{.Terraform}}
+
+Then check the peering connection is available:
+
+{{.Next}}
+{{end}}`
+
+// GCPPeering is a peering connection with a GCP network and the steps left to use it
+type GCPPeering struct {
+	*atlas.Peer
+	AtlasGCPProjectID string `json:"atlasGcpProjectId,omitempty"`
+	AtlasNetworkName  string `json:"atlasNetworkName,omitempty"`
+	GCloud            string `json:"gcloud,omitempty"`
+	Terraform         string `json:"terraform,omitempty"`
+	Next              string `json:"-"`
+}
+
+type GCPOpts struct {
+	cli.GlobalOpts
+	cli.WatchOpts
+	gcpProjectID   string
+	network        string
+	regions        []string
+	atlasCIDRBlock string
+	progress       io.Writer
+	store          store.GCPPeeringConnectionProvisioner
+}
+
+func (opts *GCPOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+// container returns the container of the project, creating it when there's none,
+// as there can only be one for GCP
+func (opts *GCPOpts) container() (*atlas.Container, error) {
+	r, err := opts.store.GCPContainers(opts.ConfigProjectID())
+	if err != nil {
+		return nil, err
+	}
+	if len(r) > 0 {
+		_, err = fmt.Fprintf(opts.progress, "Using container '%s'.\n", r[0].ID)
+		return &r[0], err
+	}
+	if opts.atlasCIDRBlock == "" {
+		return nil, fmt.Errorf("there's no container, use --%s to create one", flag.AtlasCIDRBlock)
+	}
+	c, err := opts.store.CreateContainer(opts.ConfigProjectID(), &atlas.Container{
+		AtlasCIDRBlock: opts.atlasCIDRBlock,
+		Regions:        opts.regions,
+		ProviderName:   gcpProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(opts.progress, "Container '%s' created.\n", c.ID)
+	return c, err
+}
+
+// peer returns the peering connection of the container with the network, creating it when there's none
+func (opts *GCPOpts) peer(containerID string) (*atlas.Peer, error) {
+	r, err := opts.store.PeeringConnections(opts.ConfigProjectID(), &atlas.ContainersListOptions{ProviderName: gcpProvider})
+	if err != nil {
+		return nil, err
+	}
+	for i := range r {
+		if r[i].ContainerID == containerID && r[i].GCPProjectID == opts.gcpProjectID && r[i].NetworkName == opts.network {
+			_, err = fmt.Fprintf(opts.progress, "Using peering connection '%s' to %s.\n", r[i].ID, opts.network)
+			return &r[i], err
+		}
+	}
+	p, err := opts.store.CreatePeeringConnection(opts.ConfigProjectID(), &atlas.Peer{
+		ContainerID:  containerID,
+		GCPProjectID: opts.gcpProjectID,
+		NetworkName:  opts.network,
+		ProviderName: gcpProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(opts.progress, "Peering connection '%s' created.\n", p.ID)
+	return p, err
+}
+
+// waitForPeer waits for the peering connection to wait for the network to peer with the Atlas one
+func (opts *GCPOpts) waitForPeer(id string) (*atlas.Peer, error) {
+	var r *atlas.Peer
+	err := opts.Watch(func() (bool, error) {
+		var err error
+		r, err = opts.store.PeeringConnection(opts.ConfigProjectID(), id)
+		if err != nil {
+			return false, err
+		}
+		return r.Status == waitingForUser || r.Status == available || r.Status == failed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == failed {
+		return nil, fmt.Errorf("peering connection %s failed: %s", id, r.ErrorMessage)
+	}
+	return r, nil
+}
+
+// newGCPPeering returns the Google Cloud CLI command and the Terraform resource that peer the network
+// with the Atlas one, and the command that checks the peering connection then
+func (opts *GCPOpts) newGCPPeering(p *atlas.Peer, c *atlas.Container) *GCPPeering {
+	name := "atlas-" + p.ID
+	gcloud := fmt.Sprintf(
+		"gcloud compute networks peerings create %s --project %s --network %s --peer-project %s --peer-network %s",
+		name,
+		opts.gcpProjectID,
+		opts.network,
+		c.GCPProjectID,
+		c.NetworkName,
+	)
+	terraform := fmt.Sprintf(`resource "google_compute_network_peering" "atlas" {
+  name         = %q
+  network      = "projects/%s/global/networks/%s"
+  peer_network = "projects/%s/global/networks/%s"
+}`, name, opts.gcpProjectID, opts.network, c.GCPProjectID, c.NetworkName)
+	return &GCPPeering{
+		Peer:              p,
+		AtlasGCPProjectID: c.GCPProjectID,
+		AtlasNetworkName:  c.NetworkName,
+		GCloud:            gcloud,
+		Terraform:         terraform,
+		Next:              fmt.Sprintf("mongocli atlas networking peering list --provider %s --projectId %s", gcpProvider, opts.ConfigProjectID()),
+	}
+}
+
+func (opts *GCPOpts) Run() error {
+	c, err := opts.container()
+	if err != nil {
+		return err
+	}
+	p, err := opts.peer(c.ID)
+	if err != nil {
+		return err
+	}
+	if p, err = opts.waitForPeer(p.ID); err != nil {
+		return err
+	}
+	return opts.Print(opts.newGCPPeering(p, c))
+}
+
+// mongocli atlas networking peering setup gcp --gcpProjectId gcpProjectId --network network
+// [--atlasCidrBlock cidrBlock] [--region region[,region]] [--projectId projectId]
+func GCPBuilder() *cobra.Command {
+	opts := &GCPOpts{}
+	cmd := &cobra.Command{
+		Use:   "gcp",
+		Short: "Connect your GCP network to your project with a network peering connection.",
+		Long: `The container of the project is created, or reused when there's one already, then the peering connection with your network is created, or reused, and the command waits for Atlas to be ready to peer.
+It then prints the Google Cloud CLI command and the Terraform resource that peer your network with the Atlas one.`,
+		Example: `  $ mongocli atlas networking peering setup gcp --gcpProjectId my-project --network default --atlasCidrBlock 192.168.0.0/18`,
+		Args:    require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), gcpTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.gcpProjectID, flag.GCPProjectID, "", usage.GCPProjectID)
+	cmd.Flags().StringVar(&opts.network, flag.Network, "", usage.Network)
+	cmd.Flags().StringVar(&opts.atlasCIDRBlock, flag.AtlasCIDRBlock, "", usage.AtlasCIDRBlock)
+	cmd.Flags().StringSliceVar(&opts.regions, flag.Region, []string{}, usage.ContainerRegions)
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.GCPProjectID)
+	_ = cmd.MarkFlagRequired(flag.Network)
+
+	return cmd
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package setup
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestGCPOpts_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockGCPPeeringConnectionProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &GCPOpts{
+		WatchOpts:    cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: gcpTemplate, OutWriter: buf}},
+		gcpProjectID: "my-project",
+		network:      "default",
+		progress:     new(bytes.Buffer),
+		store:        mockStore,
+	}
+	opts.ProjectID = "5a0a1e7e0f2912c554080adc"
+
+	container := atlas.Container{ID: "c1", GCPProjectID: "atlas-project", NetworkName: "nt-1"}
+	mockStore.
+		EXPECT().
+		GCPContainers(opts.ProjectID).
+		Return([]atlas.Container{container}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PeeringConnections(opts.ProjectID, &atlas.ContainersListOptions{ProviderName: gcpProvider}).
+		Return([]atlas.Peer{{ID: "p1", ContainerID: "c1", GCPProjectID: "my-project", NetworkName: "default", Status: "ADDING_PEER"}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PeeringConnection(opts.ProjectID, "p1").
+		Return(&atlas.Peer{ID: "p1", Status: waitingForUser}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"gcloud compute networks peerings create atlas-p1 --project my-project --network default --peer-project atlas-project --peer-network nt-1\n",
+		`peer_network = "projects/atlas-project/global/networks/nt-1"`,
+		"mongocli atlas networking peering list --provider GCP",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("got\n%s\nwant it to contain\n%s", buf.String(), want)
+		}
+	}
+}
+
+func TestGCPBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		GCPBuilder(),
+		0,
+		[]string{flag.GCPProjectID, flag.Network, flag.AtlasCIDRBlock, flag.Region, flag.Interval, flag.ProjectID, flag.Output},
+	)
+}
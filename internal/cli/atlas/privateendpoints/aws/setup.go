@@ -0,0 +1,279 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	waitingForUser = "WAITING_FOR_USER"
+	available      = "AVAILABLE"
+	failed         = "FAILED"
+	rejected       = "REJECTED"
+)
+
+var setupTemplate = `{{if .InterfaceEndpoint}}
+Interface endpoint '{{.InterfaceEndpoint.InterfaceEndpointID}}' of private endpoint service '{{.ID}}' is {{.InterfaceEndpoint.AWSConnectionStatus}}.
+{{else}}
+Private endpoint service '{{.ID}}' is ready, create the interface endpoint in your VPC with the AWS CLI:
+
+{{.AWSCLI}}
+
+or with Terraform:
+
+{{.Terraform}}
+
+Then add the interface endpoint to the private endpoint service:
+
+{{.Next}}
+{{end}}`
+
+// Setup is the private endpoint service of a region and the steps left to connect a VPC to it
+type Setup struct {
+	*atlas.PrivateEndpointConnection
+	InterfaceEndpoint *atlas.InterfaceEndpointConnection `json:"interfaceEndpoint,omitempty"`
+	AWSCLI            string                             `json:"awsCli,omitempty"`
+	Terraform         string                             `json:"terraform,omitempty"`
+	Next              string                             `json:"-"`
+}
+
+type SetupOpts struct {
+	cli.GlobalOpts
+	cli.WatchOpts
+	region            string
+	vpcID             string
+	subnetIDs         []string
+	securityGroupIDs  []string
+	privateEndpointID string
+	progress          io.Writer
+	store             store.PrivateEndpointProvisioner
+}
+
+func (opts *SetupOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+// awsRegion returns the region as AWS names it, Atlas also accepts US_EAST_1 for us-east-1
+func awsRegion(region string) string {
+	return strings.ReplaceAll(strings.ToLower(region), "_", "-")
+}
+
+// endpointService returns the private endpoint service of the region, creating it when there's none,
+// as there can only be one per region
+func (opts *SetupOpts) endpointService() (*atlas.PrivateEndpointConnection, error) {
+	r, err := opts.store.PrivateEndpointService(opts.ConfigProjectID(), provider, opts.region)
+	if err != nil {
+		return nil, err
+	}
+	if r != nil {
+		_, err = fmt.Fprintf(opts.progress, "Using private endpoint service '%s' of region %s.\n", r.ID, awsRegion(opts.region))
+		return r, err
+	}
+	created, err := opts.store.CreatePrivateEndpoint(opts.ConfigProjectID(), &atlas.PrivateEndpointConnection{
+		ProviderName: provider,
+		Region:       awsRegion(opts.region),
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(opts.progress, "Private endpoint service '%s' created.\n", created.ID)
+	return created, err
+}
+
+// waitForEndpointService waits for the private endpoint service to accept interface endpoints
+func (opts *SetupOpts) waitForEndpointService(id string) (*atlas.PrivateEndpointConnection, error) {
+	var r *atlas.PrivateEndpointConnection
+	err := opts.Watch(func() (bool, error) {
+		var err error
+		r, err = opts.store.PrivateEndpoint(opts.ConfigProjectID(), provider, id)
+		if err != nil {
+			return false, err
+		}
+		return r.Status == waitingForUser || r.Status == available || r.Status == failed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == failed {
+		return nil, fmt.Errorf("private endpoint service %s failed: %s", id, r.ErrorMessage)
+	}
+	return r, nil
+}
+
+// addInterfaceEndpoint adds the interface endpoint of the VPC to the private endpoint service,
+// unless it was already added, and waits for AWS to accept it
+func (opts *SetupOpts) addInterfaceEndpoint(service *atlas.PrivateEndpointConnection) (*atlas.InterfaceEndpointConnection, error) {
+	if !contains(service.InterfaceEndpoints, opts.privateEndpointID) {
+		if _, err := opts.store.CreateInterfaceEndpoint(
+			opts.ConfigProjectID(),
+			provider,
+			service.ID,
+			&atlas.InterfaceEndpointConnection{ID: opts.privateEndpointID},
+		); err != nil {
+			return nil, err
+		}
+		if _, err := fmt.Fprintf(opts.progress, "Interface endpoint '%s' added to private endpoint service '%s'.\n", opts.privateEndpointID, service.ID); err != nil {
+			return nil, err
+		}
+	}
+	var r *atlas.InterfaceEndpointConnection
+	err := opts.Watch(func() (bool, error) {
+		var err error
+		r, err = opts.store.InterfaceEndpoint(opts.ConfigProjectID(), provider, service.ID, opts.privateEndpointID)
+		if err != nil {
+			return false, err
+		}
+		return r.AWSConnectionStatus == available || r.AWSConnectionStatus == rejected, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.AWSConnectionStatus == rejected {
+		return nil, fmt.Errorf("interface endpoint %s was rejected: %s", opts.privateEndpointID, r.ErrorMessage)
+	}
+	return r, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func quote(values []string) string {
+	q := make([]string, len(values))
+	for i, v := range values {
+		q[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(q, ", ")
+}
+
+// newSetup returns the AWS CLI command and the Terraform resource that create the interface endpoint
+// in the VPC, and the command that adds it to the private endpoint service
+func (opts *SetupOpts) newSetup(service *atlas.PrivateEndpointConnection) *Setup {
+	awsCLI := fmt.Sprintf(
+		"aws ec2 create-vpc-endpoint --region %s --vpc-id %s --vpc-endpoint-type Interface --service-name %s --subnet-ids %s",
+		awsRegion(opts.region),
+		opts.vpcID,
+		service.EndpointServiceName,
+		strings.Join(opts.subnetIDs, " "),
+	)
+	terraform := fmt.Sprintf(`resource "aws_vpc_endpoint" "atlas" {
+  vpc_id             = %q
+  service_name       = %q
+  vpc_endpoint_type  = "Interface"
+  subnet_ids         = [%s]
+`, opts.vpcID, service.EndpointServiceName, quote(opts.subnetIDs))
+	if len(opts.securityGroupIDs) > 0 {
+		awsCLI += " --security-group-ids " + strings.Join(opts.securityGroupIDs, " ")
+		terraform += fmt.Sprintf("  security_group_ids = [%s]\n", quote(opts.securityGroupIDs))
+	}
+	terraform += "}"
+	next := fmt.Sprintf(
+		"mongocli atlas privateEndpoints aws setup --region %s --vpcId %s --subnetIds %s --%s <VpcEndpointId> --projectId %s",
+		awsRegion(opts.region),
+		opts.vpcID,
+		strings.Join(opts.subnetIDs, ","),
+		flag.PrivateEndpointID,
+		opts.ConfigProjectID(),
+	)
+	return &Setup{
+		PrivateEndpointConnection: service,
+		AWSCLI:                    awsCLI,
+		Terraform:                 terraform,
+		Next:                      next,
+	}
+}
+
+func (opts *SetupOpts) Run() error {
+	service, err := opts.endpointService()
+	if err != nil {
+		return err
+	}
+	if service, err = opts.waitForEndpointService(service.ID); err != nil {
+		return err
+	}
+	setup := opts.newSetup(service)
+	if opts.privateEndpointID != "" {
+		if setup.InterfaceEndpoint, err = opts.addInterfaceEndpoint(service); err != nil {
+			return err
+		}
+	}
+	return opts.Print(setup)
+}
+
+// mongocli atlas privateEndpoint(s) aws setup --region region --vpcId vpcId --subnetIds subnetId[,subnetId]
+// [--securityGroupIds securityGroupId[,securityGroupId]] [--privateEndpointId vpcEndpointId] [--projectId projectId]
+func SetupBuilder() *cobra.Command {
+	opts := &SetupOpts{}
+	cmd := &cobra.Command{
+		Use:   "setup",
+		Short: "Connect your AWS VPC to your project with a private endpoint.",
+		Long: `The private endpoint service of the region is created, or reused when there's one already, and the command waits for it to be ready.
+It then prints the AWS CLI command and the Terraform resource that create the interface endpoint in your VPC.
+Once the interface endpoint is created, run the command again with --privateEndpointId to add it to the private endpoint service and wait for it to be available.`,
+		Example: `  $ mongocli atlas privateEndpoints aws setup --region us-east-1 --vpcId vpc-0e5d1a9d3c0a1b2c3 --subnetIds subnet-0a1b2c3d,subnet-4e5f6a7b
+  $ mongocli atlas privateEndpoints aws setup --region us-east-1 --vpcId vpc-0e5d1a9d3c0a1b2c3 --subnetIds subnet-0a1b2c3d,subnet-4e5f6a7b --privateEndpointId vpce-0b1c2d3e4f5a6b7c8`,
+		Args: require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), setupTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.region, flag.Region, "", usage.SetupRegion)
+	cmd.Flags().StringVar(&opts.vpcID, flag.VpcID, "", usage.SetupVpcID)
+	cmd.Flags().StringSliceVar(&opts.subnetIDs, flag.SubnetIDs, []string{}, usage.SubnetIDs)
+	cmd.Flags().StringSliceVar(&opts.securityGroupIDs, flag.SecurityGroupIDs, []string{}, usage.SecurityGroupIDs)
+	cmd.Flags().StringVar(&opts.privateEndpointID, flag.PrivateEndpointID, "", usage.SetupPrivateEndpointID)
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.Region)
+	_ = cmd.MarkFlagRequired(flag.VpcID)
+	_ = cmd.MarkFlagRequired(flag.SubnetIDs)
+
+	return cmd
+}
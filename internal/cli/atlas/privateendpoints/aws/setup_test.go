@@ -0,0 +1,133 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package aws
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestSetup_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockPrivateEndpointProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &SetupOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:  cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: setupTemplate, OutWriter: buf}},
+		region:     "US_EAST_1",
+		vpcID:      "vpc-1",
+		subnetIDs:  []string{"subnet-1", "subnet-2"},
+		progress:   new(bytes.Buffer),
+		store:      mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		PrivateEndpointService(opts.ProjectID, provider, "US_EAST_1").
+		Return(nil, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreatePrivateEndpoint(opts.ProjectID, &atlas.PrivateEndpointConnection{ProviderName: provider, Region: "us-east-1"}).
+		Return(&atlas.PrivateEndpointConnection{ID: "1", Status: "INITIATING"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PrivateEndpoint(opts.ProjectID, provider, "1").
+		Return(&atlas.PrivateEndpointConnection{ID: "1", Status: waitingForUser, EndpointServiceName: "com.amazonaws.vpce.us-east-1.vpce-svc-1"}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"aws ec2 create-vpc-endpoint --region us-east-1 --vpc-id vpc-1 --vpc-endpoint-type Interface --service-name com.amazonaws.vpce.us-east-1.vpce-svc-1 --subnet-ids subnet-1 subnet-2\n",
+		`  subnet_ids         = ["subnet-1", "subnet-2"]`,
+		"--privateEndpointId <VpcEndpointId>",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("got\n%s\nwant it to contain\n%s", buf.String(), want)
+		}
+	}
+}
+
+func TestSetup_RunInterfaceEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockPrivateEndpointProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &SetupOpts{
+		GlobalOpts:        cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:         cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: setupTemplate, OutWriter: buf}},
+		region:            "US_EAST_1",
+		vpcID:             "vpc-1",
+		subnetIDs:         []string{"subnet-1", "subnet-2"},
+		progress:          new(bytes.Buffer),
+		privateEndpointID: "vpce-1",
+		store:             mockStore,
+	}
+
+	service := atlas.PrivateEndpointConnection{ID: "1", Region: "us-east-1", Status: waitingForUser}
+	mockStore.
+		EXPECT().
+		PrivateEndpointService(opts.ProjectID, provider, "US_EAST_1").
+		Return(&service, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PrivateEndpoint(opts.ProjectID, provider, "1").
+		Return(&service, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateInterfaceEndpoint(opts.ProjectID, provider, "1", &atlas.InterfaceEndpointConnection{ID: "vpce-1"}).
+		Return(&atlas.InterfaceEndpointConnection{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		InterfaceEndpoint(opts.ProjectID, provider, "1", "vpce-1").
+		Return(&atlas.InterfaceEndpointConnection{InterfaceEndpointID: "vpce-1", AWSConnectionStatus: available}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := "\nInterface endpoint 'vpce-1' of private endpoint service '1' is AVAILABLE.\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSetupBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		SetupBuilder(),
+		0,
+		[]string{flag.Region, flag.VpcID, flag.SubnetIDs, flag.SecurityGroupIDs, flag.PrivateEndpointID, flag.Interval, flag.ProjectID, flag.Output},
+	)
+}
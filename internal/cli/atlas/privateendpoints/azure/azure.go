@@ -33,6 +33,7 @@ func Builder() *cobra.Command {
 		CreateBuilder(),
 		DeleteBuilder(),
 		WatchBuilder(),
+		SetupBuilder(),
 		interfaces.Builder(),
 	)
 
@@ -0,0 +1,286 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	waitingForUser = "WAITING_FOR_USER"
+	available      = "AVAILABLE"
+	failed         = "FAILED"
+)
+
+var setupTemplate = `{{if .PrivateEndpoint}}
+Private endpoint '{{.PrivateEndpoint.PrivateEndpointResourceID}}' of private endpoint service '{{.ID}}' is {{.PrivateEndpoint.AzureStatus}}.
+{{else}}
+Private endpoint service '{{.ID}}' is ready, create the private endpoint in your VNet with the Azure CLI:
+
+{{.AzureCLI}}
+
+or with Terraform:
+
+{{.Terraform}}
+
+Then add the private endpoint to the private endpoint service:
+
+{{.Next}}
+{{end}}`
+
+// Setup is the private endpoint service of a region and the steps left to connect a VNet to it
+type Setup struct {
+	*atlas.PrivateEndpointConnection
+	PrivateEndpoint *atlas.InterfaceEndpointConnection `json:"privateEndpoint,omitempty"`
+	AzureCLI        string                             `json:"azureCli,omitempty"`
+	Terraform       string                             `json:"terraform,omitempty"`
+	Next            string                             `json:"-"`
+}
+
+type SetupOpts struct {
+	cli.GlobalOpts
+	cli.WatchOpts
+	region                   string
+	resourceGroup            string
+	vNetName                 string
+	subnet                   string
+	privateEndpointID        string
+	privateEndpointIPAddress string
+	progress                 io.Writer
+	store                    store.PrivateEndpointProvisioner
+}
+
+func (opts *SetupOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *SetupOpts) validate() error {
+	if (opts.privateEndpointID == "") != (opts.privateEndpointIPAddress == "") {
+		return fmt.Errorf("--%s and --%s go together", flag.PrivateEndpointID, flag.PrivateEndpointIPAddress)
+	}
+	return nil
+}
+
+// endpointService returns the private endpoint service of the region, creating it when there's none,
+// as there can only be one per region
+func (opts *SetupOpts) endpointService() (*atlas.PrivateEndpointConnection, error) {
+	r, err := opts.store.PrivateEndpointService(opts.ConfigProjectID(), provider, opts.region)
+	if err != nil {
+		return nil, err
+	}
+	if r != nil {
+		_, err = fmt.Fprintf(opts.progress, "Using private endpoint service '%s' of region %s.\n", r.ID, opts.region)
+		return r, err
+	}
+	created, err := opts.store.CreatePrivateEndpoint(opts.ConfigProjectID(), &atlas.PrivateEndpointConnection{
+		ProviderName: provider,
+		Region:       opts.region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(opts.progress, "Private endpoint service '%s' created.\n", created.ID)
+	return created, err
+}
+
+// waitForEndpointService waits for the private endpoint service to accept private endpoints
+func (opts *SetupOpts) waitForEndpointService(id string) (*atlas.PrivateEndpointConnection, error) {
+	var r *atlas.PrivateEndpointConnection
+	err := opts.Watch(func() (bool, error) {
+		var err error
+		r, err = opts.store.PrivateEndpoint(opts.ConfigProjectID(), provider, id)
+		if err != nil {
+			return false, err
+		}
+		return r.Status == waitingForUser || r.Status == available || r.Status == failed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == failed {
+		return nil, fmt.Errorf("private endpoint service %s failed: %s", id, r.ErrorMessage)
+	}
+	return r, nil
+}
+
+// addPrivateEndpoint adds the private endpoint of the VNet to the private endpoint service,
+// unless it was already added, and waits for it to be available
+func (opts *SetupOpts) addPrivateEndpoint(service *atlas.PrivateEndpointConnection) (*atlas.InterfaceEndpointConnection, error) {
+	if !contains(service.PrivateEndpoints, opts.privateEndpointID) {
+		if _, err := opts.store.CreateInterfaceEndpoint(
+			opts.ConfigProjectID(),
+			provider,
+			service.ID,
+			&atlas.InterfaceEndpointConnection{ID: opts.privateEndpointID, PrivateEndpointIPAddress: opts.privateEndpointIPAddress},
+		); err != nil {
+			return nil, err
+		}
+		if _, err := fmt.Fprintf(opts.progress, "Private endpoint '%s' added to private endpoint service '%s'.\n", opts.privateEndpointID, service.ID); err != nil {
+			return nil, err
+		}
+	}
+	var r *atlas.InterfaceEndpointConnection
+	err := opts.Watch(func() (bool, error) {
+		var err error
+		r, err = opts.store.InterfaceEndpoint(opts.ConfigProjectID(), provider, service.ID, opts.privateEndpointID)
+		if err != nil {
+			return false, err
+		}
+		return r.AzureStatus == available || r.AzureStatus == failed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.AzureStatus == failed {
+		return nil, fmt.Errorf("private endpoint %s failed: %s", opts.privateEndpointID, r.ErrorMessage)
+	}
+	return r, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// newSetup returns the Azure CLI commands and the Terraform resource that create the private endpoint
+// in the VNet, and the command that adds it to the private endpoint service
+func (opts *SetupOpts) newSetup(service *atlas.PrivateEndpointConnection) *Setup {
+	name := "atlas-" + service.ID
+	azureCLI := fmt.Sprintf(`az network private-endpoint create --resource-group %[1]s --name %[2]s --vnet-name %[3]s --subnet %[4]s --private-connection-resource-id %[5]s --connection-name %[2]s --manual-request true
+az network nic show --ids $(az network private-endpoint show --resource-group %[1]s --name %[2]s --query 'networkInterfaces[0].id' --output tsv) --query 'ipConfigurations[0].privateIpAddress' --output tsv`,
+		opts.resourceGroup, name, opts.vNetName, opts.subnet, service.PrivateLinkServiceResourceID)
+	terraform := fmt.Sprintf(`data "azurerm_resource_group" "atlas" {
+  name = %[1]q
+}
+
+data "azurerm_subnet" "atlas" {
+  name                 = %[4]q
+  virtual_network_name = %[3]q
+  resource_group_name  = %[1]q
+}
+
+resource "azurerm_private_endpoint" "atlas" {
+  name                = %[2]q
+  location            = data.azurerm_resource_group.atlas.location
+  resource_group_name = %[1]q
+  subnet_id           = data.azurerm_subnet.atlas.id
+
+  private_service_connection {
+    name                           = %[2]q
+    private_connection_resource_id = %[5]q
+    is_manual_connection           = true
+    request_message                = "Atlas"
+  }
+}`, opts.resourceGroup, name, opts.vNetName, opts.subnet, service.PrivateLinkServiceResourceID)
+	next := fmt.Sprintf(
+		"mongocli atlas privateEndpoints azure setup --region %s --resourceGroup %s --vnet %s --subnet %s --%s <PrivateEndpointResourceId> --%s <PrivateIpAddress> --projectId %s",
+		opts.region,
+		opts.resourceGroup,
+		opts.vNetName,
+		opts.subnet,
+		flag.PrivateEndpointID,
+		flag.PrivateEndpointIPAddress,
+		opts.ConfigProjectID(),
+	)
+	return &Setup{
+		PrivateEndpointConnection: service,
+		AzureCLI:                  azureCLI,
+		Terraform:                 terraform,
+		Next:                      next,
+	}
+}
+
+func (opts *SetupOpts) Run() error {
+	service, err := opts.endpointService()
+	if err != nil {
+		return err
+	}
+	if service, err = opts.waitForEndpointService(service.ID); err != nil {
+		return err
+	}
+	setup := opts.newSetup(service)
+	if opts.privateEndpointID != "" {
+		if setup.PrivateEndpoint, err = opts.addPrivateEndpoint(service); err != nil {
+			return err
+		}
+	}
+	return opts.Print(setup)
+}
+
+// mongocli atlas privateEndpoint(s) azure setup --region region --resourceGroup resourceGroup --vnet vnet --subnet subnet
+// [--privateEndpointId resourceId --privateEndpointIpAddress ip] [--projectId projectId]
+func SetupBuilder() *cobra.Command {
+	opts := &SetupOpts{}
+	cmd := &cobra.Command{
+		Use:   "setup",
+		Short: "Connect your Azure VNet to your project with a private endpoint.",
+		Long: `The private endpoint service of the region is created, or reused when there's one already, and the command waits for it to be ready.
+It then prints the Azure CLI commands and the Terraform resource that create the private endpoint in your VNet and return its IP address.
+Once the private endpoint is created, run the command again with --privateEndpointId and --privateEndpointIpAddress to add it to the private endpoint service and wait for it to be available.`,
+		Example: `  $ mongocli atlas privateEndpoints azure setup --region US_EAST_2 --resourceGroup myGroup --vnet myVNet --subnet default
+  $ mongocli atlas privateEndpoints azure setup --region US_EAST_2 --resourceGroup myGroup --vnet myVNet --subnet default --privateEndpointId /subscriptions/4e2a7f1c/resourceGroups/myGroup/providers/Microsoft.Network/privateEndpoints/atlas --privateEndpointIpAddress 10.0.0.4`,
+		Args: require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.validate,
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), setupTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.region, flag.Region, "", usage.AzureSetupRegion)
+	cmd.Flags().StringVar(&opts.resourceGroup, flag.ResourceGroup, "", usage.ResourceGroup)
+	cmd.Flags().StringVar(&opts.vNetName, flag.VNet, "", usage.VNet)
+	cmd.Flags().StringVar(&opts.subnet, flag.Subnet, "", usage.AzureSubnet)
+	cmd.Flags().StringVar(&opts.privateEndpointID, flag.PrivateEndpointID, "", usage.AzureSetupPrivateEndpointID)
+	cmd.Flags().StringVar(&opts.privateEndpointIPAddress, flag.PrivateEndpointIPAddress, "", usage.PrivateEndpointIPAddressAzure)
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.Region)
+	_ = cmd.MarkFlagRequired(flag.ResourceGroup)
+	_ = cmd.MarkFlagRequired(flag.VNet)
+	_ = cmd.MarkFlagRequired(flag.Subnet)
+
+	return cmd
+}
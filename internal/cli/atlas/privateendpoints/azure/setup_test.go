@@ -0,0 +1,136 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package azure
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestSetup_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockPrivateEndpointProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &SetupOpts{
+		GlobalOpts:    cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:     cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: setupTemplate, OutWriter: buf}},
+		region:        "US_EAST_2",
+		resourceGroup: "myGroup",
+		vNetName:      "myVNet",
+		subnet:        "default",
+		progress:      new(bytes.Buffer),
+		store:         mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		PrivateEndpointService(opts.ProjectID, provider, "US_EAST_2").
+		Return(nil, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreatePrivateEndpoint(opts.ProjectID, &atlas.PrivateEndpointConnection{ProviderName: provider, Region: "US_EAST_2"}).
+		Return(&atlas.PrivateEndpointConnection{ID: "1", Status: "INITIATING"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PrivateEndpoint(opts.ProjectID, provider, "1").
+		Return(&atlas.PrivateEndpointConnection{ID: "1", PrivateLinkServiceResourceID: "/subscriptions/1/pls", Status: waitingForUser}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"az network private-endpoint create --resource-group myGroup --name atlas-1 --vnet-name myVNet --subnet default --private-connection-resource-id /subscriptions/1/pls --connection-name atlas-1 --manual-request true\n",
+		`private_connection_resource_id = "/subscriptions/1/pls"`,
+		"mongocli atlas privateEndpoints azure setup --region US_EAST_2 --resourceGroup myGroup --vnet myVNet --subnet default --privateEndpointId <PrivateEndpointResourceId>",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("got\n%s\nwant it to contain\n%s", buf.String(), want)
+		}
+	}
+}
+
+func TestSetup_RunPrivateEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockPrivateEndpointProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &SetupOpts{
+		GlobalOpts:               cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:                cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: setupTemplate, OutWriter: buf}},
+		region:                   "US_EAST_2",
+		resourceGroup:            "myGroup",
+		vNetName:                 "myVNet",
+		subnet:                   "default",
+		progress:                 new(bytes.Buffer),
+		privateEndpointID:        "/subscriptions/1/pe",
+		privateEndpointIPAddress: "10.0.0.4",
+		store:                    mockStore,
+	}
+
+	service := atlas.PrivateEndpointConnection{ID: "1", Status: waitingForUser}
+	mockStore.
+		EXPECT().
+		PrivateEndpointService(opts.ProjectID, provider, "US_EAST_2").
+		Return(&service, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		PrivateEndpoint(opts.ProjectID, provider, "1").
+		Return(&service, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		CreateInterfaceEndpoint(opts.ProjectID, provider, "1", &atlas.InterfaceEndpointConnection{ID: "/subscriptions/1/pe", PrivateEndpointIPAddress: "10.0.0.4"}).
+		Return(&atlas.InterfaceEndpointConnection{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		InterfaceEndpoint(opts.ProjectID, provider, "1", "/subscriptions/1/pe").
+		Return(&atlas.InterfaceEndpointConnection{PrivateEndpointResourceID: "/subscriptions/1/pe", AzureStatus: available}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := "\nPrivate endpoint '/subscriptions/1/pe' of private endpoint service '1' is AVAILABLE.\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSetupBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		SetupBuilder(),
+		0,
+		[]string{flag.Region, flag.ResourceGroup, flag.VNet, flag.Subnet, flag.PrivateEndpointID, flag.PrivateEndpointIPAddress, flag.Interval, flag.ProjectID, flag.Output},
+	)
+}
@@ -15,6 +15,8 @@
 package processes
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -29,23 +31,38 @@ const listTemplate = `ID	REPLICA SET NAME	SHARD NAME	VERSION{{range .}}
 {{.ID}}	{{.ReplicaSetName}}	{{.ShardName}}	{{.Version}}{{end}}
 `
 
+const listAllProjectsTemplate = `PROJECT	ID	REPLICA SET NAME	SHARD NAME	VERSION{{range .}}{{$project := .ProjectName}}{{range .Result}}
+{{$project}}	{{.ID}}	{{.ReplicaSetName}}	{{.ShardName}}	{{.Version}}{{end}}{{end}}
+`
+
 type ListOpts struct {
 	cli.GlobalOpts
 	cli.OutputOpts
 	cli.ListOpts
+	cli.AllProjectsOpts
 	clusterID string
 	store     store.ProcessLister
 }
 
-func (opts *ListOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *ListOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		s, err := store.New(config.Default(), store.WithContext(ctx))
+		opts.store, opts.ProjectStore = s, s
+		return err
+	}
 }
 
 func (opts *ListOpts) Run() error {
-	listOpts := opts.newProcessesListOptions()
-	r, err := opts.store.Processes(opts.ConfigProjectID(), listOpts)
+	if opts.AllProjects {
+		r, err := opts.ForEachProject(opts.ConfigOrgID(), opts.list)
+		if err != nil {
+			return err
+		}
+		opts.Template = listAllProjectsTemplate
+		return opts.Print(r)
+	}
+
+	r, err := opts.list(opts.ConfigProjectID())
 	if err != nil {
 		return err
 	}
@@ -53,6 +70,10 @@ func (opts *ListOpts) Run() error {
 	return opts.Print(r)
 }
 
+func (opts *ListOpts) list(projectID string) (interface{}, error) {
+	return opts.store.Processes(projectID, opts.newProcessesListOptions())
+}
+
 func (opts *ListOpts) newProcessesListOptions() *atlas.ProcessesListOptions {
 	return &atlas.ProcessesListOptions{
 		ClusterID:   opts.clusterID,
@@ -70,8 +91,8 @@ func ListBuilder() *cobra.Command {
 		Args:    require.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
-				opts.ValidateProjectID,
-				opts.initStore,
+				opts.ValidateProjects(&opts.GlobalOpts),
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), listTemplate),
 			)
 		},
@@ -85,6 +106,10 @@ func ListBuilder() *cobra.Command {
 	cmd.Flags().IntVar(&opts.ItemsPerPage, flag.Limit, 0, usage.Limit)
 
 	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().BoolVar(&opts.AllProjects, flag.AllProjects, false, usage.AllProjects)
+	cmd.Flags().StringVar(&opts.OrgID, flag.OrgID, "", usage.OrgID)
+	cmd.Flags().StringVar(&opts.ProjectName, flag.ProjectName, "", usage.ProjectNamePattern)
+	cmd.Flags().StringVar(&opts.ProjectTag, flag.ProjectTag, "", usage.ProjectTag)
 	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
 
 	return cmd
@@ -14,6 +14,7 @@
 package quickstart
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -105,10 +106,12 @@ type Opts struct {
 	store           store.AtlasClusterQuickStarter
 }
 
-func (opts *Opts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *Opts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *Opts) Run() error {
@@ -604,7 +607,7 @@ func (opts *Opts) defaultRegions() ([]string, error) {
 // program if it receives an interrupt from the OS. We then handle this by printing
 // the dbUsername and dbPassword
 func (opts *Opts) setupCloseHandler() {
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-c
@@ -636,7 +639,8 @@ func Builder() *cobra.Command {
 
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), ""),
 			)
 		},
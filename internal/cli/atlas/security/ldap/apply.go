@@ -0,0 +1,246 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/convert"
+	"github.com/mongodb/mongocli/internal/file"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	successStatus = "SUCCESS"
+	failedStatus  = "FAILED"
+	defaultPort   = 636
+)
+
+var applyTemplate = `
+VALIDATION	STATUS{{range .Validations}}
+{{.ValidationType}}	{{.Status}}{{end}}
+`
+
+// Configuration is the document with the LDAP configuration of a project
+type Configuration struct {
+	Hostname              string             `yaml:"hostname" json:"hostname"`
+	Port                  int                `yaml:"port,omitempty" json:"port,omitempty"`
+	BindUsername          string             `yaml:"bindUsername" json:"bindUsername"`
+	BindPassword          string             `yaml:"bindPassword" json:"bindPassword"`
+	CaCertificate         string             `yaml:"caCertificate,omitempty" json:"caCertificate,omitempty"`
+	AuthzQueryTemplate    string             `yaml:"authzQueryTemplate,omitempty" json:"authzQueryTemplate,omitempty"`
+	AuthenticationEnabled bool               `yaml:"authenticationEnabled" json:"authenticationEnabled"`
+	AuthorizationEnabled  bool               `yaml:"authorizationEnabled" json:"authorizationEnabled"`
+	UserToDNMapping       []*UserToDNMapping `yaml:"userToDNMapping,omitempty" json:"userToDNMapping,omitempty"`
+}
+
+// UserToDNMapping maps an LDAP username to a distinguished name, with either a substitution or an LDAP query
+type UserToDNMapping struct {
+	Match        string `yaml:"match" json:"match"`
+	Substitution string `yaml:"substitution,omitempty" json:"substitution,omitempty"`
+	LDAPQuery    string `yaml:"ldapQuery,omitempty" json:"ldapQuery,omitempty"`
+}
+
+// Validate checks the server and bind user are set and every mapping has a single template
+func (c *Configuration) Validate() error {
+	var missing []string
+	if c.Hostname == "" {
+		missing = append(missing, "hostname")
+	}
+	if c.BindUsername == "" {
+		missing = append(missing, "bindUsername")
+	}
+	if c.BindPassword == "" {
+		missing = append(missing, "bindPassword")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the file has no %s", strings.Join(missing, ", "))
+	}
+	for i, m := range c.UserToDNMapping {
+		if m.Match == "" || (m.Substitution == "") == (m.LDAPQuery == "") {
+			return fmt.Errorf("mapping %d needs a match and either a substitution or an ldapQuery", i+1)
+		}
+	}
+	return nil
+}
+
+// ExpandBindPassword replaces a bind password referencing an environment variable with its value
+func (c *Configuration) ExpandBindPassword() error {
+	env := new(convert.EnvExpander)
+	c.BindPassword = env.Expand(c.BindPassword)
+	return env.Err()
+}
+
+// newLDAP returns the connection details verified before saving
+func (c *Configuration) newLDAP() *atlas.LDAP {
+	port := c.Port
+	if port == 0 {
+		port = defaultPort
+	}
+	return &atlas.LDAP{
+		Hostname:           c.Hostname,
+		Port:               port,
+		BindUsername:       c.BindUsername,
+		BindPassword:       c.BindPassword,
+		CaCertificate:      c.CaCertificate,
+		AuthzQueryTemplate: c.AuthzQueryTemplate,
+	}
+}
+
+func (c *Configuration) newLDAPConfiguration() *atlas.LDAPConfiguration {
+	ldap := c.newLDAP()
+	ldap.AuthenticationEnabled = c.AuthenticationEnabled
+	ldap.AuthorizationEnabled = c.AuthorizationEnabled
+	for _, m := range c.UserToDNMapping {
+		ldap.UserToDNMapping = append(ldap.UserToDNMapping, &atlas.UserToDNMapping{
+			Match:        m.Match,
+			Substitution: m.Substitution,
+			LDAPQuery:    m.LDAPQuery,
+		})
+	}
+	return &atlas.LDAPConfiguration{LDAP: ldap}
+}
+
+type ApplyOpts struct {
+	cli.GlobalOpts
+	cli.WatchOpts
+	filename string
+	dryRun   bool
+	force    bool
+	fs       afero.Fs
+	progress io.Writer
+	store    store.LDAPConfigurationApplier
+}
+
+func (opts *ApplyOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+// verify requests the verification of the configuration and waits for its result
+func (opts *ApplyOpts) verify(c *Configuration) (*atlas.LDAPConfiguration, error) {
+	r, err := opts.store.VerifyLDAPConfiguration(opts.ConfigProjectID(), c.newLDAP())
+	if err != nil {
+		return nil, err
+	}
+	err = opts.Watch(func() (bool, error) {
+		r, err = opts.store.GetStatusLDAPConfiguration(opts.ConfigProjectID(), r.RequestID)
+		if err != nil {
+			return false, err
+		}
+		return r.Status == successStatus || r.Status == failedStatus, nil
+	})
+	return r, err
+}
+
+func (opts *ApplyOpts) Run() error {
+	c := new(Configuration)
+	if err := file.Load(opts.fs, opts.filename, c); err != nil {
+		return err
+	}
+	if err := c.ExpandBindPassword(); err != nil {
+		return err
+	}
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	r, err := opts.verify(c)
+	if err != nil {
+		return err
+	}
+	if err := opts.Print(r); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(opts.progress, "LDAP configuration verification %s.\n", strings.ToLower(r.Status)); err != nil {
+		return err
+	}
+	if opts.dryRun {
+		return nil
+	}
+	if r.Status != successStatus {
+		if !opts.force {
+			return fmt.Errorf("LDAP configuration not saved, fix the failed validations or use --%s to save it anyway", flag.Force)
+		}
+		if _, err := fmt.Fprintln(opts.progress, "Saving the LDAP configuration despite the failed verification."); err != nil {
+			return err
+		}
+	}
+
+	if _, err := opts.store.SaveLDAPConfiguration(opts.ConfigProjectID(), c.newLDAPConfiguration()); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(opts.progress, "LDAP configuration saved.")
+	return err
+}
+
+// mongocli atlas security ldap apply --file ldap.yaml [--dryRun] [--force] [--interval interval] [--projectId projectId]
+func ApplyBuilder() *cobra.Command {
+	opts := &ApplyOpts{
+		fs: afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Verify an LDAP configuration from a file and save it once verified.",
+		Long: `The connection to the LDAP server is verified first and the result of each validation is printed.
+The configuration, with its authentication, authorization and user to DN mapping settings, is only saved when every validation succeeds, unless --force is given.`,
+		Example: `  $ export LDAP_BIND_PASSWORD=secret
+  $ mongocli atlas security ldap apply --file ldap.yaml --projectId 5e2211c17a3e5a48f5497de3`,
+		Args: require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.progress = cmd.ErrOrStderr()
+			if opts.dryRun && opts.force {
+				return errors.New("use either --dryRun or --force, not both")
+			}
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), applyTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.LDAPFile)
+	cmd.Flags().BoolVar(&opts.dryRun, flag.DryRun, false, usage.LDAPApplyDryRun)
+	cmd.Flags().BoolVar(&opts.force, flag.Force, false, usage.LDAPApplyForce)
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.File)
+	_ = cmd.MarkFlagFilename(flag.File)
+
+	return cmd
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package ldap
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/spf13/afero"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const ldapFile = `hostname: ldap.example.com
+bindUsername: CN=Administrator,CN=Users,DC=example,DC=com
+bindPassword: ${LDAP_TEST_BIND_PASSWORD}
+authenticationEnabled: true
+userToDNMapping:
+  - match: (.+)@example.com
+    substitution: CN={0},CN=Users,DC=example,DC=com
+`
+
+func TestApply_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockLDAPConfigurationApplier(ctrl)
+	defer ctrl.Finish()
+
+	os.Setenv("LDAP_TEST_BIND_PASSWORD", "secret")
+	defer os.Unsetenv("LDAP_TEST_BIND_PASSWORD")
+
+	buf := new(bytes.Buffer)
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "ldap.yaml", []byte(ldapFile), 0600)
+	opts := &ApplyOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:  cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: buf}},
+		filename:   "ldap.yaml",
+		fs:         appFS,
+		progress:   new(bytes.Buffer),
+		store:      mockStore,
+	}
+
+	verified := &atlas.LDAP{
+		Hostname:     "ldap.example.com",
+		Port:         636,
+		BindUsername: "CN=Administrator,CN=Users,DC=example,DC=com",
+		BindPassword: "secret",
+	}
+	mockStore.
+		EXPECT().
+		VerifyLDAPConfiguration(opts.ProjectID, verified).
+		Return(&atlas.LDAPConfiguration{RequestID: "1", Status: "PENDING"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		GetStatusLDAPConfiguration(opts.ProjectID, "1").
+		Return(&atlas.LDAPConfiguration{
+			RequestID: "1",
+			Status:    "SUCCESS",
+			Validations: []*atlas.LDAPValidation{
+				{ValidationType: "CONNECT", Status: "OK"},
+				{ValidationType: "AUTHENTICATE", Status: "OK"},
+			},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		SaveLDAPConfiguration(opts.ProjectID, &atlas.LDAPConfiguration{
+			LDAP: &atlas.LDAP{
+				AuthenticationEnabled: true,
+				Hostname:              "ldap.example.com",
+				Port:                  636,
+				BindUsername:          "CN=Administrator,CN=Users,DC=example,DC=com",
+				BindPassword:          "secret",
+				UserToDNMapping: []*atlas.UserToDNMapping{
+					{Match: "(.+)@example.com", Substitution: "CN={0},CN=Users,DC=example,DC=com"},
+				},
+			},
+		}).
+		Return(&atlas.LDAPConfiguration{}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `
+VALIDATION     STATUS
+CONNECT        OK
+AUTHENTICATE   OK
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestApply_RunVerificationFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockLDAPConfigurationApplier(ctrl)
+	defer ctrl.Finish()
+
+	os.Setenv("LDAP_TEST_BIND_PASSWORD", "wrong")
+	defer os.Unsetenv("LDAP_TEST_BIND_PASSWORD")
+
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "ldap.yaml", []byte(ldapFile), 0600)
+	opts := &ApplyOpts{
+		GlobalOpts: cli.GlobalOpts{ProjectID: "5a0a1e7e0f2912c554080adc"},
+		WatchOpts:  cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: new(bytes.Buffer)}},
+		filename:   "ldap.yaml",
+		fs:         appFS,
+		progress:   new(bytes.Buffer),
+		store:      mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		VerifyLDAPConfiguration(opts.ProjectID, gomock.Any()).
+		Return(&atlas.LDAPConfiguration{RequestID: "1"}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		GetStatusLDAPConfiguration(opts.ProjectID, "1").
+		Return(&atlas.LDAPConfiguration{RequestID: "1", Status: "FAILED"}, nil).
+		Times(1)
+
+	if err := opts.Run(); err == nil {
+		t.Fatal("Run() expected an error for a failed verification")
+	}
+}
+
+func TestConfiguration_ExpandBindPassword(t *testing.T) {
+	c := &Configuration{BindPassword: "${LDAP_TEST_UNSET_PASSWORD}"}
+	if err := c.ExpandBindPassword(); err == nil {
+		t.Error("ExpandBindPassword() expected an error for an unset variable")
+	}
+}
+
+func TestApplyBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ApplyBuilder(),
+		0,
+		[]string{flag.File, flag.DryRun, flag.Force, flag.Interval, flag.ProjectID, flag.Output},
+	)
+}
@@ -31,6 +31,7 @@ func Builder() *cobra.Command {
 		SaveBuilder(),
 		DeleteBuilder(),
 		GetBuilder(),
+		ApplyBuilder(),
 	)
 
 	return cmd
@@ -25,7 +25,7 @@ func TestBuilder(t *testing.T) {
 	test.CmdValidator(
 		t,
 		Builder(),
-		4,
+		5,
 		[]string{},
 	)
 }
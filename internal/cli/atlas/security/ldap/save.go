@@ -15,6 +15,8 @@
 package ldap
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/config"
 	"github.com/mongodb/mongocli/internal/flag"
@@ -38,10 +40,12 @@ type SaveOpts struct {
 	store                 store.LDAPConfigurationSaver
 }
 
-func (opts *SaveOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *SaveOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 var saveTemplate = `HOSTNAME	PORT	AUTHENTICATION	AUTHORIZATION
@@ -82,7 +86,7 @@ func SaveBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), saveTemplate))
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,388 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupreport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	maxItemsPerPage       = 500
+	defaultMaxSnapshotAge = 24 * time.Hour
+	daysPerWeek           = 7
+	daysPerMonth          = 30
+	startedStatus         = "STARTED"
+	inactiveStatus        = "INACTIVE"
+	cloudBackupStatus     = "CLOUD_BACKUP"
+	legacyBackupStatus    = "LEGACY_BACKUP"
+	disabledStatus        = "DISABLED"
+	completedSnapshot     = "completed"
+	configServerType      = "CONFIG_SERVER_REPLICA_SET"
+)
+
+type project struct {
+	id   string
+	name string
+}
+
+type Opts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	policy  Policy
+	service string
+	now     func() time.Time
+	store   store.BackupReporter
+}
+
+func (opts *Opts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+var reportTemplate = `PROJECT	CLUSTER	STATUS	LAST SNAPSHOT	SCHEDULE	RETENTION DAYS	VIOLATIONS{{range .Results}}
+{{.ProjectName}}	{{.ClusterName}}	{{.Status}}	{{.LastSnapshot}}	{{.Schedule}}	{{.RetentionDays}}	{{range $i, $v := .Violations}}{{if $i}}, {{end}}{{$v}}{{end}}{{end}}
+`
+
+func (opts *Opts) Run() error {
+	projects, err := opts.projects()
+	if err != nil {
+		return err
+	}
+
+	report := &Report{Results: []*Entry{}}
+	now := opts.now()
+	for _, p := range projects {
+		var entries []*Entry
+		if opts.service == config.CloudService {
+			entries, err = opts.atlasEntries(p)
+		} else {
+			entries, err = opts.opsManagerEntries(p)
+		}
+		if err != nil {
+			return fmt.Errorf("project %s: %w", p.name, err)
+		}
+		for _, e := range entries {
+			opts.policy.Evaluate(e, now)
+			if len(e.Violations) > 0 {
+				report.NonCompliant++
+			}
+		}
+		report.Results = append(report.Results, entries...)
+	}
+	report.TotalCount = len(report.Results)
+
+	return opts.Print(report)
+}
+
+// projects returns every project of the organization across all pages.
+func (opts *Opts) projects() ([]project, error) {
+	var result []project
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxItemsPerPage}
+	for listOpts.PageNum = 1; ; listOpts.PageNum++ {
+		r, err := opts.store.GetOrgProjects(opts.ConfigOrgID(), listOpts)
+		if err != nil {
+			return nil, err
+		}
+		var total, count int
+		switch projects := r.(type) {
+		case *atlas.Projects:
+			for _, p := range projects.Results {
+				result = append(result, project{id: p.ID, name: p.Name})
+			}
+			total, count = projects.TotalCount, len(projects.Results)
+		case *opsmngr.Projects:
+			for _, p := range projects.Results {
+				result = append(result, project{id: p.ID, name: p.Name})
+			}
+			total, count = projects.TotalCount, len(projects.Results)
+		}
+		if count < maxItemsPerPage || len(result) >= total {
+			return result, nil
+		}
+	}
+}
+
+// atlasClusters returns every cluster of an Atlas project across all pages.
+func (opts *Opts) atlasClusters(projectID string) ([]atlas.Cluster, error) {
+	var result []atlas.Cluster
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxItemsPerPage}
+	for listOpts.PageNum = 1; ; listOpts.PageNum++ {
+		r, err := opts.store.ProjectClusters(projectID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		clusters, _ := r.([]atlas.Cluster)
+		result = append(result, clusters...)
+		if len(clusters) < maxItemsPerPage {
+			return result, nil
+		}
+	}
+}
+
+func (opts *Opts) atlasEntries(p project) ([]*Entry, error) {
+	clusters, err := opts.atlasClusters(p.id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*Entry, 0, len(clusters))
+	for i := range clusters {
+		c := &clusters[i]
+		e := &Entry{
+			ProjectID:   p.id,
+			ProjectName: p.name,
+			ClusterID:   c.ID,
+			ClusterName: c.Name,
+			Status:      disabledStatus,
+		}
+		switch {
+		case c.ProviderBackupEnabled != nil && *c.ProviderBackupEnabled:
+			e.BackupEnabled = true
+			e.Status = cloudBackupStatus
+			if err := opts.addCloudBackup(e); err != nil {
+				return nil, err
+			}
+		case c.BackupEnabled != nil && *c.BackupEnabled:
+			e.BackupEnabled = true
+			e.Status = legacyBackupStatus
+			if err := opts.addContinuousSnapshot(e, c.Name); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// addCloudBackup sets the last snapshot, schedule and retention of an Atlas cloud backup.
+// Snapshots are listed most recent first so the first page holds the latest one.
+func (opts *Opts) addCloudBackup(e *Entry) error {
+	snapshots, err := opts.store.Snapshots(e.ProjectID, e.ClusterName, nil)
+	if err != nil {
+		return err
+	}
+	var last time.Time
+	for _, s := range snapshots.Results {
+		createdAt, err := time.Parse(time.RFC3339, s.CreatedAt)
+		if s.Status != completedSnapshot || err != nil {
+			continue
+		}
+		if createdAt.After(last) {
+			last = createdAt
+			e.LastSnapshot = s.CreatedAt
+		}
+	}
+
+	policy, err := opts.store.BackupPolicy(e.ProjectID, e.ClusterName)
+	if err != nil {
+		return err
+	}
+	var schedule []string
+	for _, p := range policy.Policies {
+		for _, item := range p.PolicyItems {
+			schedule = append(schedule, policyItemSchedule(item))
+			if days := retentionDays(item.RetentionValue, item.RetentionUnit); days > e.RetentionDays {
+				e.RetentionDays = days
+			}
+		}
+	}
+	e.Schedule = strings.Join(schedule, ", ")
+	return nil
+}
+
+func policyItemSchedule(item atlas.PolicyItem) string {
+	if item.FrequencyType == "hourly" {
+		return fmt.Sprintf("every %dh", item.FrequencyInterval)
+	}
+	return item.FrequencyType
+}
+
+func retentionDays(value int, unit string) int {
+	switch unit {
+	case "weeks":
+		return value * daysPerWeek
+	case "months":
+		return value * daysPerMonth
+	default:
+		return value
+	}
+}
+
+// addContinuousSnapshot sets the last snapshot of a continuous backup,
+// snapshots are listed most recent first so the first page holds the latest one.
+func (opts *Opts) addContinuousSnapshot(e *Entry, clusterID string) error {
+	snapshots, err := opts.store.ContinuousSnapshots(e.ProjectID, clusterID, nil)
+	if err != nil {
+		return err
+	}
+	var last time.Time
+	for _, s := range snapshots.Results {
+		if !s.Complete || s.Created == nil {
+			continue
+		}
+		createdAt, err := time.Parse(time.RFC3339, s.Created.Date)
+		if err != nil {
+			continue
+		}
+		if createdAt.After(last) {
+			last = createdAt
+			e.LastSnapshot = s.Created.Date
+		}
+	}
+	return nil
+}
+
+// opsManagerClusters returns every cluster of an Ops Manager project across all pages.
+func (opts *Opts) opsManagerClusters(projectID string) ([]*opsmngr.Cluster, error) {
+	var result []*opsmngr.Cluster
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxItemsPerPage}
+	for listOpts.PageNum = 1; ; listOpts.PageNum++ {
+		r, err := opts.store.ProjectClusters(projectID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		clusters, _ := r.(*opsmngr.Clusters)
+		if clusters == nil {
+			return result, nil
+		}
+		result = append(result, clusters.Results...)
+		if len(clusters.Results) < maxItemsPerPage || listOpts.PageNum*maxItemsPerPage >= clusters.TotalCount {
+			return result, nil
+		}
+	}
+}
+
+// backupStatus returns the backup status of every cluster of a project by cluster ID, across all pages of backup configs.
+func (opts *Opts) backupStatus(projectID string) (map[string]string, error) {
+	status := map[string]string{}
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxItemsPerPage}
+	for listOpts.PageNum = 1; ; listOpts.PageNum++ {
+		configs, err := opts.store.ListBackupConfigs(projectID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range configs.Results {
+			status[c.ClusterID] = c.StatusName
+		}
+		if len(configs.Results) < maxItemsPerPage || listOpts.PageNum*maxItemsPerPage >= configs.TotalCount {
+			return status, nil
+		}
+	}
+}
+
+func (opts *Opts) opsManagerEntries(p project) ([]*Entry, error) {
+	clusters, err := opts.opsManagerClusters(p.id)
+	if err != nil || len(clusters) == 0 {
+		return nil, err
+	}
+
+	status, err := opts.backupStatus(p.id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*Entry, 0, len(clusters))
+	for _, c := range clusters {
+		// shards and config servers are backed up as part of their sharded cluster
+		if c.ShardName != "" || c.TypeName == configServerType {
+			continue
+		}
+		e := &Entry{
+			ProjectID:   p.id,
+			ProjectName: p.name,
+			ClusterID:   c.ID,
+			ClusterName: c.ClusterName,
+			Status:      status[c.ID],
+		}
+		if e.Status == "" {
+			e.Status = inactiveStatus
+		}
+		if e.Status == startedStatus {
+			e.BackupEnabled = true
+			if err := opts.addContinuousSnapshot(e, c.ID); err != nil {
+				return nil, err
+			}
+			schedule, err := opts.store.GetSnapshotSchedule(p.id, c.ID)
+			if err != nil {
+				return nil, err
+			}
+			e.Schedule = fmt.Sprintf("every %dh", schedule.SnapshotIntervalHours)
+			e.RetentionDays = scheduleRetentionDays(schedule)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// scheduleRetentionDays returns the longest retention of a snapshot schedule.
+func scheduleRetentionDays(s *opsmngr.SnapshotSchedule) int {
+	days := s.SnapshotRetentionDays
+	for _, d := range []int{s.DailySnapshotRetentionDays, s.WeeklySnapshotRetentionWeeks * daysPerWeek, s.MonthlySnapshotRetentionMonths * daysPerMonth} {
+		if d > days {
+			days = d
+		}
+	}
+	return days
+}
+
+// mongocli atlas|ops-manager|cloud-manager backups report [--orgId orgId] [--maxSnapshotAge 24h] [--minRetentionDays N] [--output csv]
+func Builder() *cobra.Command {
+	opts := &Opts{
+		now: time.Now,
+	}
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report the backup compliance of every cluster in your organization.",
+		Long: `Walk every project and cluster of the organization and report whether backup is enabled, the last snapshot, the schedule and the retention.
+Clusters without backup, without a snapshot within --maxSnapshotAge or with a retention under --minRetentionDays are reported as violations.`,
+		Example: `  Export the report of an organization as CSV:
+  $ mongocli atlas backups report --orgId 5e2211c17a3e5a48f5497de3 --output csv > backups.csv`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.service = config.Service()
+			return opts.PreRunE(
+				opts.ValidateOrgID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), reportTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().DurationVar(&opts.policy.MaxSnapshotAge, flag.MaxSnapshotAge, defaultMaxSnapshotAge, usage.MaxSnapshotAge)
+	cmd.Flags().IntVar(&opts.policy.MinRetentionDays, flag.MinRetentionDays, 0, usage.MinRetentionDays)
+
+	cmd.Flags().StringVar(&opts.OrgID, flag.OrgID, "", usage.OrgID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.ReportFormatOut)
+
+	return cmd
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package backupreport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+func decodeReport(t *testing.T, buf *bytes.Buffer) *Report {
+	t.Helper()
+	r := new(Report)
+	if err := json.Unmarshal(buf.Bytes(), r); err != nil {
+		t.Fatalf("invalid report: %v", err)
+	}
+	return r
+}
+
+func TestReport_RunAtlas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockBackupReporter(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &Opts{
+		GlobalOpts: cli.GlobalOpts{OrgID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{OutWriter: buf, Output: "json"},
+		policy:     Policy{MaxSnapshotAge: defaultMaxSnapshotAge},
+		service:    config.CloudService,
+		now:        func() time.Time { return time.Date(2021, 4, 13, 12, 0, 0, 0, time.UTC) },
+		store:      mockStore,
+	}
+	enabled := true
+
+	mockStore.
+		EXPECT().
+		GetOrgProjects(opts.OrgID, gomock.Any()).
+		Return(&atlas.Projects{Results: []*atlas.Project{{ID: "1", Name: "prod"}}, TotalCount: 1}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectClusters("1", gomock.Any()).
+		Return([]atlas.Cluster{{Name: "backed", ProviderBackupEnabled: &enabled}, {Name: "unsafe"}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		Snapshots("1", "backed", nil).
+		Return(&atlas.CloudProviderSnapshots{Results: []*atlas.CloudProviderSnapshot{
+			{CreatedAt: "2021-04-13T06:00:00Z", Status: completedSnapshot},
+			{CreatedAt: "2021-04-12T06:00:00Z", Status: completedSnapshot},
+		}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		BackupPolicy("1", "backed").
+		Return(&atlas.CloudProviderSnapshotBackupPolicy{Policies: []atlas.Policy{{PolicyItems: []atlas.PolicyItem{
+			{FrequencyType: "hourly", FrequencyInterval: 6, RetentionUnit: "days", RetentionValue: 2},
+			{FrequencyType: "monthly", FrequencyInterval: 40, RetentionUnit: "months", RetentionValue: 12},
+		}}}}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	r := decodeReport(t, buf)
+	if r.TotalCount != 2 || r.NonCompliant != 1 {
+		t.Fatalf("got %d clusters and %d non compliant, want 2 and 1", r.TotalCount, r.NonCompliant)
+	}
+	backed := r.Results[0]
+	if backed.Status != cloudBackupStatus || backed.LastSnapshot != "2021-04-13T06:00:00Z" || backed.Schedule != "every 6h, monthly" || backed.RetentionDays != 360 {
+		t.Errorf("unexpected entry %+v", backed)
+	}
+	if r.Results[1].Status != disabledStatus {
+		t.Errorf("got status %s, want %s", r.Results[1].Status, disabledStatus)
+	}
+}
+
+func TestReport_RunOpsManager(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockBackupReporter(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &Opts{
+		GlobalOpts: cli.GlobalOpts{OrgID: "5a0a1e7e0f2912c554080adc"},
+		OutputOpts: cli.OutputOpts{OutWriter: buf, Output: "json"},
+		policy:     Policy{MaxSnapshotAge: defaultMaxSnapshotAge},
+		service:    config.OpsManagerService,
+		now:        func() time.Time { return time.Date(2021, 4, 13, 12, 0, 0, 0, time.UTC) },
+		store:      mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		GetOrgProjects(opts.OrgID, gomock.Any()).
+		Return(&opsmngr.Projects{Results: []*opsmngr.Project{{ID: "1", Name: "prod"}}, TotalCount: 1}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectClusters("1", gomock.Any()).
+		Return(&opsmngr.Clusters{Results: []*opsmngr.Cluster{
+			{ID: "a", ClusterName: "sharded", TypeName: "SHARDED_REPLICA_SET"},
+			{ID: "b", ClusterName: "sharded", ShardName: "shard0", TypeName: "REPLICA_SET"},
+			{ID: "c", ClusterName: "rs", TypeName: "REPLICA_SET"},
+		}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ListBackupConfigs("1", gomock.Any()).
+		Return(&opsmngr.BackupConfigs{Results: []*opsmngr.BackupConfig{{ClusterID: "a", StatusName: startedStatus}}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ContinuousSnapshots("1", "a", nil).
+		Return(&atlas.ContinuousSnapshots{Results: []*atlas.ContinuousSnapshot{
+			{Complete: true, Created: &atlas.SnapshotTimestamp{Date: "2021-04-11T06:00:00Z"}},
+		}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		GetSnapshotSchedule("1", "a").
+		Return(&opsmngr.SnapshotSchedule{SnapshotIntervalHours: 24, SnapshotRetentionDays: 2, MonthlySnapshotRetentionMonths: 6}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	r := decodeReport(t, buf)
+	if r.TotalCount != 2 || r.NonCompliant != 2 {
+		t.Fatalf("got %d clusters and %d non compliant, want 2 and 2", r.TotalCount, r.NonCompliant)
+	}
+	sharded := r.Results[0]
+	if sharded.Schedule != "every 24h" || sharded.RetentionDays != 180 || len(sharded.Violations) != 1 || sharded.Violations[0] != "no snapshot in 24h" {
+		t.Errorf("unexpected entry %+v", sharded)
+	}
+	if r.Results[1].Status != inactiveStatus {
+		t.Errorf("got status %s, want %s", r.Results[1].Status, inactiveStatus)
+	}
+}
+
+func TestReport_backupStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockBackupReporter(ctrl)
+	defer ctrl.Finish()
+
+	opts := &Opts{store: mockStore}
+	page := make([]*opsmngr.BackupConfig, maxItemsPerPage)
+	for i := range page {
+		page[i] = &opsmngr.BackupConfig{ClusterID: fmt.Sprintf("page1-%d", i), StatusName: inactiveStatus}
+	}
+
+	mockStore.
+		EXPECT().
+		ListBackupConfigs("1", &atlas.ListOptions{PageNum: 1, ItemsPerPage: maxItemsPerPage}).
+		Return(&opsmngr.BackupConfigs{Results: page, TotalCount: maxItemsPerPage + 1}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ListBackupConfigs("1", &atlas.ListOptions{PageNum: 2, ItemsPerPage: maxItemsPerPage}).
+		Return(&opsmngr.BackupConfigs{Results: []*opsmngr.BackupConfig{{ClusterID: "last", StatusName: startedStatus}}, TotalCount: maxItemsPerPage + 1}, nil).
+		Times(1)
+
+	status, err := opts.backupStatus("1")
+	if err != nil {
+		t.Fatalf("backupStatus() unexpected error: %v", err)
+	}
+	if len(status) != maxItemsPerPage+1 || status["last"] != startedStatus {
+		t.Errorf("backupStatus() got %d configs and last %q, want %d and %s", len(status), status["last"], maxItemsPerPage+1, startedStatus)
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		Builder(),
+		0,
+		[]string{flag.OrgID, flag.MaxSnapshotAge, flag.MinRetentionDays, flag.Output},
+	)
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupreport
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Entry is the backup state of a cluster.
+type Entry struct {
+	ProjectID     string   `json:"projectId"`
+	ProjectName   string   `json:"projectName"`
+	ClusterID     string   `json:"clusterId,omitempty"`
+	ClusterName   string   `json:"clusterName"`
+	BackupEnabled bool     `json:"backupEnabled"`
+	Status        string   `json:"status"`
+	LastSnapshot  string   `json:"lastSnapshot,omitempty"`
+	Schedule      string   `json:"schedule,omitempty"`
+	RetentionDays int      `json:"retentionDays,omitempty"`
+	Violations    []string `json:"violations"`
+}
+
+// Report is the backup state of every cluster of an organization.
+type Report struct {
+	Results      []*Entry `json:"results"`
+	TotalCount   int      `json:"totalCount"`
+	NonCompliant int      `json:"nonCompliant"`
+}
+
+var csvHeader = []string{"PROJECT ID", "PROJECT", "CLUSTER", "BACKUP ENABLED", "STATUS", "LAST SNAPSHOT", "SCHEDULE", "RETENTION DAYS", "VIOLATIONS"}
+
+// Records returns the report as CSV records, one per cluster after a header.
+func (r *Report) Records() [][]string {
+	records := make([][]string, 0, len(r.Results)+1)
+	records = append(records, csvHeader)
+	for _, e := range r.Results {
+		records = append(records, []string{
+			e.ProjectID,
+			e.ProjectName,
+			e.ClusterName,
+			strconv.FormatBool(e.BackupEnabled),
+			e.Status,
+			e.LastSnapshot,
+			e.Schedule,
+			strconv.Itoa(e.RetentionDays),
+			strings.Join(e.Violations, "; "),
+		})
+	}
+	return records
+}
+
+// Policy is what a cluster must satisfy to be compliant.
+type Policy struct {
+	MaxSnapshotAge   time.Duration
+	MinRetentionDays int
+}
+
+// Evaluate sets the violations of the entry at the given time.
+// A cluster without backup is always a violation.
+func (p *Policy) Evaluate(e *Entry, now time.Time) {
+	e.Violations = []string{}
+	if !e.BackupEnabled {
+		e.Violations = append(e.Violations, "backup disabled")
+		return
+	}
+
+	if e.LastSnapshot == "" {
+		e.Violations = append(e.Violations, "no snapshot")
+	} else if p.MaxSnapshotAge > 0 {
+		last, err := time.Parse(time.RFC3339, e.LastSnapshot)
+		if err != nil || now.Sub(last) > p.MaxSnapshotAge {
+			e.Violations = append(e.Violations, fmt.Sprintf("no snapshot in %s", shortDuration(p.MaxSnapshotAge)))
+		}
+	}
+
+	// retention is only known for clusters with a schedule
+	if p.MinRetentionDays > 0 && e.Schedule != "" && e.RetentionDays < p.MinRetentionDays {
+		e.Violations = append(e.Violations, fmt.Sprintf("retention under %d days", p.MinRetentionDays))
+	}
+}
+
+// shortDuration formats 24h0m0s as 24h.
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package backupreport
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPolicy_Evaluate(t *testing.T) {
+	now := time.Date(2021, 4, 13, 12, 0, 0, 0, time.UTC)
+	policy := &Policy{MaxSnapshotAge: 24 * time.Hour, MinRetentionDays: 30}
+	tests := []struct {
+		name  string
+		entry *Entry
+		want  []string
+	}{
+		{
+			name:  "disabled",
+			entry: &Entry{Status: disabledStatus},
+			want:  []string{"backup disabled"},
+		},
+		{
+			name:  "compliant",
+			entry: &Entry{BackupEnabled: true, LastSnapshot: "2021-04-13T06:00:00Z", Schedule: "every 6h", RetentionDays: 365},
+			want:  []string{},
+		},
+		{
+			name:  "no snapshot",
+			entry: &Entry{BackupEnabled: true, Schedule: "every 6h", RetentionDays: 365},
+			want:  []string{"no snapshot"},
+		},
+		{
+			name:  "stale snapshot and short retention",
+			entry: &Entry{BackupEnabled: true, LastSnapshot: "2021-04-11T06:00:00Z", Schedule: "every 6h", RetentionDays: 7},
+			want:  []string{"no snapshot in 24h", "retention under 30 days"},
+		},
+		{
+			name:  "unknown retention",
+			entry: &Entry{BackupEnabled: true, LastSnapshot: "2021-04-13T06:00:00Z"},
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		entry := tt.entry
+		want := tt.want
+		t.Run(tt.name, func(t *testing.T) {
+			policy.Evaluate(entry, now)
+			if !reflect.DeepEqual(entry.Violations, want) {
+				t.Errorf("Evaluate() got = %v, want %v", entry.Violations, want)
+			}
+		})
+	}
+}
+
+func TestReport_Records(t *testing.T) {
+	r := &Report{
+		Results: []*Entry{
+			{ProjectID: "1", ProjectName: "p", ClusterName: "c", Status: disabledStatus, Violations: []string{"backup disabled"}},
+		},
+	}
+	want := [][]string{
+		csvHeader,
+		{"1", "p", "c", "false", disabledStatus, "", "", "0", "backup disabled"},
+	}
+	if got := r.Records(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Records() got = %v, want %v", got, want)
+	}
+}
@@ -15,6 +15,7 @@
 package config
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -49,10 +50,12 @@ type configOpts struct {
 	store          ProjectOrgsLister
 }
 
-func (opts *configOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *configOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *configOpts) IsCloud() bool {
@@ -94,7 +97,7 @@ func (opts *configOpts) setUpMongoSHPath() {
 	}
 }
 
-func (opts *configOpts) Run() error {
+func (opts *configOpts) Run(ctx context.Context) error {
 	fmt.Printf(`You are configuring a profile for %s.
 
 All values are optional and you can use environment variables (MCLI_*) instead.
@@ -109,7 +112,7 @@ Enter [?] on any option to get help.
 	}
 	opts.SetUpAccess()
 
-	if err := opts.initStore(); err != nil {
+	if err := opts.initStore(ctx)(); err != nil {
 		return err
 	}
 
@@ -263,7 +266,7 @@ To find out more, see the documentation: https://docs.mongodb.com/mongocli/stabl
   $ mongocli config --service ops-manager
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return opts.Run()
+			return opts.Run(cmd.Context())
 		},
 	}
 	cmd.Flags().StringVar(&opts.Service, flag.Service, config.CloudService, usage.Service)
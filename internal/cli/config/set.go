@@ -34,14 +34,8 @@ type SetOpts struct {
 }
 
 func (opts *SetOpts) Run() error {
-	if strings.HasSuffix(opts.prop, "_url") {
-		if err := validate.URL(opts.val); err != nil {
-			return err
-		}
-	} else if strings.HasSuffix(opts.prop, "_id") {
-		if err := validate.ObjectID(opts.val); err != nil {
-			return err
-		}
+	if err := validateProperty(opts.prop, opts.val); err != nil {
+		return err
 	}
 	opts.store.Set(opts.prop, opts.val)
 	if err := opts.store.Save(); err != nil {
@@ -51,6 +45,28 @@ func (opts *SetOpts) Run() error {
 	return nil
 }
 
+// validateProperty validates the value matches what the property expects
+func validateProperty(prop, val string) error {
+	switch {
+	case strings.HasSuffix(prop, "_url"):
+		return validate.URL(val)
+	case strings.HasSuffix(prop, "_id"):
+		return validate.ObjectID(val)
+	case search.StringInSlice(config.BooleanProperties(), prop):
+		return validate.Bool(val)
+	case search.StringInSlice(config.IntProperties(), prop):
+		return validate.PositiveInt(val)
+	case search.StringInSlice(config.DurationProperties(), prop):
+		return validate.Duration(val)
+	case search.StringInSlice(config.FileProperties(), prop):
+		return validate.Path(val)
+	case search.StringInSlice(config.ProxyProperties(), prop):
+		return validate.ProxyURL(val)
+	default:
+		return nil
+	}
+}
+
 func SetBuilder() *cobra.Command {
 	const argsN = 2
 	cmd := &cobra.Command{
@@ -187,4 +187,119 @@ func TestSet_Run(t *testing.T) {
 			t.Fatal("Run() expected an error but got none\n")
 		}
 	})
+
+	t.Run("valid retry_max_attempts", func(t *testing.T) {
+		setOpts := &SetOpts{
+			store: mockStore,
+			prop:  "retry_max_attempts",
+			val:   "5",
+		}
+		mockStore.
+			EXPECT().
+			Set(setOpts.prop, setOpts.val).
+			Times(1)
+
+		mockStore.
+			EXPECT().
+			Save().Return(nil).
+			Times(1)
+
+		err := setOpts.Run()
+
+		if err != nil {
+			t.Fatalf("Run() unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("invalid retry_max_attempts", func(t *testing.T) {
+		setOpts := &SetOpts{
+			store: mockStore,
+			prop:  "retry_max_attempts",
+			val:   "0",
+		}
+		mockStore.
+			EXPECT().
+			Set(setOpts.prop, setOpts.val).
+			Times(0)
+
+		mockStore.
+			EXPECT().
+			Save().Return(nil).
+			Times(0)
+
+		err := setOpts.Run()
+
+		if err == nil {
+			t.Fatal("Run() expected an error but got none\n")
+		}
+	})
+
+	t.Run("invalid retry_non_idempotent", func(t *testing.T) {
+		setOpts := &SetOpts{
+			store: mockStore,
+			prop:  "retry_non_idempotent",
+			val:   "maybe",
+		}
+		mockStore.
+			EXPECT().
+			Set(setOpts.prop, setOpts.val).
+			Times(0)
+
+		mockStore.
+			EXPECT().
+			Save().Return(nil).
+			Times(0)
+
+		err := setOpts.Run()
+
+		if err == nil {
+			t.Fatal("Run() expected an error but got none\n")
+		}
+	})
+
+	t.Run("invalid request_timeout", func(t *testing.T) {
+		setOpts := &SetOpts{
+			store: mockStore,
+			prop:  "request_timeout",
+			val:   "10",
+		}
+		mockStore.
+			EXPECT().
+			Set(setOpts.prop, setOpts.val).
+			Times(0)
+
+		mockStore.
+			EXPECT().
+			Save().Return(nil).
+			Times(0)
+
+		err := setOpts.Run()
+
+		if err == nil {
+			t.Fatal("Run() expected an error but got none\n")
+		}
+	})
+
+	t.Run("invalid http_proxy", func(t *testing.T) {
+		setOpts := &SetOpts{
+			store: mockStore,
+			prop:  "http_proxy",
+			val:   "proxy.example.com",
+		}
+		mockStore.
+			EXPECT().
+			Set(setOpts.prop, setOpts.val).
+			Times(0)
+
+		mockStore.
+			EXPECT().
+			Save().Return(nil).
+			Times(0)
+
+		err := setOpts.Run()
+
+		if err == nil {
+			t.Fatal("Run() expected an error but got none\n")
+		}
+	})
 }
@@ -0,0 +1,136 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/randgen"
+	"github.com/spf13/afero"
+)
+
+const generatedPasswordLength = 32
+
+// Credential is a database user credential
+type Credential struct {
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+	Database string `json:"database"`
+}
+
+// CredentialOpts saves a generated credential to a file or hands it to a credential helper,
+// a command can compose this struct and print the credential when neither is set.
+type CredentialOpts struct {
+	Out              string
+	CredentialHelper string
+	Fs               afero.Fs
+}
+
+// ValidateCredentialOpts checks a single destination is given
+func (opts *CredentialOpts) ValidateCredentialOpts() error {
+	if opts.Out != "" && opts.CredentialHelper != "" {
+		return fmt.Errorf("use either an output file or a credential helper, not both")
+	}
+	return nil
+}
+
+// GeneratePassword returns a random password
+func (opts *CredentialOpts) GeneratePassword() (string, error) {
+	return randgen.GenerateRandomBase64String(generatedPasswordLength)
+}
+
+// SaveCredential saves the credential and removes its password so it's not printed,
+// it's left as it is when there's nowhere to save it.
+func (opts *CredentialOpts) SaveCredential(c *Credential) error {
+	switch {
+	case opts.Out != "":
+		b, err := json.MarshalIndent(c, "", "  ")
+		if err != nil {
+			return err
+		}
+		// WriteFile only sets the mode of new files, restrict an existing one before writing the password to it
+		if _, err := opts.Fs.Stat(opts.Out); err == nil {
+			if err := opts.Fs.Chmod(opts.Out, 0600); err != nil {
+				return err
+			}
+		}
+		if err := afero.WriteFile(opts.Fs, opts.Out, append(b, '\n'), 0600); err != nil {
+			return err
+		}
+	case opts.CredentialHelper != "":
+		// the helper gets key=value lines, like git credential helpers
+		cmd := exec.Command(opts.CredentialHelper, "store")
+		cmd.Stdin = strings.NewReader(fmt.Sprintf("username=%s\ndatabase=%s\npassword=%s\n", c.Username, c.Database, c.Password))
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("credential helper failed: %w: %s", err, strings.TrimSpace(string(out)))
+		}
+	default:
+		return nil
+	}
+	c.Password = ""
+	return nil
+}
+
+// loadCredential fills the credential from a file saved with SaveCredential
+func loadCredential(fs afero.Fs, filename string, c *Credential) error {
+	b, err := afero.ReadFile(fs, filename)
+	if err != nil {
+		return err
+	}
+	saved := new(Credential)
+	if err := json.Unmarshal(b, saved); err != nil {
+		return fmt.Errorf("invalid credential file %s: %w", filename, err)
+	}
+	if c.Username != "" && c.Username != saved.Username {
+		return fmt.Errorf("credential file %s is for user '%s', not '%s'", filename, saved.Username, c.Username)
+	}
+	*c = *saved
+	return nil
+}
+
+// fetchCredential asks the credential helper for the password of the user,
+// the helper gets and returns key=value lines like with SaveCredential.
+func fetchCredential(helper string, c *Credential) error {
+	cmd := exec.Command(helper, "get")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("username=%s\ndatabase=%s\n", c.Username, c.Database))
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("credential helper failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		kv := strings.SplitN(scanner.Text(), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "username":
+			c.Username = kv[1]
+		case "password":
+			c.Password = kv[1]
+		}
+	}
+	if c.Password == "" {
+		return fmt.Errorf("credential helper returned no password for '%s'", c.Username)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// +build unit
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func TestCredentialOpts_SaveCredential(t *testing.T) {
+	t.Run("file", func(t *testing.T) {
+		opts := &CredentialOpts{Out: "credential.json", Fs: afero.NewMemMapFs()}
+		c := &Credential{Username: "user", Password: "secret", Database: "admin"}
+		if err := opts.SaveCredential(c); err != nil {
+			t.Fatalf("SaveCredential() unexpected error: %v", err)
+		}
+		if c.Password != "" {
+			t.Error("expected the saved password to be removed")
+		}
+		b, _ := afero.ReadFile(opts.Fs, opts.Out)
+		want := `{
+  "username": "user",
+  "password": "secret",
+  "database": "admin"
+}
+`
+		if string(b) != want {
+			t.Errorf("got %s, want %s", b, want)
+		}
+		info, _ := opts.Fs.Stat(opts.Out)
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("got mode %v, want 0600", info.Mode().Perm())
+		}
+	})
+	t.Run("existing file", func(t *testing.T) {
+		opts := &CredentialOpts{Out: "credential.json", Fs: afero.NewMemMapFs()}
+		if err := afero.WriteFile(opts.Fs, opts.Out, []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		c := &Credential{Username: "user", Password: "secret", Database: "admin"}
+		if err := opts.SaveCredential(c); err != nil {
+			t.Fatalf("SaveCredential() unexpected error: %v", err)
+		}
+		info, _ := opts.Fs.Stat(opts.Out)
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("got mode %v, want 0600", info.Mode().Perm())
+		}
+	})
+	t.Run("no destination", func(t *testing.T) {
+		opts := &CredentialOpts{}
+		c := &Credential{Username: "user", Password: "secret"}
+		if err := opts.SaveCredential(c); err != nil {
+			t.Fatalf("SaveCredential() unexpected error: %v", err)
+		}
+		if c.Password != "secret" {
+			t.Error("expected the password to be kept")
+		}
+	})
+}
+
+func TestCredentialOpts_ValidateCredentialOpts(t *testing.T) {
+	opts := &CredentialOpts{Out: "credential.json", CredentialHelper: "helper"}
+	if err := opts.ValidateCredentialOpts(); err == nil {
+		t.Error("expected an error for two destinations")
+	}
+}
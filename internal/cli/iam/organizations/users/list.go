@@ -15,6 +15,8 @@
 package users
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -35,10 +37,12 @@ type ListOpts struct {
 	store store.UserLister
 }
 
-func (opts *ListOpts) init() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *ListOpts) init(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *ListOpts) Run() error {
@@ -66,7 +70,7 @@ func ListBuilder() *cobra.Command {
 			return opts.PreRunE(
 				opts.ValidateOrgID,
 				opts.InitOutput(cmd.OutOrStdout(), listTemplate),
-				opts.init,
+				opts.init(cmd.Context()),
 			)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -15,6 +15,8 @@
 package apikeys
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -33,10 +35,12 @@ type AssignOpts struct {
 	store store.ProjectAPIKeyAssigner
 }
 
-func (opts *AssignOpts) init() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *AssignOpts) init(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *AssignOpts) newAssignAPIKey() *atlas.AssignAPIKey {
@@ -67,7 +71,7 @@ func AssignBuilder() *cobra.Command {
 			opts.OutWriter = cmd.OutOrStdout()
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.init,
+				opts.init(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), updateTemplate),
 			)
 		},
@@ -15,35 +15,96 @@
 package projects
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/file"
 	"github.com/mongodb/mongocli/internal/flag"
 	"github.com/mongodb/mongocli/internal/store"
 	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
 )
 
 var createTemplate = "Project '{{.ID}}' created.\n"
 
+// rollbackTimeout bounds the rollback, which goes on once the command is interrupted
+const rollbackTimeout = time.Minute
+
 type CreateOpts struct {
 	cli.GlobalOpts
 	cli.OutputOpts
-	name  string
-	store store.ProjectCreator
+	name     string
+	template string
+	fs       afero.Fs
+	progress io.Writer
+	store    store.ProjectProvisioner
+	// rollbackStore returns a store on a context of its own, the one of the command may be canceled already
+	rollbackStore func(context.Context) (store.ProjectProvisioner, error)
+}
+
+// step provisions an item of the template and returns how to remove it
+type step struct {
+	description string
+	run         func() (func(store.ProjectProvisioner) error, error)
 }
 
-func (opts *CreateOpts) init() error {
-	if opts.ConfigOrgID() == "" {
-		return cli.ErrMissingOrgID
+// undo removes a provisioned item when a later step fails
+type undo struct {
+	description string
+	run         func(store.ProjectProvisioner) error
+}
+
+func (opts *CreateOpts) init(ctx context.Context) func() error {
+	return func() error {
+		if opts.ConfigOrgID() == "" {
+			return cli.ErrMissingOrgID
+		}
+
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		opts.rollbackStore = func(ctx context.Context) (store.ProjectProvisioner, error) {
+			return store.New(config.Default(), store.WithContext(ctx))
+		}
+		return err
 	}
+}
 
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *CreateOpts) loadTemplate() (*Template, error) {
+	t := new(Template)
+	if err := file.Load(opts.fs, opts.template, t); err != nil {
+		return nil, err
+	}
+	if err := t.ExpandSecrets(); err != nil {
+		return nil, err
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	if t.AtlasOnly() && config.Service() != config.CloudService {
+		return nil, errors.New("customDbRoles, dbUsers, accessList, maintenanceWindow and integrations are only supported by Atlas")
+	}
+	return t, nil
 }
 
 func (opts *CreateOpts) Run() error {
+	var t *Template
+	if opts.template != "" {
+		var err error
+		if t, err = opts.loadTemplate(); err != nil {
+			return err
+		}
+	}
+
 	r, err := opts.store.CreateProject(opts.name, opts.ConfigOrgID())
 
 	if err != nil {
@@ -52,21 +113,200 @@ func (opts *CreateOpts) Run() error {
 	if config.Service() != config.CloudService {
 		createTemplate += "Agent API Key: '{{.AgentAPIKey}}'\n"
 	}
+	if t != nil {
+		if err := opts.provision(projectID(r), t); err != nil {
+			return err
+		}
+	}
 
 	return opts.Print(r)
 }
 
-// mongocli iam project(s) create <name> [--orgId orgId]
+func projectID(p interface{}) string {
+	switch v := p.(type) {
+	case *atlas.Project:
+		return v.ID
+	case *opsmngr.Project:
+		return v.ID
+	}
+	return ""
+}
+
+// provision runs the steps of the template in order, removing what was provisioned,
+// the project included, as soon as one fails so no half provisioned project is left behind
+func (opts *CreateOpts) provision(projectID string, t *Template) error {
+	undos := []*undo{{
+		description: fmt.Sprintf("project %s", projectID),
+		run:         func(s store.ProjectProvisioner) error { return s.DeleteProject(projectID) },
+	}}
+	for _, s := range opts.steps(projectID, t) {
+		u, err := s.run()
+		if err != nil {
+			return opts.rollback(undos, fmt.Errorf("%s: %w", s.description, err))
+		}
+		undos = append(undos, &undo{description: s.description, run: u})
+		if _, err := fmt.Fprintf(opts.progress, "Provisioned %s.\n", s.description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (opts *CreateOpts) rollback(undos []*undo, cause error) error {
+	_, _ = fmt.Fprintf(opts.progress, "Provisioning failed, rolling back.\n")
+	ctx, cancel := context.WithTimeout(context.Background(), rollbackTimeout)
+	defer cancel()
+	s, err := opts.rollbackStore(ctx)
+	if err != nil {
+		return fmt.Errorf("%w, rollback failed: %v", cause, err)
+	}
+	var failed []string
+	for i := len(undos) - 1; i >= 0; i-- {
+		if err := undos[i].run(s); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", undos[i].description, err))
+			continue
+		}
+		_, _ = fmt.Fprintf(opts.progress, "Rolled back %s.\n", undos[i].description)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%w, rollback failed, remove these manually: %s", cause, strings.Join(failed, "; "))
+	}
+	return fmt.Errorf("%w, project rolled back", cause)
+}
+
+// steps returns the steps of the template in the order they depend on each other,
+// custom roles come before the users granted them and teams before the alerts notifying them
+func (opts *CreateOpts) steps(projectID string, t *Template) []*step {
+	var steps []*step
+	for _, team := range t.Teams {
+		steps = append(steps, opts.teamStep(projectID, team))
+	}
+	for _, r := range t.CustomDBRoles {
+		role := r.NewCustomDBRole()
+		steps = append(steps, &step{
+			description: fmt.Sprintf("custom role %s", role.RoleName),
+			run: func() (func(store.ProjectProvisioner) error, error) {
+				_, err := opts.store.CreateDatabaseRole(projectID, role)
+				return func(s store.ProjectProvisioner) error { return s.DeleteDatabaseRole(projectID, role.RoleName) }, err
+			},
+		})
+	}
+	for _, u := range t.DBUsers {
+		u := u
+		steps = append(steps, &step{
+			description: fmt.Sprintf("database user %s", u.Username),
+			run: func() (func(store.ProjectProvisioner) error, error) {
+				_, err := opts.store.CreateDatabaseUser(u.NewAtlasDatabaseUser(projectID))
+				return func(s store.ProjectProvisioner) error { return s.DeleteDatabaseUser(u.AuthDB(), projectID, u.Username) }, err
+			},
+		})
+	}
+	if len(t.AccessList) > 0 {
+		steps = append(steps, opts.accessListStep(projectID, t))
+	}
+	if t.MaintenanceWindow != nil {
+		steps = append(steps, &step{
+			description: "maintenance window",
+			run: func() (func(store.ProjectProvisioner) error, error) {
+				err := opts.store.UpdateMaintenanceWindow(projectID, t.MaintenanceWindow.NewMaintenanceWindow())
+				return func(s store.ProjectProvisioner) error { return s.ClearMaintenanceWindow(projectID) }, err
+			},
+		})
+	}
+	for _, i := range t.Integrations {
+		integration := i.NewThirdPartyIntegration()
+		steps = append(steps, &step{
+			description: fmt.Sprintf("integration %s", integration.Type),
+			run: func() (func(store.ProjectProvisioner) error, error) {
+				_, err := opts.store.CreateIntegration(projectID, integration.Type, integration)
+				return func(s store.ProjectProvisioner) error { return s.DeleteIntegration(projectID, integration.Type) }, err
+			},
+		})
+	}
+	for _, a := range t.Alerts {
+		alert := a.NewAlertConfiguration(projectID)
+		steps = append(steps, &step{
+			description: fmt.Sprintf("alert configuration for %s", alert.EventTypeName),
+			run: func() (func(store.ProjectProvisioner) error, error) {
+				r, err := opts.store.CreateAlertConfiguration(alert)
+				if err != nil {
+					return nil, err
+				}
+				return func(s store.ProjectProvisioner) error { return s.DeleteAlertConfiguration(projectID, r.ID) }, nil
+			},
+		})
+	}
+	return steps
+}
+
+func (opts *CreateOpts) teamStep(projectID string, team *TemplateTeam) *step {
+	name := team.ID
+	if team.Name != "" {
+		name = team.Name
+	}
+	return &step{
+		description: fmt.Sprintf("team %s", name),
+		run: func() (func(store.ProjectProvisioner) error, error) {
+			teamID := team.ID
+			if teamID == "" {
+				t, err := opts.store.TeamByName(opts.ConfigOrgID(), team.Name)
+				if err != nil {
+					return nil, err
+				}
+				teamID = t.ID
+			}
+			_, err := opts.store.AddTeamsToProject(projectID, []*atlas.ProjectTeam{{TeamID: teamID, RoleNames: team.Roles}})
+			return func(s store.ProjectProvisioner) error { return s.DeleteTeamFromProject(projectID, teamID) }, err
+		},
+	}
+}
+
+func (opts *CreateOpts) accessListStep(projectID string, t *Template) *step {
+	now := time.Now()
+	entries := make([]*atlas.ProjectIPAccessList, len(t.AccessList))
+	for i, e := range t.AccessList {
+		entries[i] = e.NewProjectIPAccessList(projectID, now)
+	}
+	return &step{
+		description: fmt.Sprintf("%d access list entries", len(entries)),
+		run: func() (func(store.ProjectProvisioner) error, error) {
+			_, err := opts.store.CreateProjectIPAccessList(entries)
+			return func(s store.ProjectProvisioner) error {
+				for _, e := range entries {
+					entry := e.CIDRBlock
+					switch {
+					case e.IPAddress != "":
+						entry = e.IPAddress
+					case e.AwsSecurityGroup != "":
+						entry = e.AwsSecurityGroup
+					}
+					if err := s.DeleteProjectIPAccessList(projectID, entry); err != nil {
+						return err
+					}
+				}
+				return nil
+			}, err
+		},
+	}
+}
+
+// mongocli iam project(s) create <name> [--template template.yaml] [--orgId orgId]
 func CreateBuilder() *cobra.Command {
-	opts := &CreateOpts{}
+	opts := &CreateOpts{
+		fs: afero.NewOsFs(),
+	}
 	opts.Template = createTemplate
 	cmd := &cobra.Command{
 		Use:   "create <name>",
 		Short: "Create a project.",
-		Args:  require.ExactArgs(1),
+		Long: `With --template the project is provisioned with the teams, custom roles, database users, access list entries, maintenance window, integrations and alert configurations of the template, in that order.
+If any of them fails, the command being interrupted included, everything provisioned so far is removed and the project is deleted.`,
+		Example: `  $ mongocli iam projects create myTeam --template project.yaml --orgId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			opts.OutWriter = cmd.OutOrStdout()
-			return opts.init()
+			opts.progress = cmd.ErrOrStderr()
+			return opts.init(cmd.Context())()
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.name = args[0]
@@ -74,8 +314,11 @@ func CreateBuilder() *cobra.Command {
 			return opts.Run()
 		},
 	}
+	cmd.Flags().StringVar(&opts.template, flag.Template, "", usage.ProjectTemplate)
 	cmd.Flags().StringVar(&opts.OrgID, flag.OrgID, "", usage.OrgID)
 	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
 
+	_ = cmd.MarkFlagFilename(flag.Template)
+
 	return cmd
 }
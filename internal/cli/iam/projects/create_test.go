@@ -17,16 +17,39 @@
 package projects
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/spf13/afero"
 	"go.mongodb.org/atlas/mongodbatlas"
 )
 
+const projectTemplate = `teams:
+  - name: platform
+    roles: [GROUP_OWNER]
+dbUsers:
+  - username: app
+    password: ${PROJECT_TEST_APP_PASSWORD}
+    roles: [readWrite@app]
+alerts:
+  - eventTypeName: no_primary
+    enabled: true
+    notifications:
+      - typeName: group
+        emailEnabled: true
+`
+
 func TestCreate_Run(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	mockStore := mocks.NewMockProjectCreator(ctrl)
+	mockStore := mocks.NewMockProjectProvisioner(ctrl)
 	defer ctrl.Finish()
 
 	expected := &mongodbatlas.Project{}
@@ -46,3 +69,204 @@ func TestCreate_Run(t *testing.T) {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 }
+
+func TestCreate_RunTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	os.Setenv("PROJECT_TEST_APP_PASSWORD", "secret")
+	defer os.Unsetenv("PROJECT_TEST_APP_PASSWORD")
+
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "project.yaml", []byte(projectTemplate), 0600)
+	opts := &CreateOpts{
+		OutputOpts: cli.OutputOpts{OutWriter: new(bytes.Buffer)},
+		store:      mockStore,
+		name:       "ProjectBar",
+		template:   "project.yaml",
+		fs:         appFS,
+		progress:   new(bytes.Buffer),
+		rollbackStore: func(context.Context) (store.ProjectProvisioner, error) {
+			return mockStore, nil
+		},
+	}
+	opts.OrgID = "5a0a1e7e0f2912c554080adc"
+	const projectID = "5e2211c17a3e5a48f5497de3"
+
+	gomock.InOrder(
+		mockStore.
+			EXPECT().
+			CreateProject("ProjectBar", opts.OrgID).
+			Return(&mongodbatlas.Project{ID: projectID}, nil),
+		mockStore.
+			EXPECT().
+			TeamByName(opts.OrgID, "platform").
+			Return(&mongodbatlas.Team{ID: "team1"}, nil),
+		mockStore.
+			EXPECT().
+			AddTeamsToProject(projectID, []*mongodbatlas.ProjectTeam{{TeamID: "team1", RoleNames: []string{"GROUP_OWNER"}}}).
+			Return(&mongodbatlas.TeamsAssigned{}, nil),
+		mockStore.
+			EXPECT().
+			CreateDatabaseUser(gomock.Any()).
+			DoAndReturn(func(u *mongodbatlas.DatabaseUser) (*mongodbatlas.DatabaseUser, error) {
+				if u.GroupID != projectID || u.Username != "app" || u.Password != "secret" {
+					t.Errorf("CreateDatabaseUser() unexpected user %+v", u)
+				}
+				return u, nil
+			}),
+		mockStore.
+			EXPECT().
+			CreateAlertConfiguration(gomock.Any()).
+			DoAndReturn(func(a *mongodbatlas.AlertConfiguration) (*mongodbatlas.AlertConfiguration, error) {
+				if a.GroupID != projectID || a.EventTypeName != "NO_PRIMARY" || a.Notifications[0].TypeName != "GROUP" {
+					t.Errorf("CreateAlertConfiguration() unexpected alert configuration %+v", a)
+				}
+				return &mongodbatlas.AlertConfiguration{ID: "alert1"}, nil
+			}),
+	)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+}
+
+func TestCreate_RunTemplateRollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	os.Setenv("PROJECT_TEST_APP_PASSWORD", "secret")
+	defer os.Unsetenv("PROJECT_TEST_APP_PASSWORD")
+
+	progress := new(bytes.Buffer)
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "project.yaml", []byte(projectTemplate), 0600)
+	opts := &CreateOpts{
+		OutputOpts: cli.OutputOpts{OutWriter: new(bytes.Buffer)},
+		store:      mockStore,
+		name:       "ProjectBar",
+		template:   "project.yaml",
+		fs:         appFS,
+		progress:   progress,
+		rollbackStore: func(context.Context) (store.ProjectProvisioner, error) {
+			return mockStore, nil
+		},
+	}
+	opts.OrgID = "5a0a1e7e0f2912c554080adc"
+	const projectID = "5e2211c17a3e5a48f5497de3"
+
+	gomock.InOrder(
+		mockStore.
+			EXPECT().
+			CreateProject("ProjectBar", opts.OrgID).
+			Return(&mongodbatlas.Project{ID: projectID}, nil),
+		mockStore.
+			EXPECT().
+			TeamByName(opts.OrgID, "platform").
+			Return(&mongodbatlas.Team{ID: "team1"}, nil),
+		mockStore.
+			EXPECT().
+			AddTeamsToProject(projectID, gomock.Any()).
+			Return(&mongodbatlas.TeamsAssigned{}, nil),
+		mockStore.
+			EXPECT().
+			CreateDatabaseUser(gomock.Any()).
+			Return(nil, errors.New("DUPLICATE_DATABASE_USER")),
+		mockStore.
+			EXPECT().
+			DeleteTeamFromProject(projectID, "team1").
+			Return(nil),
+		mockStore.
+			EXPECT().
+			DeleteProject(projectID).
+			Return(nil),
+	)
+
+	err := opts.Run()
+	if err == nil || !strings.Contains(err.Error(), "database user app: DUPLICATE_DATABASE_USER") {
+		t.Fatalf("Run() expected the database user error, got: %v", err)
+	}
+	want := `Provisioned team platform.
+Provisioning failed, rolling back.
+Rolled back team platform.
+Rolled back project 5e2211c17a3e5a48f5497de3.
+`
+	if got := progress.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCreate_RunTemplateRollbackCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockProjectProvisioner(ctrl)
+	rollbackStore := mocks.NewMockProjectProvisioner(ctrl)
+	defer ctrl.Finish()
+
+	os.Setenv("PROJECT_TEST_APP_PASSWORD", "secret")
+	defer os.Unsetenv("PROJECT_TEST_APP_PASSWORD")
+
+	appFS := afero.NewMemMapFs()
+	_ = afero.WriteFile(appFS, "project.yaml", []byte(projectTemplate), 0600)
+	opts := &CreateOpts{
+		OutputOpts: cli.OutputOpts{OutWriter: new(bytes.Buffer)},
+		store:      mockStore,
+		name:       "ProjectBar",
+		template:   "project.yaml",
+		fs:         appFS,
+		progress:   new(bytes.Buffer),
+		rollbackStore: func(ctx context.Context) (store.ProjectProvisioner, error) {
+			if ctx.Err() != nil {
+				t.Errorf("expected the rollback context to be alive, got %v", ctx.Err())
+			}
+			return rollbackStore, nil
+		},
+	}
+	opts.OrgID = "5a0a1e7e0f2912c554080adc"
+	const projectID = "5e2211c17a3e5a48f5497de3"
+
+	// the command is interrupted while the database user is created, its store fails from then on
+	gomock.InOrder(
+		mockStore.
+			EXPECT().
+			CreateProject("ProjectBar", opts.OrgID).
+			Return(&mongodbatlas.Project{ID: projectID}, nil),
+		mockStore.
+			EXPECT().
+			TeamByName(opts.OrgID, "platform").
+			Return(&mongodbatlas.Team{ID: "team1"}, nil),
+		mockStore.
+			EXPECT().
+			AddTeamsToProject(projectID, gomock.Any()).
+			Return(&mongodbatlas.TeamsAssigned{}, nil),
+		mockStore.
+			EXPECT().
+			CreateDatabaseUser(gomock.Any()).
+			Return(nil, context.Canceled),
+		rollbackStore.
+			EXPECT().
+			DeleteTeamFromProject(projectID, "team1").
+			Return(nil),
+		rollbackStore.
+			EXPECT().
+			DeleteProject(projectID).
+			Return(nil),
+	)
+
+	err := opts.Run()
+	if !errors.Is(err, context.Canceled) || !strings.Contains(err.Error(), "project rolled back") {
+		t.Fatalf("Run() expected a canceled and rolled back provisioning, got: %v", err)
+	}
+}
+
+func TestTemplate_Validate(t *testing.T) {
+	tmpl := &Template{Teams: []*TemplateTeam{{Name: "platform"}}}
+	if err := tmpl.Validate(); err == nil {
+		t.Error("Validate() expected an error for a team without roles")
+	}
+	tmpl = &Template{MaintenanceWindow: &TemplateMaintenanceWindow{DayOfWeek: 8}}
+	if err := tmpl.Validate(); err == nil {
+		t.Error("Validate() expected an error for an invalid day of week")
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/convert"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+// Template is the document with everything a new project is provisioned with.
+// The access list, maintenance window, integrations, database users and custom roles are Atlas only,
+// secrets like passwords, tokens and keys set to ${VARIABLE} are read from that environment variable.
+type Template struct {
+	Teams             []*TemplateTeam                  `yaml:"teams,omitempty" json:"teams,omitempty"`
+	CustomDBRoles     []*convert.CustomDBRoleConfig    `yaml:"customDbRoles,omitempty" json:"customDbRoles,omitempty"`
+	DBUsers           []*convert.DatabaseUserConfig    `yaml:"dbUsers,omitempty" json:"dbUsers,omitempty"`
+	AccessList        []*convert.AccessListEntryConfig `yaml:"accessList,omitempty" json:"accessList,omitempty"`
+	MaintenanceWindow *TemplateMaintenanceWindow       `yaml:"maintenanceWindow,omitempty" json:"maintenanceWindow,omitempty"`
+	Integrations      []*TemplateIntegration           `yaml:"integrations,omitempty" json:"integrations,omitempty"`
+	Alerts            []*TemplateAlertConfiguration    `yaml:"alerts,omitempty" json:"alerts,omitempty"`
+}
+
+// TemplateTeam is an organization team given roles in the project, by ID or by name
+type TemplateTeam struct {
+	ID    string   `yaml:"id,omitempty" json:"id,omitempty"`
+	Name  string   `yaml:"name,omitempty" json:"name,omitempty"`
+	Roles []string `yaml:"roles" json:"roles"`
+}
+
+// TemplateMaintenanceWindow is the weekly maintenance window of the project, days start on Sunday as 1
+type TemplateMaintenanceWindow struct {
+	DayOfWeek int `yaml:"dayOfWeek" json:"dayOfWeek"`
+	HourOfDay int `yaml:"hourOfDay" json:"hourOfDay"`
+}
+
+// TemplateIntegration is a third party service integration, only the fields of its type are set
+type TemplateIntegration struct {
+	Type        string `yaml:"type" json:"type"`
+	LicenseKey  string `yaml:"licenseKey,omitempty" json:"licenseKey,omitempty"`
+	AccountID   string `yaml:"accountId,omitempty" json:"accountId,omitempty"`
+	WriteToken  string `yaml:"writeToken,omitempty" json:"writeToken,omitempty"`
+	ReadToken   string `yaml:"readToken,omitempty" json:"readToken,omitempty"`
+	APIKey      string `yaml:"apiKey,omitempty" json:"apiKey,omitempty"`
+	Region      string `yaml:"region,omitempty" json:"region,omitempty"`
+	ServiceKey  string `yaml:"serviceKey,omitempty" json:"serviceKey,omitempty"`
+	APIToken    string `yaml:"apiToken,omitempty" json:"apiToken,omitempty"`
+	TeamName    string `yaml:"teamName,omitempty" json:"teamName,omitempty"`
+	ChannelName string `yaml:"channelName,omitempty" json:"channelName,omitempty"`
+	RoutingKey  string `yaml:"routingKey,omitempty" json:"routingKey,omitempty"`
+	FlowName    string `yaml:"flowName,omitempty" json:"flowName,omitempty"`
+	OrgName     string `yaml:"orgName,omitempty" json:"orgName,omitempty"`
+	URL         string `yaml:"url,omitempty" json:"url,omitempty"`
+	Secret      string `yaml:"secret,omitempty" json:"secret,omitempty"`
+}
+
+// TemplateAlertConfiguration is an alert configuration of the project
+type TemplateAlertConfiguration struct {
+	EventTypeName   string                   `yaml:"eventTypeName" json:"eventTypeName"`
+	Enabled         bool                     `yaml:"enabled" json:"enabled"`
+	Matchers        []*TemplateMatcher       `yaml:"matchers,omitempty" json:"matchers,omitempty"`
+	MetricThreshold *TemplateMetricThreshold `yaml:"metricThreshold,omitempty" json:"metricThreshold,omitempty"`
+	Notifications   []*TemplateNotification  `yaml:"notifications" json:"notifications"`
+}
+
+// TemplateMatcher filters the hosts, replica sets or clusters an alert configuration applies to
+type TemplateMatcher struct {
+	FieldName string `yaml:"fieldName" json:"fieldName"`
+	Operator  string `yaml:"operator" json:"operator"`
+	Value     string `yaml:"value" json:"value"`
+}
+
+// TemplateMetricThreshold is the threshold of a metric alert configuration
+type TemplateMetricThreshold struct {
+	MetricName string  `yaml:"metricName" json:"metricName"`
+	Operator   string  `yaml:"operator" json:"operator"`
+	Threshold  float64 `yaml:"threshold" json:"threshold"`
+	Units      string  `yaml:"units,omitempty" json:"units,omitempty"`
+	Mode       string  `yaml:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// TemplateNotification is a notification of an alert configuration, only the fields of its type are set
+type TemplateNotification struct {
+	TypeName            string   `yaml:"typeName" json:"typeName"`
+	IntervalMin         int      `yaml:"intervalMin,omitempty" json:"intervalMin,omitempty"`
+	DelayMin            int      `yaml:"delayMin,omitempty" json:"delayMin,omitempty"`
+	EmailEnabled        bool     `yaml:"emailEnabled,omitempty" json:"emailEnabled,omitempty"`
+	SMSEnabled          bool     `yaml:"smsEnabled,omitempty" json:"smsEnabled,omitempty"`
+	EmailAddress        string   `yaml:"emailAddress,omitempty" json:"emailAddress,omitempty"`
+	MobileNumber        string   `yaml:"mobileNumber,omitempty" json:"mobileNumber,omitempty"`
+	Username            string   `yaml:"username,omitempty" json:"username,omitempty"`
+	TeamID              string   `yaml:"teamId,omitempty" json:"teamId,omitempty"`
+	Roles               []string `yaml:"roles,omitempty" json:"roles,omitempty"`
+	ChannelName         string   `yaml:"channelName,omitempty" json:"channelName,omitempty"`
+	APIToken            string   `yaml:"apiToken,omitempty" json:"apiToken,omitempty"`
+	DatadogAPIKey       string   `yaml:"datadogApiKey,omitempty" json:"datadogApiKey,omitempty"`
+	DatadogRegion       string   `yaml:"datadogRegion,omitempty" json:"datadogRegion,omitempty"`
+	OpsGenieAPIKey      string   `yaml:"opsGenieApiKey,omitempty" json:"opsGenieApiKey,omitempty"`
+	OpsGenieRegion      string   `yaml:"opsGenieRegion,omitempty" json:"opsGenieRegion,omitempty"`
+	ServiceKey          string   `yaml:"serviceKey,omitempty" json:"serviceKey,omitempty"`
+	VictorOpsAPIKey     string   `yaml:"victorOpsApiKey,omitempty" json:"victorOpsApiKey,omitempty"`
+	VictorOpsRoutingKey string   `yaml:"victorOpsRoutingKey,omitempty" json:"victorOpsRoutingKey,omitempty"`
+	FlowdockAPIToken    string   `yaml:"flowdockApiToken,omitempty" json:"flowdockApiToken,omitempty"`
+	FlowName            string   `yaml:"flowName,omitempty" json:"flowName,omitempty"`
+	OrgName             string   `yaml:"orgName,omitempty" json:"orgName,omitempty"`
+}
+
+// AtlasOnly reports whether the template provisions anything only Atlas supports
+func (t *Template) AtlasOnly() bool {
+	return len(t.CustomDBRoles) > 0 || len(t.DBUsers) > 0 || len(t.AccessList) > 0 ||
+		t.MaintenanceWindow != nil || len(t.Integrations) > 0
+}
+
+// Validate checks every section of the template the way the matching apply commands do
+func (t *Template) Validate() error {
+	for i, team := range t.Teams {
+		if team.ID == "" && team.Name == "" {
+			return fmt.Errorf("team %d has neither an id nor a name", i+1)
+		}
+		if len(team.Roles) == 0 {
+			return fmt.Errorf("team %d has no roles", i+1)
+		}
+	}
+	if len(t.CustomDBRoles) > 0 {
+		if err := (&convert.CustomDBRolesConfig{Roles: t.CustomDBRoles}).Validate(); err != nil {
+			return fmt.Errorf("customDbRoles: %w", err)
+		}
+	}
+	if len(t.DBUsers) > 0 {
+		if err := (&convert.DatabaseUsersConfig{Users: t.DBUsers}).Validate(); err != nil {
+			return fmt.Errorf("dbUsers: %w", err)
+		}
+	}
+	if len(t.AccessList) > 0 {
+		if err := (&convert.AccessListConfig{Entries: t.AccessList}).Validate(); err != nil {
+			return fmt.Errorf("accessList: %w", err)
+		}
+	}
+	if w := t.MaintenanceWindow; w != nil && (w.DayOfWeek < 1 || w.DayOfWeek > 7 || w.HourOfDay < 0 || w.HourOfDay > 23) {
+		return errors.New("maintenanceWindow: dayOfWeek must be between 1 and 7 and hourOfDay between 0 and 23")
+	}
+	seen := map[string]bool{}
+	for i, integration := range t.Integrations {
+		if integration.Type == "" {
+			return fmt.Errorf("integration %d has no type", i+1)
+		}
+		integrationType := strings.ToUpper(integration.Type)
+		if seen[integrationType] {
+			return fmt.Errorf("integration %s is listed more than once", integrationType)
+		}
+		seen[integrationType] = true
+	}
+	for i, alert := range t.Alerts {
+		if alert.EventTypeName == "" {
+			return fmt.Errorf("alert %d has no eventTypeName", i+1)
+		}
+		if len(alert.Notifications) == 0 {
+			return fmt.Errorf("alert %d has no notifications", i+1)
+		}
+		for _, n := range alert.Notifications {
+			if n.TypeName == "" {
+				return fmt.Errorf("alert %d has a notification without typeName", i+1)
+			}
+		}
+	}
+	return nil
+}
+
+// ExpandSecrets replaces the passwords, tokens and keys referencing an environment variable with its value
+func (t *Template) ExpandSecrets() error {
+	var secrets []*string
+	for _, u := range t.DBUsers {
+		secrets = append(secrets, &u.Password)
+	}
+	for _, i := range t.Integrations {
+		secrets = append(secrets, &i.LicenseKey, &i.WriteToken, &i.ReadToken, &i.APIKey, &i.ServiceKey, &i.APIToken, &i.RoutingKey, &i.Secret)
+	}
+	for _, a := range t.Alerts {
+		for _, n := range a.Notifications {
+			secrets = append(secrets, &n.APIToken, &n.DatadogAPIKey, &n.OpsGenieAPIKey, &n.ServiceKey, &n.VictorOpsAPIKey, &n.VictorOpsRoutingKey, &n.FlowdockAPIToken)
+		}
+	}
+	env := new(convert.EnvExpander)
+	for _, s := range secrets {
+		*s = env.Expand(*s)
+	}
+	return env.Err()
+}
+
+// NewMaintenanceWindow returns the Atlas maintenance window
+func (w *TemplateMaintenanceWindow) NewMaintenanceWindow() *atlas.MaintenanceWindow {
+	hourOfDay := w.HourOfDay
+	return &atlas.MaintenanceWindow{
+		DayOfWeek: w.DayOfWeek,
+		HourOfDay: &hourOfDay,
+	}
+}
+
+// NewThirdPartyIntegration returns the Atlas third party integration
+func (i *TemplateIntegration) NewThirdPartyIntegration() *atlas.ThirdPartyIntegration {
+	return &atlas.ThirdPartyIntegration{
+		Type:        strings.ToUpper(i.Type),
+		LicenseKey:  i.LicenseKey,
+		AccountID:   i.AccountID,
+		WriteToken:  i.WriteToken,
+		ReadToken:   i.ReadToken,
+		APIKey:      i.APIKey,
+		Region:      i.Region,
+		ServiceKey:  i.ServiceKey,
+		APIToken:    i.APIToken,
+		TeamName:    i.TeamName,
+		ChannelName: i.ChannelName,
+		RoutingKey:  i.RoutingKey,
+		FlowName:    i.FlowName,
+		OrgName:     i.OrgName,
+		URL:         i.URL,
+		Secret:      i.Secret,
+	}
+}
+
+// NewAlertConfiguration returns the Atlas alert configuration of the project
+func (a *TemplateAlertConfiguration) NewAlertConfiguration(projectID string) *atlas.AlertConfiguration {
+	enabled := a.Enabled
+	out := &atlas.AlertConfiguration{
+		GroupID:       projectID,
+		EventTypeName: strings.ToUpper(a.EventTypeName),
+		Enabled:       &enabled,
+	}
+	for _, m := range a.Matchers {
+		out.Matchers = append(out.Matchers, atlas.Matcher{
+			FieldName: strings.ToUpper(m.FieldName),
+			Operator:  strings.ToUpper(m.Operator),
+			Value:     m.Value,
+		})
+	}
+	if t := a.MetricThreshold; t != nil {
+		out.MetricThreshold = &atlas.MetricThreshold{
+			MetricName: strings.ToUpper(t.MetricName),
+			Operator:   strings.ToUpper(t.Operator),
+			Threshold:  t.Threshold,
+			Units:      strings.ToUpper(t.Units),
+			Mode:       strings.ToUpper(t.Mode),
+		}
+	}
+	for _, n := range a.Notifications {
+		out.Notifications = append(out.Notifications, *n.newNotification())
+	}
+	return out
+}
+
+func (n *TemplateNotification) newNotification() *atlas.Notification {
+	delayMin := n.DelayMin
+	out := &atlas.Notification{
+		TypeName:            strings.ToUpper(n.TypeName),
+		IntervalMin:         n.IntervalMin,
+		DelayMin:            &delayMin,
+		EmailAddress:        n.EmailAddress,
+		MobileNumber:        n.MobileNumber,
+		Username:            n.Username,
+		TeamID:              n.TeamID,
+		Roles:               n.Roles,
+		ChannelName:         n.ChannelName,
+		APIToken:            n.APIToken,
+		DatadogAPIKey:       n.DatadogAPIKey,
+		DatadogRegion:       strings.ToUpper(n.DatadogRegion),
+		OpsGenieAPIKey:      n.OpsGenieAPIKey,
+		OpsGenieRegion:      strings.ToUpper(n.OpsGenieRegion),
+		ServiceKey:          n.ServiceKey,
+		VictorOpsAPIKey:     n.VictorOpsAPIKey,
+		VictorOpsRoutingKey: n.VictorOpsRoutingKey,
+		FlowdockAPIToken:    n.FlowdockAPIToken,
+		FlowName:            n.FlowName,
+		OrgName:             n.OrgName,
+	}
+	// the flags only apply to the notifications of users, teams, the project and the organization
+	if n.EmailEnabled || n.SMSEnabled {
+		emailEnabled, smsEnabled := n.EmailEnabled, n.SMSEnabled
+		out.EmailEnabled = &emailEnabled
+		out.SMSEnabled = &smsEnabled
+	}
+	return out
+}
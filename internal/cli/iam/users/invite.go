@@ -15,6 +15,7 @@
 package users
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -46,10 +47,12 @@ type InviteOpts struct {
 	store        store.UserCreator
 }
 
-func (opts *InviteOpts) init() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *InviteOpts) init(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *InviteOpts) newUserRequest() (*store.UserRequest, error) {
@@ -242,7 +245,7 @@ func InviteBuilder() *cobra.Command {
 			if config.Service() != config.OpsManagerService {
 				_ = cmd.MarkFlagRequired(flag.Country)
 			}
-			return opts.init()
+			return opts.init(cmd.Context())()
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.Prompt(); err != nil {
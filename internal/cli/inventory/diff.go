@@ -0,0 +1,165 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	addedAction   = "added"
+	removedAction = "removed"
+	changedAction = "changed"
+
+	projectResource = "project"
+	clusterResource = "cluster"
+	processResource = "process"
+	agentResource   = "agent"
+)
+
+// Change is a difference between two inventories
+type Change struct {
+	Project  string `json:"project"`
+	Resource string `json:"resource"`
+	Name     string `json:"name"`
+	Action   string `json:"action"`
+	Field    string `json:"field,omitempty"`
+	From     string `json:"from,omitempty"`
+	To       string `json:"to,omitempty"`
+}
+
+// Changes lists the differences between two inventories
+type Changes []*Change
+
+// Records returns a CSV row per change
+func (c Changes) Records() [][]string {
+	records := [][]string{{"project", "resource", "name", "action", "field", "from", "to"}}
+	for _, change := range c {
+		records = append(records, []string{change.Project, change.Resource, change.Name, change.Action, change.Field, change.From, change.To})
+	}
+	return records
+}
+
+// resource is a comparable item of an inventory, identified by its name, with its fields in a fixed order
+type resource struct {
+	name   string
+	fields [][2]string
+}
+
+func clusterResources(clusters []*Cluster) []resource {
+	resources := make([]resource, len(clusters))
+	for i, c := range clusters {
+		resources[i] = resource{name: c.Name, fields: [][2]string{
+			{"type", c.Type},
+			{"tier", c.Tier},
+			{"mongoDBVersion", c.MongoDBVersion},
+			{"provider", c.Provider},
+			{"region", c.Region},
+			{"diskSizeGB", strconv.FormatFloat(c.DiskSizeGB, 'f', -1, 64)},
+			{"backup", strconv.FormatBool(c.Backup)},
+		}}
+	}
+	return resources
+}
+
+func processResources(processes []*Process) []resource {
+	resources := make([]resource, len(processes))
+	for i, p := range processes {
+		resources[i] = resource{name: p.Hostname + ":" + strconv.Itoa(p.Port), fields: [][2]string{
+			{"type", p.Type},
+			{"version", p.Version},
+		}}
+	}
+	return resources
+}
+
+func agentResources(agents []*Agent) []resource {
+	resources := make([]resource, len(agents))
+	for i, a := range agents {
+		resources[i] = resource{name: a.Hostname, fields: [][2]string{
+			{"version", a.Version},
+		}}
+	}
+	return resources
+}
+
+// projectSettings uses the given name so a renamed project is compared with itself
+func projectSettings(p *Project, name string) resource {
+	return resource{name: name, fields: [][2]string{
+		{"databaseUsers", strconv.Itoa(p.DatabaseUsers)},
+		{"integrations", strings.Join(p.Integrations, ",")},
+	}}
+}
+
+// diffResources lists the resources added to, changed in and removed from the project
+func diffResources(project, kind string, previous, current []resource) Changes {
+	var changes Changes
+	before := map[string]resource{}
+	for _, r := range previous {
+		before[r.name] = r
+	}
+	after := map[string]bool{}
+	for _, r := range current {
+		after[r.name] = true
+		b, ok := before[r.name]
+		if !ok {
+			changes = append(changes, &Change{Project: project, Resource: kind, Name: r.name, Action: addedAction})
+			continue
+		}
+		for i, f := range r.fields {
+			if from := b.fields[i][1]; from != f[1] {
+				changes = append(changes, &Change{Project: project, Resource: kind, Name: r.name, Action: changedAction, Field: f[0], From: from, To: f[1]})
+			}
+		}
+	}
+	for _, r := range previous {
+		if !after[r.name] {
+			changes = append(changes, &Change{Project: project, Resource: kind, Name: r.name, Action: removedAction})
+		}
+	}
+	return changes
+}
+
+// Diff lists what changed from the previous inventory to the current one, projects are matched by ID
+func Diff(previous, current *Inventory) Changes {
+	changes := Changes{}
+	before := map[string]*Project{}
+	for _, p := range previous.Projects {
+		before[p.ID] = p
+	}
+	after := map[string]bool{}
+	for _, p := range current.Projects {
+		after[p.ID] = true
+		b, ok := before[p.ID]
+		if !ok {
+			changes = append(changes, &Change{Project: p.Name, Resource: projectResource, Name: p.Name, Action: addedAction})
+			continue
+		}
+		if b.Name != p.Name {
+			changes = append(changes, &Change{Project: p.Name, Resource: projectResource, Name: p.Name, Action: changedAction, Field: "name", From: b.Name, To: p.Name})
+		}
+		changes = append(changes, diffResources(p.Name, projectResource, []resource{projectSettings(b, p.Name)}, []resource{projectSettings(p, p.Name)})...)
+		changes = append(changes, diffResources(p.Name, clusterResource, clusterResources(b.Clusters), clusterResources(p.Clusters))...)
+		changes = append(changes, diffResources(p.Name, processResource, processResources(b.Processes), processResources(p.Processes))...)
+		changes = append(changes, diffResources(p.Name, agentResource, agentResources(b.Agents), agentResources(p.Agents))...)
+	}
+	for _, p := range previous.Projects {
+		if !after[p.ID] {
+			changes = append(changes, &Change{Project: p.Name, Resource: projectResource, Name: p.Name, Action: removedAction})
+		}
+	}
+	return changes
+}
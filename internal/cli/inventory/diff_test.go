@@ -0,0 +1,67 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package inventory
+
+import (
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	previous := &Inventory{Projects: []*Project{
+		{ID: "1", Name: "prod", Clusters: []*Cluster{{Name: "a", Tier: "M10"}, {Name: "b"}}},
+		{ID: "2", Name: "dev"},
+	}}
+	current := &Inventory{Projects: []*Project{
+		{ID: "1", Name: "prod", DatabaseUsers: 1, Clusters: []*Cluster{{Name: "a", Tier: "M20"}, {Name: "c"}}},
+		{ID: "3", Name: "test"},
+	}}
+
+	want := []Change{
+		{Project: "prod", Resource: projectResource, Name: "prod", Action: changedAction, Field: "databaseUsers", From: "0", To: "1"},
+		{Project: "prod", Resource: clusterResource, Name: "a", Action: changedAction, Field: "tier", From: "M10", To: "M20"},
+		{Project: "prod", Resource: clusterResource, Name: "c", Action: addedAction},
+		{Project: "prod", Resource: clusterResource, Name: "b", Action: removedAction},
+		{Project: "test", Resource: projectResource, Name: "test", Action: addedAction},
+		{Project: "dev", Resource: projectResource, Name: "dev", Action: removedAction},
+	}
+	got := Diff(previous, current)
+	if len(got) != len(want) {
+		t.Fatalf("got %d changes, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if *got[i] != want[i] {
+			t.Errorf("change %d: got %+v, want %+v", i, *got[i], want[i])
+		}
+	}
+}
+
+func TestInventory_Records(t *testing.T) {
+	i := &Inventory{Projects: []*Project{
+		{ID: "1", Name: "prod", Clusters: []*Cluster{{Name: "a", MongoDBVersion: "4.4.4", DiskSizeGB: 10, Backup: true}}},
+		{ID: "2", Name: "empty"},
+	}}
+	records := i.Records()
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3", len(records))
+	}
+	if got := records[1]; got[2] != "a" || got[8] != "10" || got[9] != "true" {
+		t.Errorf("unexpected record %v", got)
+	}
+	if got := records[2]; got[1] != "empty" || got[2] != "" {
+		t.Errorf("unexpected record %v", got)
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/file"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/mongodb/mongocli/internal/validate"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	maxItemsPerPage = 500
+	tenantProvider  = "TENANT"
+)
+
+var inventoryTemplate = `PROJECT	CLUSTERS	PROCESSES	DB USERS	AGENTS	VERSIONS{{range .Projects}}
+{{.Name}}	{{len .Clusters}}	{{len .Processes}}	{{.DatabaseUsers}}	{{len .Agents}}	{{.Versions}}{{end}}
+`
+
+var diffTemplate = `PROJECT	RESOURCE	NAME	ACTION	FIELD	FROM	TO{{range .}}
+{{.Project}}	{{.Resource}}	{{.Name}}	{{.Action}}	{{.Field}}	{{.From}}	{{.To}}{{end}}
+`
+
+type Opts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	cli.AllProjectsOpts
+	diffFile string
+	service  string
+	now      func() time.Time
+	fs       afero.Fs
+	store    store.InventoryLister
+}
+
+func (opts *Opts) initStore(ctx context.Context) func() error {
+	return func() error {
+		s, err := store.New(config.Default(), store.WithContext(ctx))
+		opts.store, opts.ProjectStore = s, s
+		return err
+	}
+}
+
+func (opts *Opts) Run() error {
+	inventory, err := opts.inventory()
+	if err != nil {
+		return err
+	}
+	if opts.diffFile == "" {
+		return opts.Print(inventory)
+	}
+
+	previous := new(Inventory)
+	if err := file.Load(opts.fs, opts.diffFile, previous); err != nil {
+		return err
+	}
+	opts.Template = diffTemplate
+	return opts.Print(Diff(previous, inventory))
+}
+
+// inventory walks every project of the organization, Ops Manager clusters are listed once for all projects
+func (opts *Opts) inventory() (*Inventory, error) {
+	inventory := &Inventory{
+		OrgID:     opts.ConfigOrgID(),
+		CreatedAt: opts.now().UTC().Format(time.RFC3339),
+		Projects:  []*Project{},
+	}
+
+	var clusters map[string][]opsmngr.AllClustersCluster
+	if opts.service != config.CloudService {
+		all, err := opts.store.ListAllProjectClusters()
+		if err != nil {
+			return nil, err
+		}
+		clusters = map[string][]opsmngr.AllClustersCluster{}
+		for _, p := range all.Results {
+			if p.OrgID == inventory.OrgID {
+				clusters[p.GroupID] = p.Clusters
+			}
+		}
+	}
+
+	results, err := opts.ForEachProject(inventory.OrgID, func(projectID string) (interface{}, error) {
+		if opts.service == config.CloudService {
+			return opts.atlasProject(projectID)
+		}
+		return opts.opsManagerProject(projectID, clusters[projectID])
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		p := r.Result.(*Project)
+		p.ID, p.Name = r.ProjectID, r.ProjectName
+		inventory.Projects = append(inventory.Projects, p)
+	}
+	return inventory, nil
+}
+
+func (opts *Opts) atlasProject(projectID string) (*Project, error) {
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxItemsPerPage}
+	p := &Project{Clusters: []*Cluster{}, Processes: []*Process{}}
+
+	r, err := opts.store.ProjectClusters(projectID, listOpts)
+	if err != nil {
+		return nil, err
+	}
+	clusters, _ := r.([]atlas.Cluster)
+	for i := range clusters {
+		p.Clusters = append(p.Clusters, newAtlasCluster(&clusters[i]))
+	}
+
+	processes, err := opts.store.Processes(projectID, &atlas.ProcessesListOptions{ListOptions: *listOpts})
+	if err != nil {
+		return nil, err
+	}
+	for _, process := range processes {
+		p.Processes = append(p.Processes, &Process{
+			Hostname:       process.Hostname,
+			Port:           process.Port,
+			Type:           process.TypeName,
+			ReplicaSetName: process.ReplicaSetName,
+			ShardName:      process.ShardName,
+			Version:        process.Version,
+		})
+	}
+
+	users, err := opts.store.DatabaseUsers(projectID, listOpts)
+	if err != nil {
+		return nil, err
+	}
+	p.DatabaseUsers = len(users)
+
+	integrations, err := opts.store.Integrations(projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range integrations.Results {
+		p.Integrations = append(p.Integrations, i.Type)
+	}
+
+	return p, nil
+}
+
+func newAtlasCluster(c *atlas.Cluster) *Cluster {
+	cluster := &Cluster{
+		ID:             c.ID,
+		Name:           c.Name,
+		Type:           c.ClusterType,
+		MongoDBVersion: c.MongoDBVersion,
+		Backup:         c.ProviderBackupEnabled != nil && *c.ProviderBackupEnabled || c.BackupEnabled != nil && *c.BackupEnabled,
+	}
+	if c.DiskSizeGB != nil {
+		cluster.DiskSizeGB = *c.DiskSizeGB
+	}
+	if s := c.ProviderSettings; s != nil {
+		cluster.Tier = s.InstanceSizeName
+		cluster.Provider = s.ProviderName
+		if s.ProviderName == tenantProvider {
+			cluster.Provider = s.BackingProviderName
+		}
+		cluster.Region = s.RegionName
+	}
+	return cluster
+}
+
+func (opts *Opts) opsManagerProject(projectID string, clusters []opsmngr.AllClustersCluster) (*Project, error) {
+	p := &Project{Clusters: []*Cluster{}, Processes: []*Process{}}
+	for _, c := range clusters {
+		p.Clusters = append(p.Clusters, &Cluster{
+			ID:             c.ClusterID,
+			Name:           c.Name,
+			Type:           c.Type,
+			MongoDBVersion: strings.Join(c.Versions, ","),
+			DataSizeBytes:  c.DataSizeBytes,
+			Backup:         c.BackupEnabled,
+		})
+	}
+
+	hosts, err := opts.store.Hosts(projectID, &opsmngr.HostListOptions{ListOptions: atlas.ListOptions{ItemsPerPage: maxItemsPerPage}})
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hosts.Results {
+		p.Processes = append(p.Processes, &Process{
+			Hostname:       h.Hostname,
+			Port:           int(h.Port),
+			Type:           h.TypeName,
+			ReplicaSetName: h.ReplicaSetName,
+			ShardName:      h.ShardName,
+			Version:        h.Version,
+		})
+	}
+
+	automation, err := opts.store.GetAutomationConfig(projectID)
+	if err != nil {
+		return nil, err
+	}
+	p.DatabaseUsers = len(automation.Auth.Users)
+
+	// agent versions are only available with Ops Manager
+	if opts.service == config.OpsManagerService {
+		agents, err := opts.store.AgentProjectVersions(projectID)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range agents.Entries {
+			p.Agents = append(p.Agents, &Agent{Hostname: a.Hostname, Version: a.Version, VersionOld: a.IsVersionOld})
+		}
+	}
+
+	return p, nil
+}
+
+// mongocli inventory [--orgId orgId] [--projectName pattern] [--projectTag tag] [--diff file] [--output json|csv]
+func Builder() *cobra.Command {
+	opts := &Opts{
+		now: time.Now,
+		fs:  afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "inventory",
+		Short: "List the projects, clusters, processes, database users and agents of your organization.",
+		Long: `Build the inventory of every project of the organization: clusters with their tier, MongoDB version, provider, region,
+disk size and backup, processes, the number of database users, integrations and, for Ops Manager, agents and their versions.
+The default output is a summary per project, use --output json for the full inventory or --output csv for a row per cluster.
+Save the inventory as JSON and use --diff to list what changed since.`,
+		Example: `  $ mongocli inventory --orgId 5e2211c17a3e5a48f5497de3 --output json > inventory.json
+  $ mongocli inventory --orgId 5e2211c17a3e5a48f5497de3 --diff inventory.json`,
+		Args: cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return validate.Credentials()
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.service = config.Service()
+			return opts.PreRunE(
+				opts.ValidateOrgID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), inventoryTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ProjectName, flag.ProjectName, "", usage.InventoryProjectNamePattern)
+	cmd.Flags().StringVar(&opts.ProjectTag, flag.ProjectTag, "", usage.InventoryProjectTag)
+	cmd.Flags().StringVar(&opts.diffFile, flag.Diff, "", usage.InventoryDiff)
+
+	cmd.Flags().StringVar(&opts.OrgID, flag.OrgID, "", usage.OrgID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.ReportFormatOut)
+
+	_ = cmd.MarkFlagFilename(flag.Diff)
+
+	return cmd
+}
@@ -0,0 +1,208 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package inventory
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/spf13/afero"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const orgID = "5a0a1e7e0f2912c554080adc"
+
+func expectAtlasProject(mockStore *mocks.MockInventoryLister) {
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxItemsPerPage}
+	enabled := true
+	diskSize := 10.0
+	mockStore.
+		EXPECT().
+		GetOrgProjects(orgID, gomock.Any()).
+		Return(&atlas.Projects{Results: []*atlas.Project{{ID: "1", Name: "prod"}}, TotalCount: 1}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectClusters("1", listOpts).
+		Return([]atlas.Cluster{{
+			ID:                    "a",
+			Name:                  "Cluster0",
+			ClusterType:           "REPLICASET",
+			MongoDBVersion:        "4.0.23",
+			DiskSizeGB:            &diskSize,
+			ProviderBackupEnabled: &enabled,
+			ProviderSettings:      &atlas.ProviderSettings{ProviderName: "AWS", InstanceSizeName: "M10", RegionName: "US_EAST_1"},
+		}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		Processes("1", &atlas.ProcessesListOptions{ListOptions: *listOpts}).
+		Return([]*atlas.Process{{Hostname: "host0", Port: 27017, TypeName: "REPLICA_PRIMARY", Version: "4.0.23"}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DatabaseUsers("1", listOpts).
+		Return([]atlas.DatabaseUser{{Username: "a"}, {Username: "b"}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		Integrations("1").
+		Return(&atlas.ThirdPartyIntegrations{Results: []*atlas.ThirdPartyIntegration{{Type: "DATADOG"}}}, nil).
+		Times(1)
+}
+
+func TestInventory_RunAtlas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockInventoryLister(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &Opts{
+		GlobalOpts:      cli.GlobalOpts{OrgID: orgID},
+		OutputOpts:      cli.OutputOpts{Template: inventoryTemplate, OutWriter: buf},
+		AllProjectsOpts: cli.AllProjectsOpts{ProjectStore: mockStore},
+		service:         config.CloudService,
+		now:             func() time.Time { return time.Date(2021, 4, 20, 12, 0, 0, 0, time.UTC) },
+		fs:              afero.NewMemMapFs(),
+		store:           mockStore,
+	}
+	expectAtlasProject(mockStore)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `PROJECT   CLUSTERS   PROCESSES   DB USERS   AGENTS   VERSIONS
+prod      1          1           2          0        4.0.23
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestInventory_RunDiff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockInventoryLister(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &Opts{
+		GlobalOpts:      cli.GlobalOpts{OrgID: orgID},
+		OutputOpts:      cli.OutputOpts{Template: inventoryTemplate, OutWriter: buf},
+		AllProjectsOpts: cli.AllProjectsOpts{ProjectStore: mockStore},
+		diffFile:        "inventory.json",
+		service:         config.CloudService,
+		now:             func() time.Time { return time.Date(2021, 4, 20, 12, 0, 0, 0, time.UTC) },
+		fs:              afero.NewMemMapFs(),
+		store:           mockStore,
+	}
+	_ = afero.WriteFile(opts.fs, opts.diffFile, []byte(`{
+  "orgId": "5a0a1e7e0f2912c554080adc",
+  "projects": [{
+    "id": "1",
+    "name": "prod",
+    "databaseUsers": 2,
+    "integrations": ["DATADOG"],
+    "clusters": [{"name": "Cluster0", "type": "REPLICASET", "tier": "M10", "mongoDBVersion": "3.6.23", "provider": "AWS", "region": "US_EAST_1", "diskSizeGB": 10, "backup": true}],
+    "processes": [{"hostname": "host0", "port": 27017, "type": "REPLICA_PRIMARY", "version": "3.6.23"}]
+  }]
+}`), 0600)
+	expectAtlasProject(mockStore)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `PROJECT   RESOURCE   NAME          ACTION    FIELD            FROM     TO
+prod      cluster    Cluster0      changed   mongoDBVersion   3.6.23   4.0.23
+prod      process    host0:27017   changed   version          3.6.23   4.0.23
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestInventory_RunOpsManager(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockInventoryLister(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &Opts{
+		GlobalOpts:      cli.GlobalOpts{OrgID: orgID},
+		OutputOpts:      cli.OutputOpts{Template: inventoryTemplate, OutWriter: buf},
+		AllProjectsOpts: cli.AllProjectsOpts{ProjectStore: mockStore},
+		service:         config.OpsManagerService,
+		now:             func() time.Time { return time.Date(2021, 4, 20, 12, 0, 0, 0, time.UTC) },
+		fs:              afero.NewMemMapFs(),
+		store:           mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		ListAllProjectClusters().
+		Return(&opsmngr.AllClustersProjects{Results: []*opsmngr.AllClustersProject{
+			{GroupID: "1", OrgID: orgID, Clusters: []opsmngr.AllClustersCluster{{ClusterID: "a", Name: "rs", Type: "replica set", Versions: []string{"4.4.4"}, BackupEnabled: true}}},
+			{GroupID: "2", OrgID: "other", Clusters: []opsmngr.AllClustersCluster{{ClusterID: "b", Name: "other"}}},
+		}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		GetOrgProjects(orgID, gomock.Any()).
+		Return(&opsmngr.Projects{Results: []*opsmngr.Project{{ID: "1", Name: "prod"}}, TotalCount: 1}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		Hosts("1", gomock.Any()).
+		Return(&opsmngr.Hosts{Results: []*opsmngr.Host{{Hostname: "host0", Port: 27017, TypeName: "REPLICA_PRIMARY", Version: "4.4.4"}}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		GetAutomationConfig("1").
+		Return(&opsmngr.AutomationConfig{Auth: opsmngr.Auth{Users: []*opsmngr.MongoDBUser{{Username: "a"}}}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		AgentProjectVersions("1").
+		Return(&opsmngr.AgentVersions{Entries: []*opsmngr.AgentVersion{{Hostname: "host0", Version: "10.14.0"}}}, nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `PROJECT   CLUSTERS   PROCESSES   DB USERS   AGENTS   VERSIONS
+prod      1          1           1          1        4.4.4
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		Builder(),
+		0,
+		[]string{flag.OrgID, flag.ProjectName, flag.ProjectTag, flag.Diff, flag.Output},
+	)
+}
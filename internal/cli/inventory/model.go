@@ -0,0 +1,113 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Inventory lists the deployments of the projects of an organization
+type Inventory struct {
+	OrgID     string     `json:"orgId"`
+	CreatedAt string     `json:"createdAt"`
+	Projects  []*Project `json:"projects"`
+}
+
+// Project is the inventory of a project
+type Project struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Clusters      []*Cluster `json:"clusters"`
+	Processes     []*Process `json:"processes"`
+	DatabaseUsers int        `json:"databaseUsers"`
+	Integrations  []string   `json:"integrations,omitempty"`
+	Agents        []*Agent   `json:"agents,omitempty"`
+}
+
+// Cluster is a cluster of a project, tier, provider, region and disk size are only known for Atlas clusters
+type Cluster struct {
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	Tier           string  `json:"tier,omitempty"`
+	MongoDBVersion string  `json:"mongoDBVersion"`
+	Provider       string  `json:"provider,omitempty"`
+	Region         string  `json:"region,omitempty"`
+	DiskSizeGB     float64 `json:"diskSizeGB,omitempty"`
+	DataSizeBytes  int64   `json:"dataSizeBytes,omitempty"`
+	Backup         bool    `json:"backup"`
+}
+
+// Process is a mongod or mongos of a project
+type Process struct {
+	Hostname       string `json:"hostname"`
+	Port           int    `json:"port"`
+	Type           string `json:"type"`
+	ReplicaSetName string `json:"replicaSetName,omitempty"`
+	ShardName      string `json:"shardName,omitempty"`
+	Version        string `json:"version"`
+}
+
+// Agent is an Ops Manager agent
+type Agent struct {
+	Hostname   string `json:"hostname"`
+	Version    string `json:"version"`
+	VersionOld bool   `json:"versionOld"`
+}
+
+// Versions returns the MongoDB versions of the clusters of the project
+func (p *Project) Versions() string {
+	seen := map[string]bool{}
+	var versions []string
+	for _, c := range p.Clusters {
+		for _, v := range strings.Split(c.MongoDBVersion, ",") {
+			if v != "" && !seen[v] {
+				seen[v] = true
+				versions = append(versions, v)
+			}
+		}
+	}
+	sort.Strings(versions)
+	return strings.Join(versions, ", ")
+}
+
+var inventoryHeader = []string{"projectId", "projectName", "cluster", "type", "tier", "mongoDBVersion", "provider", "region", "diskSizeGB", "backup"}
+
+// Records returns a CSV row per cluster, projects without clusters have a row without a cluster
+func (i *Inventory) Records() [][]string {
+	records := [][]string{inventoryHeader}
+	for _, p := range i.Projects {
+		if len(p.Clusters) == 0 {
+			records = append(records, []string{p.ID, p.Name, "", "", "", "", "", "", "", ""})
+		}
+		for _, c := range p.Clusters {
+			records = append(records, []string{
+				p.ID,
+				p.Name,
+				c.Name,
+				c.Type,
+				c.Tier,
+				c.MongoDBVersion,
+				c.Provider,
+				c.Region,
+				strconv.FormatFloat(c.DiskSizeGB, 'f', -1, 64),
+				strconv.FormatBool(c.Backup),
+			})
+		}
+	}
+	return records
+}
@@ -16,6 +16,7 @@ package admin
 
 import (
 	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/opsmanager/admin/backup"
 	"github.com/mongodb/mongocli/internal/cli/opsmanager/admin/backup/blockstore"
 	"github.com/mongodb/mongocli/internal/cli/opsmanager/admin/backup/filesystem"
 	"github.com/mongodb/mongocli/internal/cli/opsmanager/admin/backup/oplog"
@@ -38,6 +39,8 @@ func BackupBuilder() *cobra.Command {
 		s3.Builder(),
 		oplog.Builder(),
 		sync.Builder(),
+		backup.ExportBuilder(),
+		backup.ApplyBuilder(),
 	)
 
 	return cmd
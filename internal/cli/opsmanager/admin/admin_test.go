@@ -34,7 +34,7 @@ func TestBackupBuilder(t *testing.T) {
 	test.CmdValidator(
 		t,
 		BackupBuilder(),
-		5,
+		7,
 		[]string{},
 	)
 }
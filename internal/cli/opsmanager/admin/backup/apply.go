@@ -0,0 +1,282 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/file"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	createAction = "create"
+	updateAction = "update"
+
+	blockstoreType   = "blockstore"
+	s3BlockstoreType = "s3Blockstore"
+	fileSystemType   = "fileSystem"
+	oplogStoreType   = "oplogStore"
+	syncStoreType    = "syncStore"
+)
+
+var applyTemplate = `TYPE	ID	ACTION{{range .}}
+{{.Type}}	{{.ID}}	{{.Action}}{{end}}
+`
+
+// StoreChange is a store the document creates or updates
+type StoreChange struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Action string `json:"action"`
+}
+
+type ApplyOpts struct {
+	cli.OutputOpts
+	cli.PlanOpts
+	filename string
+	fs       afero.Fs
+	store    store.BackupInfrastructureApplier
+}
+
+func (opts *ApplyOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ApplyOpts) Run() error {
+	infrastructure := new(Infrastructure)
+	if err := file.Load(opts.fs, opts.filename, infrastructure); err != nil {
+		return err
+	}
+	if err := infrastructure.Validate(); err != nil {
+		return err
+	}
+	if err := infrastructure.expandSecrets(); err != nil {
+		return err
+	}
+
+	current, err := currentInfrastructure(opts.store)
+	if err != nil {
+		return err
+	}
+	changes, operations := opts.plan(infrastructure, current)
+	if err := opts.Print(changes); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Apply %d changes to the backup infrastructure?", len(changes))
+	if ok, err := opts.Proceed(len(changes), message, "Backup infrastructure not applied"); err != nil || !ok {
+		return err
+	}
+
+	describe := func(i int) string {
+		return fmt.Sprintf("%s %s %s", changes[i].Action, changes[i].Type, changes[i].ID)
+	}
+	if err := opts.Apply(operations, describe); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(opts.Progress, "Backup infrastructure applied, %d stores changed.\n", len(changes))
+	return err
+}
+
+// plan compares every store of the document with the store with the same ID, stores that are not in the document are left as they are.
+// It returns the changes with the operation applying each one.
+func (opts *ApplyOpts) plan(desired, current *Infrastructure) ([]*StoreChange, []func() error) {
+	changes := []*StoreChange{}
+	var operations []func() error
+	add := func(storeType, id, action string, apply func() error) {
+		changes = append(changes, &StoreChange{Type: storeType, ID: id, Action: action})
+		operations = append(operations, apply)
+	}
+
+	planStores := func(storeType string, desired, current []*Store, create, update func(*opsmngr.BackupStore) error) {
+		existing := map[string]*Store{}
+		for _, s := range current {
+			existing[s.ID] = s
+		}
+		for _, s := range desired {
+			backupStore := s.backupStore()
+			c, ok := existing[s.ID]
+			if !ok {
+				add(storeType, s.ID, createAction, func() error { return create(backupStore) })
+			} else if !sameStore(s, c) {
+				add(storeType, s.ID, updateAction, func() error { return update(backupStore) })
+			}
+		}
+	}
+
+	planStores(blockstoreType, desired.Blockstores, current.Blockstores,
+		func(s *opsmngr.BackupStore) error {
+			_, err := opts.store.CreateBlockstore(s)
+			return err
+		},
+		func(s *opsmngr.BackupStore) error {
+			_, err := opts.store.UpdateBlockstore(s)
+			return err
+		})
+
+	existingS3 := map[string]*S3Store{}
+	for _, s := range current.S3Blockstores {
+		existingS3[s.ID] = s
+	}
+	for _, s := range desired.S3Blockstores {
+		s3Blockstore := s.s3Blockstore()
+		c, ok := existingS3[s.ID]
+		if !ok {
+			add(s3BlockstoreType, s.ID, createAction, func() error {
+				_, err := opts.store.CreateS3Blockstores(s3Blockstore)
+				return err
+			})
+		} else if !sameS3Store(s, c) {
+			add(s3BlockstoreType, s.ID, updateAction, func() error {
+				_, err := opts.store.UpdateS3Blockstores(s3Blockstore.ID, s3Blockstore)
+				return err
+			})
+		}
+	}
+
+	existingFileSystems := map[string]*FileSystemStore{}
+	for _, s := range current.FileSystems {
+		existingFileSystems[s.ID] = s
+	}
+	for _, s := range desired.FileSystems {
+		fileSystem := s.fileSystem()
+		c, ok := existingFileSystems[s.ID]
+		if !ok {
+			add(fileSystemType, s.ID, createAction, func() error {
+				_, err := opts.store.CreateFileSystems(fileSystem)
+				return err
+			})
+		} else if !reflect.DeepEqual(s, c) {
+			add(fileSystemType, s.ID, updateAction, func() error {
+				_, err := opts.store.UpdateFileSystems(fileSystem)
+				return err
+			})
+		}
+	}
+
+	planStores(oplogStoreType, desired.OplogStores, current.OplogStores,
+		func(s *opsmngr.BackupStore) error {
+			_, err := opts.store.CreateOplog(s)
+			return err
+		},
+		func(s *opsmngr.BackupStore) error {
+			_, err := opts.store.UpdateOplog(s.ID, s)
+			return err
+		})
+
+	planStores(syncStoreType, desired.SyncStores, current.SyncStores,
+		func(s *opsmngr.BackupStore) error {
+			_, err := opts.store.CreateSync(s)
+			return err
+		},
+		func(s *opsmngr.BackupStore) error {
+			_, err := opts.store.UpdateSync(s.ID, s)
+			return err
+		})
+
+	return changes, operations
+}
+
+// sameStore compares two stores, the password of the connection string included when the document sets one.
+// A password the API doesn't return differs, so the store is updated with the password of the document.
+func sameStore(desired, current *Store) bool {
+	x, y := *desired, *current
+	x.URI, y.URI = comparableURIs(x.URI, y.URI)
+	return reflect.DeepEqual(x, y)
+}
+
+// sameS3Store compares two S3 blockstores, their secrets included when the document sets them.
+func sameS3Store(desired, current *S3Store) bool {
+	x, y := *desired, *current
+	x.URI, y.URI = comparableURIs(x.URI, y.URI)
+	if x.AWSSecretKey == "" {
+		y.AWSSecretKey = ""
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// comparableURIs returns both connection strings with the same escaping,
+// the password of current is dropped when desired has none as the document leaves it as it is.
+func comparableURIs(desired, current string) (string, string) {
+	d, err := url.Parse(desired)
+	if err != nil {
+		return desired, current
+	}
+	c, err := url.Parse(current)
+	if err != nil {
+		return desired, current
+	}
+	if d.User == nil {
+		c.User = nil
+	} else if _, ok := d.User.Password(); !ok && c.User != nil {
+		c.User = url.User(c.User.Username())
+	}
+	return d.String(), c.String()
+}
+
+// mongocli ops-manager admin backup(s) apply --file backup.yaml [--dryRun] [--force]
+func ApplyBuilder() *cobra.Command {
+	opts := &ApplyOpts{
+		fs: afero.NewOsFs(),
+	}
+	opts.Template = applyTemplate
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create or update the backup infrastructure of your Ops Manager instance from a YAML document.",
+		Long: `The document has the format of the export command, stores are matched by ID, missing stores are created
+and stores that differ from the document are updated. Stores not listed in the document are left as they are,
+so applying the same document again changes nothing, unless Ops Manager doesn't return a secret of a store,
+the store is then updated every time to make sure the secret of the document is applied.
+Secrets are read from the environment variables referenced in the document as ${VARIABLE}.
+The changes are printed and confirmed before they are applied.`,
+		Example: `  $ export MCLI_BACKUP_BLOCKSTORE_1_PASSWORD=secret
+  $ mongocli ops-manager admin backups apply --file backup.yaml --dryRun`,
+		Args: require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.OutWriter = cmd.OutOrStdout()
+			opts.Progress = cmd.ErrOrStderr()
+			return opts.initStore(cmd.Context())()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.BackupInfrastructureFile)
+	cmd.Flags().BoolVar(&opts.DryRun, flag.DryRun, false, usage.ApplyDryRun)
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.File)
+	_ = cmd.MarkFlagFilename(flag.File)
+
+	return cmd
+}
@@ -0,0 +1,141 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"io"
+
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"gopkg.in/yaml.v2"
+)
+
+// maxItemsPerPage is large enough to list every store of an Ops Manager instance at once
+const maxItemsPerPage = 500
+
+type ExportOpts struct {
+	out       string
+	fs        afero.Fs
+	outWriter io.Writer
+	store     store.BackupInfrastructureExporter
+}
+
+func (opts *ExportOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ExportOpts) Run() error {
+	infrastructure, err := currentInfrastructure(opts.store)
+	if err != nil {
+		return err
+	}
+	infrastructure.redact()
+
+	b, err := yaml.Marshal(infrastructure)
+	if err != nil {
+		return err
+	}
+	if opts.out != "" {
+		return afero.WriteFile(opts.fs, opts.out, b, 0600)
+	}
+	_, err = opts.outWriter.Write(b)
+	return err
+}
+
+// currentInfrastructure lists every store of the instance
+func currentInfrastructure(s store.BackupInfrastructureExporter) (*Infrastructure, error) {
+	listOpts := &atlas.ListOptions{ItemsPerPage: maxItemsPerPage}
+	infrastructure := new(Infrastructure)
+
+	blockstores, err := s.ListBlockstores(listOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range blockstores.Results {
+		infrastructure.Blockstores = append(infrastructure.Blockstores, newStore(b))
+	}
+
+	s3Blockstores, err := s.ListS3Blockstores(listOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range s3Blockstores.Results {
+		infrastructure.S3Blockstores = append(infrastructure.S3Blockstores, newS3Store(b))
+	}
+
+	fileSystems, err := s.ListFileSystems(listOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fileSystems.Results {
+		infrastructure.FileSystems = append(infrastructure.FileSystems, newFileSystemStore(f))
+	}
+
+	oplogs, err := s.ListOplogs(listOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oplogs.Results {
+		infrastructure.OplogStores = append(infrastructure.OplogStores, newStore(o))
+	}
+
+	syncs, err := s.ListSyncs(listOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range syncs.Results {
+		infrastructure.SyncStores = append(infrastructure.SyncStores, newStore(o))
+	}
+
+	return infrastructure, nil
+}
+
+// mongocli ops-manager admin backup(s) export [--out file]
+func ExportBuilder() *cobra.Command {
+	opts := &ExportOpts{
+		fs: afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the backup infrastructure of your Ops Manager instance to a YAML document.",
+		Long: `The document lists the blockstores, S3 blockstores, file system stores, oplog stores and sync stores.
+Secrets are not exported, passwords in connection strings and AWS secret keys are replaced by a reference
+to an environment variable, for example ${MCLI_BACKUP_BLOCKSTORE_1_PASSWORD}, read when the document is applied.`,
+		Example: `  $ mongocli ops-manager admin backups export --out backup.yaml`,
+		Args:    require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.outWriter = cmd.OutOrStdout()
+			return opts.initStore(cmd.Context())()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.out, flag.Out, "", usage.BackupInfrastructureOut)
+
+	return cmd
+}
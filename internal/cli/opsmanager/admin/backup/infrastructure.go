@@ -0,0 +1,296 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/mongodb/mongocli/internal/convert"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	secretEnvPrefix = "MCLI_BACKUP_"
+	passwordSecret  = "PASSWORD"
+	awsSecretKey    = "AWS_SECRET_KEY"
+)
+
+// Infrastructure is the backup infrastructure of an Ops Manager instance.
+// Secrets are not exported, they are referenced as ${VARIABLE} and read from the environment when applied.
+type Infrastructure struct {
+	Blockstores   []*Store           `yaml:"blockstores,omitempty" json:"blockstores,omitempty"`
+	S3Blockstores []*S3Store         `yaml:"s3Blockstores,omitempty" json:"s3Blockstores,omitempty"`
+	FileSystems   []*FileSystemStore `yaml:"fileSystems,omitempty" json:"fileSystems,omitempty"`
+	OplogStores   []*Store           `yaml:"oplogStores,omitempty" json:"oplogStores,omitempty"`
+	SyncStores    []*Store           `yaml:"syncStores,omitempty" json:"syncStores,omitempty"`
+}
+
+// Store is a blockstore, oplog store or sync store
+type Store struct {
+	ID                   string   `yaml:"id" json:"id"`
+	URI                  string   `yaml:"uri,omitempty" json:"uri,omitempty"`
+	WriteConcern         string   `yaml:"writeConcern,omitempty" json:"writeConcern,omitempty"`
+	Labels               []string `yaml:"labels,omitempty" json:"labels,omitempty"`
+	SSL                  *bool    `yaml:"ssl,omitempty" json:"ssl,omitempty"`
+	AssignmentEnabled    *bool    `yaml:"assignmentEnabled,omitempty" json:"assignmentEnabled,omitempty"`
+	EncryptedCredentials *bool    `yaml:"encryptedCredentials,omitempty" json:"encryptedCredentials,omitempty"`
+	LoadFactor           *int64   `yaml:"loadFactor,omitempty" json:"loadFactor,omitempty"`
+	MaxCapacityGB        *int64   `yaml:"maxCapacityGB,omitempty" json:"maxCapacityGB,omitempty"`
+	Provisioned          *bool    `yaml:"provisioned,omitempty" json:"provisioned,omitempty"`
+	SyncSource           string   `yaml:"syncSource,omitempty" json:"syncSource,omitempty"`
+	Username             string   `yaml:"username,omitempty" json:"username,omitempty"`
+}
+
+// S3Store is an S3 blockstore
+type S3Store struct {
+	Store                  `yaml:",inline"`
+	AWSAccessKey           string `yaml:"awsAccessKey,omitempty" json:"awsAccessKey,omitempty"`
+	AWSSecretKey           string `yaml:"awsSecretKey,omitempty" json:"awsSecretKey,omitempty"`
+	S3AuthMethod           string `yaml:"s3AuthMethod,omitempty" json:"s3AuthMethod,omitempty"`
+	S3BucketEndpoint       string `yaml:"s3BucketEndpoint,omitempty" json:"s3BucketEndpoint,omitempty"`
+	S3BucketName           string `yaml:"s3BucketName,omitempty" json:"s3BucketName,omitempty"`
+	S3MaxConnections       int64  `yaml:"s3MaxConnections,omitempty" json:"s3MaxConnections,omitempty"`
+	DisableProxyS3         *bool  `yaml:"disableProxyS3,omitempty" json:"disableProxyS3,omitempty"`
+	AcceptedTos            *bool  `yaml:"acceptedTos,omitempty" json:"acceptedTos,omitempty"`
+	SSEEnabled             *bool  `yaml:"sseEnabled,omitempty" json:"sseEnabled,omitempty"`
+	PathStyleAccessEnabled *bool  `yaml:"pathStyleAccessEnabled,omitempty" json:"pathStyleAccessEnabled,omitempty"`
+}
+
+// FileSystemStore is a file system store
+type FileSystemStore struct {
+	Store                    `yaml:",inline"`
+	MMAPV1CompressionSetting string `yaml:"mmapv1CompressionSetting,omitempty" json:"mmapv1CompressionSetting,omitempty"`
+	StorePath                string `yaml:"storePath,omitempty" json:"storePath,omitempty"`
+	WTCompressionSetting     string `yaml:"wtCompressionSetting,omitempty" json:"wtCompressionSetting,omitempty"`
+}
+
+// Validate checks every store has an ID, unique among the stores of its type
+func (i *Infrastructure) Validate() error {
+	ids := map[string][]string{}
+	for _, s := range i.Blockstores {
+		ids["blockstores"] = append(ids["blockstores"], s.ID)
+	}
+	for _, s := range i.S3Blockstores {
+		ids["s3Blockstores"] = append(ids["s3Blockstores"], s.ID)
+	}
+	for _, s := range i.FileSystems {
+		ids["fileSystems"] = append(ids["fileSystems"], s.ID)
+	}
+	for _, s := range i.OplogStores {
+		ids["oplogStores"] = append(ids["oplogStores"], s.ID)
+	}
+	for _, s := range i.SyncStores {
+		ids["syncStores"] = append(ids["syncStores"], s.ID)
+	}
+	if len(ids) == 0 {
+		return errors.New("the document has no stores")
+	}
+	for storeType, storeIDs := range ids {
+		seen := map[string]bool{}
+		for j, id := range storeIDs {
+			if id == "" {
+				return fmt.Errorf("%s[%d]: id is required", storeType, j)
+			}
+			if seen[id] {
+				return fmt.Errorf("%s[%d]: duplicate id %q", storeType, j, id)
+			}
+			seen[id] = true
+		}
+	}
+	return nil
+}
+
+func newStore(s *opsmngr.BackupStore) *Store {
+	return &Store{
+		ID:                   s.ID,
+		URI:                  s.URI,
+		WriteConcern:         s.WriteConcern,
+		Labels:               s.Labels,
+		SSL:                  s.SSL,
+		AssignmentEnabled:    s.AssignmentEnabled,
+		EncryptedCredentials: s.EncryptedCredentials,
+		LoadFactor:           s.LoadFactor,
+		MaxCapacityGB:        s.MaxCapacityGB,
+		Provisioned:          s.Provisioned,
+		SyncSource:           s.SyncSource,
+		Username:             s.Username,
+	}
+}
+
+func (s *Store) backupStore() *opsmngr.BackupStore {
+	return &opsmngr.BackupStore{
+		AdminBackupConfig: opsmngr.AdminBackupConfig{
+			ID:                   s.ID,
+			URI:                  s.URI,
+			WriteConcern:         s.WriteConcern,
+			Labels:               s.Labels,
+			SSL:                  s.SSL,
+			AssignmentEnabled:    s.AssignmentEnabled,
+			EncryptedCredentials: s.EncryptedCredentials,
+		},
+		LoadFactor:    s.LoadFactor,
+		MaxCapacityGB: s.MaxCapacityGB,
+		Provisioned:   s.Provisioned,
+		SyncSource:    s.SyncSource,
+		Username:      s.Username,
+	}
+}
+
+func newS3Store(s *opsmngr.S3Blockstore) *S3Store {
+	return &S3Store{
+		Store:                  *newStore(&s.BackupStore),
+		AWSAccessKey:           s.AWSAccessKey,
+		AWSSecretKey:           s.AWSSecretKey,
+		S3AuthMethod:           s.S3AuthMethod,
+		S3BucketEndpoint:       s.S3BucketEndpoint,
+		S3BucketName:           s.S3BucketName,
+		S3MaxConnections:       s.S3MaxConnections,
+		DisableProxyS3:         s.DisableProxyS3,
+		AcceptedTos:            s.AcceptedTos,
+		SSEEnabled:             s.SSEEnabled,
+		PathStyleAccessEnabled: s.PathStyleAccessEnabled,
+	}
+}
+
+func (s *S3Store) s3Blockstore() *opsmngr.S3Blockstore {
+	return &opsmngr.S3Blockstore{
+		BackupStore:            *s.backupStore(),
+		AWSAccessKey:           s.AWSAccessKey,
+		AWSSecretKey:           s.AWSSecretKey,
+		S3AuthMethod:           s.S3AuthMethod,
+		S3BucketEndpoint:       s.S3BucketEndpoint,
+		S3BucketName:           s.S3BucketName,
+		S3MaxConnections:       s.S3MaxConnections,
+		DisableProxyS3:         s.DisableProxyS3,
+		AcceptedTos:            s.AcceptedTos,
+		SSEEnabled:             s.SSEEnabled,
+		PathStyleAccessEnabled: s.PathStyleAccessEnabled,
+	}
+}
+
+func newFileSystemStore(s *opsmngr.FileSystemStoreConfiguration) *FileSystemStore {
+	return &FileSystemStore{
+		Store:                    *newStore(&s.BackupStore),
+		MMAPV1CompressionSetting: s.MMAPV1CompressionSetting,
+		StorePath:                s.StorePath,
+		WTCompressionSetting:     s.WTCompressionSetting,
+	}
+}
+
+func (s *FileSystemStore) fileSystem() *opsmngr.FileSystemStoreConfiguration {
+	return &opsmngr.FileSystemStoreConfiguration{
+		BackupStore:              *s.backupStore(),
+		MMAPV1CompressionSetting: s.MMAPV1CompressionSetting,
+		StorePath:                s.StorePath,
+		WTCompressionSetting:     s.WTCompressionSetting,
+	}
+}
+
+// secretEnv returns the environment variable holding a secret of a store,
+// for example MCLI_BACKUP_BLOCKSTORE_1_PASSWORD for the password of blockstore-1.
+func secretEnv(id, secret string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, id)
+	return secretEnvPrefix + name + "_" + secret
+}
+
+// redactURI replaces the password of a connection string with a reference to its environment variable.
+func redactURI(id, uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.User == nil {
+		return uri
+	}
+	password, ok := u.User.Password()
+	if !ok || password == "" {
+		return uri
+	}
+	// the reference is added once the rest of the URI is escaped, url.URL would escape its braces
+	username := u.User.Username()
+	u.User = nil
+	rest := strings.TrimPrefix(u.String(), u.Scheme+"://")
+	return u.Scheme + "://" + url.User(username).String() + ":${" + secretEnv(id, passwordSecret) + "}@" + rest
+}
+
+// redact replaces every secret of the infrastructure with a reference to its environment variable.
+func (i *Infrastructure) redact() {
+	for _, stores := range [][]*Store{i.Blockstores, i.OplogStores, i.SyncStores} {
+		for _, s := range stores {
+			s.URI = redactURI(s.ID, s.URI)
+		}
+	}
+	for _, s := range i.S3Blockstores {
+		s.URI = redactURI(s.ID, s.URI)
+		if s.AWSSecretKey != "" || s.AWSAccessKey != "" {
+			s.AWSSecretKey = "${" + secretEnv(s.ID, awsSecretKey) + "}"
+		}
+	}
+}
+
+// expandURI replaces a password the connection string references as ${VARIABLE}, the way redactURI writes it,
+// with its value.
+func expandURI(env *convert.EnvExpander, uri string) string {
+	scheme := strings.Index(uri, "://")
+	if scheme < 0 {
+		return uri
+	}
+	start := scheme + len("://")
+	end := len(uri)
+	if i := strings.IndexAny(uri[start:], "/?"); i >= 0 {
+		end = start + i
+	}
+	at := strings.LastIndex(uri[start:end], "@")
+	if at < 0 {
+		return uri
+	}
+	userinfo := uri[start : start+at]
+	colon := strings.Index(userinfo, ":")
+	if colon < 0 {
+		return uri
+	}
+	reference := userinfo[colon+1:]
+	password := env.Expand(reference)
+	if password == reference {
+		return uri
+	}
+	username, err := url.PathUnescape(userinfo[:colon])
+	if err != nil {
+		return uri
+	}
+	return uri[:start] + url.UserPassword(username, password).String() + uri[start+at:]
+}
+
+// expandSecrets replaces the secrets referencing an environment variable as ${VARIABLE} with its value.
+func (i *Infrastructure) expandSecrets() error {
+	env := new(convert.EnvExpander)
+	for _, stores := range [][]*Store{i.Blockstores, i.OplogStores, i.SyncStores} {
+		for _, s := range stores {
+			s.URI = expandURI(env, s.URI)
+		}
+	}
+	for _, s := range i.S3Blockstores {
+		s.URI = expandURI(env, s.URI)
+		s.AWSAccessKey = env.Expand(s.AWSAccessKey)
+		s.AWSSecretKey = env.Expand(s.AWSSecretKey)
+	}
+	return env.Err()
+}
@@ -0,0 +1,204 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/file"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const configServerType = "CONFIG_SERVER_REPLICA_SET"
+
+var applyTemplate = `CLUSTER	FIELD	FROM	TO{{range .}}
+{{.ClusterName}}	{{.Field}}	{{.From}}	{{.To}}{{end}}
+`
+
+type ApplyOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	cli.PlanOpts
+	filename string
+	fs       afero.Fs
+	store    store.BackupApplier
+}
+
+func (opts *ApplyOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ApplyOpts) Run() error {
+	policy := new(BackupPolicy)
+	if err := file.Load(opts.fs, opts.filename, policy); err != nil {
+		return err
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	changes, plans, err := opts.plan(policy)
+	if err != nil {
+		return err
+	}
+	if err := opts.Print(changes); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Apply %d changes to the backup of project %s?", len(changes), opts.ConfigProjectID())
+	if ok, err := opts.Proceed(len(changes), message, "Backup policy not applied"); err != nil || !ok {
+		return err
+	}
+
+	return opts.apply(plans)
+}
+
+// plan compares every cluster of the project matching the policy with its current backup settings.
+// The snapshot schedule is only planned for clusters that have backup started after the change.
+func (opts *ApplyOpts) plan(policy *BackupPolicy) ([]*BackupChange, []*clusterPlan, error) {
+	r, err := opts.store.ProjectClusters(opts.ConfigProjectID(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	clusters, _ := r.(*opsmngr.Clusters)
+	if clusters == nil {
+		return nil, nil, nil
+	}
+
+	configs, err := opts.store.ListBackupConfigs(opts.ConfigProjectID(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	current := map[string]*opsmngr.BackupConfig{}
+	for _, c := range configs.Results {
+		current[c.ClusterID] = c
+	}
+
+	changes := []*BackupChange{}
+	var plans []*clusterPlan
+	for _, c := range clusters.Results {
+		// shards and config servers are backed up as part of their sharded cluster
+		if c.ShardName != "" || c.TypeName == configServerType {
+			continue
+		}
+		clusterPolicy := policy.Match(c.ClusterName)
+		if clusterPolicy == nil {
+			continue
+		}
+		backupConfig, ok := current[c.ID]
+		if !ok {
+			backupConfig = &opsmngr.BackupConfig{GroupID: opts.ConfigProjectID(), ClusterID: c.ID, StatusName: inactiveStatus}
+		}
+
+		p := &planner{clusterID: c.ID, clusterName: c.ClusterName}
+		plan := &clusterPlan{clusterID: c.ID, config: p.planConfig(clusterPolicy, backupConfig)}
+		started := backupConfig.StatusName == startedStatus
+		if plan.config != nil {
+			plan.config.GroupID = opts.ConfigProjectID()
+			if plan.config.StatusName != "" {
+				started = plan.config.StatusName == startedStatus
+			}
+		}
+		if clusterPolicy.Schedule != nil && started {
+			schedule, err := opts.store.GetSnapshotSchedule(opts.ConfigProjectID(), c.ID)
+			if err != nil {
+				return nil, nil, err
+			}
+			plan.schedule = p.planSchedule(clusterPolicy.Schedule, schedule)
+		}
+		if plan.config != nil || plan.schedule != nil {
+			changes = append(changes, p.changes...)
+			plans = append(plans, plan)
+		}
+	}
+	return changes, plans, nil
+}
+
+// apply updates the backup config before the schedule so backup is started before it is scheduled.
+func (opts *ApplyOpts) apply(plans []*clusterPlan) error {
+	for _, p := range plans {
+		if p.config != nil {
+			if _, err := opts.store.UpdateBackupConfig(p.config); err != nil {
+				return fmt.Errorf("updating backup config of cluster %s: %w", p.clusterID, err)
+			}
+		}
+		if p.schedule != nil {
+			if _, err := opts.store.UpdateSnapshotSchedule(opts.ConfigProjectID(), p.clusterID, p.schedule); err != nil {
+				return fmt.Errorf("updating snapshot schedule of cluster %s: %w", p.clusterID, err)
+			}
+		}
+	}
+	_, err := fmt.Fprintf(opts.Progress, "Backup policy applied to %d clusters.\n", len(plans))
+	return err
+}
+
+// mongocli ops-manager backup(s) apply --file policy.yaml [--dryRun] [--force] [--projectId projectId]
+func ApplyBuilder() *cobra.Command {
+	opts := &ApplyOpts{
+		fs: afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply a backup policy to the clusters of your project.",
+		Long: `The policy file lists cluster name patterns, the first pattern matching a cluster sets
+whether backup is started, the storage engine, the sync source, the excluded namespaces and the snapshot schedule.
+The changes are printed and confirmed before they are applied.`,
+		Example: `  Enable backup with a 6 hours snapshot interval for every production cluster:
+  $ cat policy.yaml
+  clusters:
+    - name: prod-*
+      backup: true
+      excludedNamespaces: [test.cache]
+      schedule:
+        snapshotIntervalHours: 6
+        dailySnapshotRetentionDays: 7
+  $ mongocli ops-manager backups apply --file policy.yaml --dryRun`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.Progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), applyTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.BackupPolicyFile)
+	cmd.Flags().BoolVar(&opts.DryRun, flag.DryRun, false, usage.ApplyDryRun)
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.File)
+	_ = cmd.MarkFlagFilename(flag.File)
+
+	return cmd
+}
@@ -0,0 +1,178 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/spf13/afero"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const policyYML = `
+clusters:
+  - name: prod-*
+    backup: true
+    excludedNamespaces: [test.cache]
+    schedule:
+      snapshotIntervalHours: 6
+      pointInTimeWindowHours: 24
+  - name: "*"
+    backup: false
+`
+
+func expectPlan(mockStore *mocks.MockBackupApplier, projectID string) {
+	mockStore.
+		EXPECT().
+		ProjectClusters(projectID, nil).
+		Return(&opsmngr.Clusters{Results: []*opsmngr.Cluster{
+			{ID: "1", ClusterName: "prod-a", TypeName: "REPLICA_SET"},
+			{ID: "2", ClusterName: "dev", TypeName: "REPLICA_SET"},
+			{ID: "3", ClusterName: "prod-a", ShardName: "shard0", TypeName: "REPLICA_SET"},
+		}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ListBackupConfigs(projectID, nil).
+		Return(&opsmngr.BackupConfigs{Results: []*opsmngr.BackupConfig{
+			{ClusterID: "1", StatusName: inactiveStatus},
+			{ClusterID: "2", StatusName: startedStatus},
+		}}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		GetSnapshotSchedule(projectID, "1").
+		Return(&opsmngr.SnapshotSchedule{ClusterID: "1", SnapshotIntervalHours: 24, SnapshotRetentionDays: 2}, nil).
+		Times(1)
+}
+
+func TestApply_Run(t *testing.T) {
+	t.Run("dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockBackupApplier(ctrl)
+		defer ctrl.Finish()
+
+		out := new(bytes.Buffer)
+		appFS := afero.NewMemMapFs()
+		_ = afero.WriteFile(appFS, "policy.yaml", []byte(policyYML), 0600)
+		opts := &ApplyOpts{
+			OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: out},
+			filename:   "policy.yaml",
+			fs:         appFS,
+			PlanOpts:   cli.PlanOpts{DryRun: true, Progress: new(bytes.Buffer)},
+			store:      mockStore,
+		}
+		expectPlan(mockStore, opts.ProjectID)
+
+		if err := opts.Run(); err != nil {
+			t.Fatalf("Run() unexpected error: %v", err)
+		}
+		want := `CLUSTER   FIELD                    FROM       TO
+prod-a    status                   INACTIVE   STARTED
+prod-a    excludedNamespaces                  test.cache
+prod-a    snapshotIntervalHours    24         6
+prod-a    pointInTimeWindowHours              24
+dev       status                   STARTED    STOPPED
+`
+		if got := out.String(); got != want {
+			t.Errorf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockBackupApplier(ctrl)
+		defer ctrl.Finish()
+
+		progress := new(bytes.Buffer)
+		appFS := afero.NewMemMapFs()
+		_ = afero.WriteFile(appFS, "policy.yaml", []byte(policyYML), 0600)
+		opts := &ApplyOpts{
+			OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: new(bytes.Buffer)},
+			filename:   "policy.yaml",
+			fs:         appFS,
+			PlanOpts:   cli.PlanOpts{Confirm: true, Progress: progress},
+			store:      mockStore,
+		}
+		expectPlan(mockStore, opts.ProjectID)
+
+		pitWindow := 24
+		gomock.InOrder(
+			mockStore.
+				EXPECT().
+				UpdateBackupConfig(&opsmngr.BackupConfig{ClusterID: "1", StatusName: startedStatus, ExcludedNamespaces: []string{"test.cache"}}).
+				Return(nil, nil).
+				Times(1),
+			mockStore.
+				EXPECT().
+				UpdateSnapshotSchedule(opts.ProjectID, "1", &opsmngr.SnapshotSchedule{ClusterID: "1", SnapshotIntervalHours: 6, SnapshotRetentionDays: 2, PointInTimeWindowHours: &pitWindow}).
+				Return(nil, nil).
+				Times(1),
+			mockStore.
+				EXPECT().
+				UpdateBackupConfig(&opsmngr.BackupConfig{ClusterID: "2", StatusName: stoppedStatus}).
+				Return(nil, nil).
+				Times(1),
+		)
+
+		if err := opts.Run(); err != nil {
+			t.Fatalf("Run() unexpected error: %v", err)
+		}
+		if got, want := progress.String(), "Backup policy applied to 2 clusters.\n"; got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+}
+
+func TestPlanner_planConfig(t *testing.T) {
+	p := &planner{clusterID: "1", clusterName: "prod-a"}
+	current := &opsmngr.BackupConfig{ClusterID: "1", StatusName: startedStatus, ExcludedNamespaces: []string{"test.cache"}}
+	update := p.planConfig(&ClusterBackupPolicy{Name: "prod-*", ExcludedNamespaces: []string{}}, current)
+	if update == nil || update.ExcludedNamespaces == nil || len(update.ExcludedNamespaces) != 0 {
+		t.Fatalf("planConfig() = %+v; want the excluded namespaces cleared", update)
+	}
+	if len(p.changes) != 1 || p.changes[0].From != "test.cache" || p.changes[0].To != "" {
+		t.Errorf("changes = %+v; want test.cache cleared", p.changes)
+	}
+}
+
+func TestBackupPolicy_Validate(t *testing.T) {
+	if err := (&BackupPolicy{}).Validate(); err == nil {
+		t.Error("Validate() expected an error for an empty policy")
+	}
+	if err := (&BackupPolicy{Clusters: []*ClusterBackupPolicy{{Name: "prod-["}}}).Validate(); err == nil {
+		t.Error("Validate() expected an error for an invalid pattern")
+	}
+	if err := (&BackupPolicy{Clusters: []*ClusterBackupPolicy{{Name: "prod-*"}}}).Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+}
+
+func TestApplyBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ApplyBuilder(),
+		0,
+		[]string{flag.File, flag.DryRun, flag.Force, flag.ProjectID, flag.Output},
+	)
+}
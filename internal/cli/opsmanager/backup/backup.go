@@ -16,6 +16,7 @@ package backup
 
 import (
 	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/backupreport"
 	"github.com/mongodb/mongocli/internal/cli/opsmanager/backup/config"
 	"github.com/mongodb/mongocli/internal/cli/opsmanager/backup/snapshots"
 	"github.com/spf13/cobra"
@@ -36,6 +37,8 @@ func Builder() *cobra.Command {
 		EnableBuilder(),
 		DisableBuilder(),
 		config.Builder(),
+		ApplyBuilder(),
+		backupreport.Builder(),
 	)
 
 	return cmd
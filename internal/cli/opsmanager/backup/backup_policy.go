@@ -0,0 +1,226 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	startedStatus  = "STARTED"
+	stoppedStatus  = "STOPPED"
+	inactiveStatus = "INACTIVE"
+)
+
+// BackupPolicy declares the backup settings of the clusters of a project
+type BackupPolicy struct {
+	Clusters []*ClusterBackupPolicy `yaml:"clusters" json:"clusters"`
+}
+
+// ClusterBackupPolicy applies to every cluster whose name matches Name,
+// Name can be a pattern such as prod-*, see path.Match for the syntax.
+// Unset fields are left as they are, an empty excludedNamespaces list clears the excluded namespaces.
+type ClusterBackupPolicy struct {
+	Name               string                  `yaml:"name" json:"name"`
+	Backup             *bool                   `yaml:"backup,omitempty" json:"backup,omitempty"`
+	StorageEngine      string                  `yaml:"storageEngine,omitempty" json:"storageEngine,omitempty"`
+	SyncSource         string                  `yaml:"syncSource,omitempty" json:"syncSource,omitempty"`
+	ExcludedNamespaces []string                `yaml:"excludedNamespaces,omitempty" json:"excludedNamespaces,omitempty"`
+	Schedule           *SnapshotSchedulePolicy `yaml:"schedule,omitempty" json:"schedule,omitempty"`
+}
+
+// SnapshotSchedulePolicy is the snapshot schedule of a cluster with backup
+type SnapshotSchedulePolicy struct {
+	SnapshotIntervalHours          int    `yaml:"snapshotIntervalHours,omitempty" json:"snapshotIntervalHours,omitempty"`
+	SnapshotRetentionDays          int    `yaml:"snapshotRetentionDays,omitempty" json:"snapshotRetentionDays,omitempty"`
+	DailySnapshotRetentionDays     int    `yaml:"dailySnapshotRetentionDays,omitempty" json:"dailySnapshotRetentionDays,omitempty"`
+	WeeklySnapshotRetentionWeeks   int    `yaml:"weeklySnapshotRetentionWeeks,omitempty" json:"weeklySnapshotRetentionWeeks,omitempty"`
+	MonthlySnapshotRetentionMonths int    `yaml:"monthlySnapshotRetentionMonths,omitempty" json:"monthlySnapshotRetentionMonths,omitempty"`
+	ClusterCheckpointIntervalMin   int    `yaml:"clusterCheckpointIntervalMin,omitempty" json:"clusterCheckpointIntervalMin,omitempty"`
+	PointInTimeWindowHours         *int   `yaml:"pointInTimeWindowHours,omitempty" json:"pointInTimeWindowHours,omitempty"`
+	ReferenceHourOfDay             *int   `yaml:"referenceHourOfDay,omitempty" json:"referenceHourOfDay,omitempty"`
+	ReferenceMinuteOfHour          *int   `yaml:"referenceMinuteOfHour,omitempty" json:"referenceMinuteOfHour,omitempty"`
+	ReferenceTimeZoneOffset        string `yaml:"referenceTimeZoneOffset,omitempty" json:"referenceTimeZoneOffset,omitempty"`
+}
+
+// Validate checks every cluster entry has a valid name pattern
+func (p *BackupPolicy) Validate() error {
+	if len(p.Clusters) == 0 {
+		return errors.New("the policy has no clusters")
+	}
+	for i, c := range p.Clusters {
+		if c.Name == "" {
+			return fmt.Errorf("clusters[%d]: name is required", i)
+		}
+		if _, err := path.Match(c.Name, ""); err != nil {
+			return fmt.Errorf("clusters[%d]: invalid name pattern %q", i, c.Name)
+		}
+	}
+	return nil
+}
+
+// Match returns the first entry matching the cluster name, if any
+func (p *BackupPolicy) Match(clusterName string) *ClusterBackupPolicy {
+	for _, c := range p.Clusters {
+		if ok, _ := path.Match(c.Name, clusterName); ok {
+			return c
+		}
+	}
+	return nil
+}
+
+// BackupChange is a field the policy changes for a cluster
+type BackupChange struct {
+	ClusterID   string `json:"clusterId"`
+	ClusterName string `json:"clusterName"`
+	Field       string `json:"field"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+}
+
+// clusterPlan holds the updates for a cluster, nil when there is nothing to update
+type clusterPlan struct {
+	clusterID string
+	config    *opsmngr.BackupConfig
+	schedule  *opsmngr.SnapshotSchedule
+}
+
+// planner records the changes for a cluster while building its updates
+type planner struct {
+	clusterID   string
+	clusterName string
+	changes     []*BackupChange
+}
+
+func (p *planner) add(field, from, to string) {
+	p.changes = append(p.changes, &BackupChange{
+		ClusterID:   p.clusterID,
+		ClusterName: p.clusterName,
+		Field:       field,
+		From:        from,
+		To:          to,
+	})
+}
+
+func (p *planner) string(field string, current *string, want string) {
+	if want != "" && *current != want {
+		p.add(field, *current, want)
+		*current = want
+	}
+}
+
+func (p *planner) int(field string, current *int, want int) {
+	if want != 0 && *current != want {
+		p.add(field, strconv.Itoa(*current), strconv.Itoa(want))
+		*current = want
+	}
+}
+
+func (p *planner) intPtr(field string, current **int, want *int) {
+	if want == nil || *current != nil && **current == *want {
+		return
+	}
+	from := ""
+	if *current != nil {
+		from = strconv.Itoa(**current)
+	}
+	p.add(field, from, strconv.Itoa(*want))
+	v := *want
+	*current = &v
+}
+
+// planConfig returns the backup config update for the cluster or nil when it already follows the policy
+func (p *planner) planConfig(policy *ClusterBackupPolicy, current *opsmngr.BackupConfig) *opsmngr.BackupConfig {
+	n := len(p.changes)
+	update := &opsmngr.BackupConfig{
+		GroupID:   current.GroupID,
+		ClusterID: current.ClusterID,
+	}
+	if policy.Backup != nil {
+		status := current.StatusName
+		if *policy.Backup && status != startedStatus {
+			p.add("status", status, startedStatus)
+			update.StatusName = startedStatus
+		} else if !*policy.Backup && status == startedStatus {
+			p.add("status", status, stoppedStatus)
+			update.StatusName = stoppedStatus
+		}
+	}
+
+	storageEngine := current.StorageEngineName
+	p.string("storageEngine", &storageEngine, policy.StorageEngine)
+	if storageEngine != current.StorageEngineName {
+		update.StorageEngineName = storageEngine
+	}
+	syncSource := current.SyncSource
+	p.string("syncSource", &syncSource, policy.SyncSource)
+	if syncSource != current.SyncSource {
+		update.SyncSource = syncSource
+	}
+	if policy.ExcludedNamespaces != nil && !sameNamespaces(current.ExcludedNamespaces, policy.ExcludedNamespaces) {
+		p.add("excludedNamespaces", strings.Join(current.ExcludedNamespaces, ","), strings.Join(policy.ExcludedNamespaces, ","))
+		update.ExcludedNamespaces = policy.ExcludedNamespaces
+	}
+
+	if len(p.changes) == n {
+		return nil
+	}
+	return update
+}
+
+// planSchedule returns the snapshot schedule update for the cluster or nil when it already follows the policy
+func (p *planner) planSchedule(policy *SnapshotSchedulePolicy, current *opsmngr.SnapshotSchedule) *opsmngr.SnapshotSchedule {
+	n := len(p.changes)
+	update := *current
+	update.Links = nil
+	p.int("snapshotIntervalHours", &update.SnapshotIntervalHours, policy.SnapshotIntervalHours)
+	p.int("snapshotRetentionDays", &update.SnapshotRetentionDays, policy.SnapshotRetentionDays)
+	p.int("dailySnapshotRetentionDays", &update.DailySnapshotRetentionDays, policy.DailySnapshotRetentionDays)
+	p.int("weeklySnapshotRetentionWeeks", &update.WeeklySnapshotRetentionWeeks, policy.WeeklySnapshotRetentionWeeks)
+	p.int("monthlySnapshotRetentionMonths", &update.MonthlySnapshotRetentionMonths, policy.MonthlySnapshotRetentionMonths)
+	p.int("clusterCheckpointIntervalMin", &update.ClusterCheckpointIntervalMin, policy.ClusterCheckpointIntervalMin)
+	p.intPtr("pointInTimeWindowHours", &update.PointInTimeWindowHours, policy.PointInTimeWindowHours)
+	p.intPtr("referenceHourOfDay", &update.ReferenceHourOfDay, policy.ReferenceHourOfDay)
+	p.intPtr("referenceMinuteOfHour", &update.ReferenceMinuteOfHour, policy.ReferenceMinuteOfHour)
+	p.string("referenceTimeZoneOffset", &update.ReferenceTimeZoneOffset, policy.ReferenceTimeZoneOffset)
+
+	if len(p.changes) == n {
+		return nil
+	}
+	return &update
+}
+
+func sameNamespaces(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
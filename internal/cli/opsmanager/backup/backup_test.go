@@ -25,7 +25,7 @@ func TestListBuilder(t *testing.T) {
 	test.CmdValidator(
 		t,
 		Builder(),
-		6,
+		8,
 		[]string{},
 	)
 }
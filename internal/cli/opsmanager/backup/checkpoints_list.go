@@ -15,6 +15,8 @@
 package backup
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -37,10 +39,12 @@ type CheckpointsListOpts struct {
 	store     store.CheckpointsLister
 }
 
-func (opts *CheckpointsListOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *CheckpointsListOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *CheckpointsListOpts) Run() error {
@@ -68,7 +72,7 @@ func AtlasBackupsCheckpointsListBuilder() *cobra.Command {
 			}
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.InitOutput(cmd.OutOrStdout(), checkpointsTemplate),
 			)
 		},
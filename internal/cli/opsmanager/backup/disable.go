@@ -15,6 +15,7 @@
 package backup
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/cli"
@@ -33,10 +34,12 @@ type DisableOpts struct {
 	store    store.AutomationPatcher
 }
 
-func (opts *DisableOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *DisableOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *DisableOpts) Run() error {
@@ -68,7 +71,7 @@ func DisableBuilder() *cobra.Command {
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 			)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
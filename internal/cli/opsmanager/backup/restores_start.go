@@ -15,6 +15,7 @@
 package backup
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/cli"
@@ -53,10 +54,12 @@ type RestoresStartOpts struct {
 	store                store.ContinuousJobCreator
 }
 
-func (opts *RestoresStartOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *RestoresStartOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *RestoresStartOpts) Run() error {
@@ -178,7 +181,7 @@ func RestoresStartBuilder() *cobra.Command {
 			}
 			return opts.PreRunE(
 				opts.ValidateProjectID,
-				opts.initStore,
+				opts.initStore(cmd.Context()),
 				opts.validateParams,
 				opts.InitOutput(cmd.OutOrStdout(), createTemplate),
 			)
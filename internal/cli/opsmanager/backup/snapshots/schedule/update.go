@@ -15,6 +15,8 @@
 package schedule
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/config"
 	"github.com/mongodb/mongocli/internal/flag"
@@ -43,10 +45,12 @@ type UpdateOpts struct {
 	referenceMinuteOfHour          int
 }
 
-func (opts *UpdateOpts) init() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *UpdateOpts) init(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *UpdateOpts) Run() error {
@@ -99,7 +103,7 @@ func UpdateBuilder() *cobra.Command {
 		Short: "Update a snapshot schedule for a cluster.",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			opts.OutWriter = cmd.OutOrStdout()
-			return opts.PreRunE(opts.ValidateProjectID, opts.init)
+			return opts.PreRunE(opts.ValidateProjectID, opts.init(cmd.Context()))
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return opts.Run()
@@ -38,6 +38,8 @@ func Builder() *cobra.Command {
 		ApplyBuilder(),
 		IndexesBuilder(),
 		UnmanageBuilder(),
+		UpgradeBuilder(),
+		ConnectBuilder(),
 	)
 
 	return cmd
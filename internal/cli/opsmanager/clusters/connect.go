@@ -0,0 +1,117 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/convert"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type ConnectOpts struct {
+	cli.GlobalOpts
+	cli.ShellOpts
+	name  string
+	store store.AutomationGetter
+}
+
+func (opts *ConnectOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+// connectionString returns the mongoURI of the cluster in the automation configuration,
+// the mongos for sharded clusters and the members for replica sets
+func (opts *ConnectOpts) connectionString() (string, error) {
+	c, err := opts.store.GetAutomationConfig(opts.ConfigProjectID())
+	if err != nil {
+		return "", err
+	}
+	for _, cluster := range convert.FromAutomationConfig(c) {
+		if cluster.Name != opts.name {
+			continue
+		}
+		if cluster.MongoURI == "" {
+			return "", fmt.Errorf("cluster %s has no processes to connect to", opts.name)
+		}
+		return cluster.MongoURI, nil
+	}
+	return "", fmt.Errorf("cluster %s not found", opts.name)
+}
+
+func (opts *ConnectOpts) Run() error {
+	uri, err := opts.connectionString()
+	if err != nil {
+		return err
+	}
+	if err := opts.LoadCredential(); err != nil {
+		return err
+	}
+	return opts.RunShell(uri)
+}
+
+// mongocli ops-manager cluster(s) connect <name> [--shell mongosh|mongo] [--username username]
+// [--credentialFile file | --credentialHelper executable] [--projectId projectId] [-- shellArgs...]
+func ConnectBuilder() *cobra.Command {
+	opts := &ConnectOpts{}
+	opts.Fs = afero.NewOsFs()
+	cmd := &cobra.Command{
+		Use:   "connect <name> [-- <shellArgs>...]",
+		Short: "Open a MongoDB shell connected to your cluster.",
+		Long: `The shell connects to the mongoURI of the automation configuration, the mongos of a sharded cluster or the members of a replica set.
+The password of the user is prompted for, read from the file given with --credentialFile or asked to the executable given with --credentialHelper.
+It's handed to the shell in a script readable only by you, never on the command line, and the script is removed when the shell exits.
+Arguments after -- are passed to the shell, like the TLS options of your deployment.`,
+		Example: `  $ mongocli om clusters connect myReplicaSet --username myUser --projectId 5e2211c17a3e5a48f5497de3
+  $ mongocli om clusters connect myReplicaSet --shell mongo -- --tls --tlsCAFile ca.pem`,
+		Args: require.MinimumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.PreRunE(
+				func() error { return opts.SetShellArgs(cmd, args, 1) },
+				opts.ValidateShell,
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Shell, flag.Shell, cli.DefaultShell, usage.Shell)
+	cmd.Flags().StringVarP(&opts.Username, flag.Username, flag.UsernameShort, "", usage.DBUsername)
+	cmd.Flags().StringVar(&opts.AuthDB, flag.AuthDB, convert.AdminDB, usage.AuthDB)
+	cmd.Flags().StringVar(&opts.CredentialFile, flag.CredentialFile, "", usage.CredentialFile)
+	cmd.Flags().StringVar(&opts.CredentialHelper, flag.CredentialHelper, "", usage.ConnectCredentialHelper)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+
+	_ = cmd.MarkFlagFilename(flag.CredentialFile)
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package clusters
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/mongodb/mongocli/internal/test/fixture"
+)
+
+func TestConnect_connectionString(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAutomationGetter(ctrl)
+	defer ctrl.Finish()
+
+	opts := &ConnectOpts{
+		name:  "myReplicaSet",
+		store: mockStore,
+	}
+	opts.ProjectID = "5a0a1e7e0f2912c554080adc"
+
+	mockStore.
+		EXPECT().
+		GetAutomationConfig(opts.ProjectID).
+		Return(fixture.AutomationConfig(), nil).
+		Times(1)
+
+	got, err := opts.connectionString()
+	if err != nil {
+		t.Fatalf("connectionString() unexpected error: %v", err)
+	}
+	if want := "mongodb://host0:27000,host1:27010,host0:27020"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestConnectBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ConnectBuilder(),
+		0,
+		[]string{flag.Shell, flag.Username, flag.AuthDB, flag.CredentialFile, flag.CredentialHelper, flag.ProjectID},
+	)
+}
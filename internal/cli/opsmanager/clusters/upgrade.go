@@ -0,0 +1,446 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/prompt"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/cobra"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	upgradeTemplate = "Cluster '{{.Name}}' upgraded to {{.Version}} with feature compatibility version {{.FeatureCompatibilityVersion}}.\n"
+	enterprise      = "-ent"
+	mongod          = "mongod"
+)
+
+// releaseSeries lists the release series in upgrade order, a cluster can only upgrade to the next one.
+var releaseSeries = []string{"3.2", "3.4", "3.6", "4.0", "4.2", "4.4", "5.0"}
+
+type UpgradeOpts struct {
+	cli.GlobalOpts
+	cli.WatchOpts
+	name          string
+	to            string
+	manifest      string
+	confirm       bool
+	progress      io.Writer
+	store         store.CloudManagerClustersUpgrader
+	manifestStore store.VersionManifestGetter
+}
+
+// UpgradeResult is the state of the cluster once upgraded
+type UpgradeResult struct {
+	Name                        string `json:"name"`
+	Version                     string `json:"version"`
+	FeatureCompatibilityVersion string `json:"featureCompatibilityVersion"`
+}
+
+// upgradeStep is a group of processes upgraded together, sharded clusters upgrade
+// the config servers first, then the shards and the mongos last.
+type upgradeStep struct {
+	name      string
+	processes []*opsmngr.Process
+}
+
+func (opts *UpgradeOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		if err != nil || config.Service() != config.OpsManagerService {
+			return err
+		}
+		opts.manifestStore, err = store.NewVersionManifest(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *UpgradeOpts) watcher() (bool, error) {
+	result, err := opts.store.GetAutomationStatus(opts.ConfigProjectID())
+	if err != nil {
+		return false, err
+	}
+
+	for _, p := range result.Processes {
+		if p.LastGoalVersionAchieved != result.GoalVersion {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Run upgrades the binaries step by step, then sets the feature compatibility version.
+// Processes already at the target are skipped so an interrupted upgrade resumes where it stopped.
+func (opts *UpgradeOpts) Run() error {
+	current, err := opts.store.GetAutomationConfig(opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+	steps, err := upgradeSteps(current, opts.name)
+	if err != nil {
+		return err
+	}
+	versions, err := opts.availableVersions(current)
+	if err != nil {
+		return err
+	}
+	target, err := targetVersion(steps, opts.to, versions)
+	if err != nil {
+		return err
+	}
+	fcv := series(target)
+
+	deployed := false
+	for i := range steps {
+		if !steps[i].setVersion(target) {
+			continue
+		}
+		if _, err := fmt.Fprintf(opts.progress, "Upgrading the %s of '%s' to %s\n", steps[i].name, opts.name, target); err != nil {
+			return err
+		}
+		if err := opts.deploy(current); err != nil {
+			return err
+		}
+		deployed = true
+		if current, err = opts.store.GetAutomationConfig(opts.ConfigProjectID()); err != nil {
+			return err
+		}
+		if steps, err = upgradeSteps(current, opts.name); err != nil {
+			return err
+		}
+	}
+
+	// the binaries of a previous run may still be deploying
+	if !deployed {
+		if err := opts.Watch(opts.watcher); err != nil {
+			return err
+		}
+	}
+
+	if !setFeatureCompatibilityVersion(steps, fcv, true) {
+		return opts.Print(&UpgradeResult{Name: opts.name, Version: target, FeatureCompatibilityVersion: fcv})
+	}
+
+	if !opts.confirm {
+		p := prompt.NewConfirm(fmt.Sprintf("Set the feature compatibility version of '%s' to %s? Downgrading the binaries won't be possible afterwards.", opts.name, fcv))
+		if err := survey.AskOne(p, &opts.confirm); err != nil {
+			return err
+		}
+		if !opts.confirm {
+			_, err := fmt.Fprintf(opts.progress, "Binaries of '%s' upgraded to %s, run the command again to set the feature compatibility version\n", opts.name, target)
+			return err
+		}
+	}
+
+	setFeatureCompatibilityVersion(steps, fcv, false)
+	if _, err := fmt.Fprintf(opts.progress, "Setting the feature compatibility version of '%s' to %s\n", opts.name, fcv); err != nil {
+		return err
+	}
+	if err := opts.deploy(current); err != nil {
+		return err
+	}
+
+	return opts.Print(&UpgradeResult{Name: opts.name, Version: target, FeatureCompatibilityVersion: fcv})
+}
+
+// deploy updates the automation config and waits for the goal state
+func (opts *UpgradeOpts) deploy(c *opsmngr.AutomationConfig) error {
+	if err := opts.store.UpdateAutomationConfig(opts.ConfigProjectID(), c); err != nil {
+		return err
+	}
+	return opts.Watch(opts.watcher)
+}
+
+// availableVersions lists the versions of the version manifest, the one given with --manifest or the one of the
+// running Ops Manager. The versions available to the project are used instead with Cloud Manager or when
+// the manifest of the running Ops Manager can't be fetched, for example without internet access.
+func (opts *UpgradeOpts) availableVersions(c *opsmngr.AutomationConfig) ([]string, error) {
+	if opts.manifestStore != nil {
+		versions, err := opts.manifestVersions()
+		if err == nil || opts.manifest != "" {
+			return versions, err
+		}
+		if _, err := fmt.Fprintf(opts.progress, "Using the versions available to the project, the version manifest isn't available: %v\n", err); err != nil {
+			return nil, err
+		}
+	}
+
+	var versions []string
+	for _, v := range c.MongoDBVersions {
+		if v == nil {
+			continue
+		}
+		if name, ok := (*v)["name"].(string); ok {
+			versions = append(versions, name)
+		}
+	}
+	return versions, nil
+}
+
+// manifestVersions lists the versions of the version manifest of --manifest or else of the running Ops Manager
+func (opts *UpgradeOpts) manifestVersions() ([]string, error) {
+	manifest := opts.manifest
+	if manifest == "" {
+		v, err := opts.store.ServiceVersion()
+		if err != nil {
+			return nil, err
+		}
+		manifest = series(v)
+	}
+	if !strings.HasSuffix(manifest, ".json") {
+		manifest += ".json"
+	}
+	m, err := opts.manifestStore.GetVersionManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]string, 0, len(m.Versions))
+	for _, v := range m.Versions {
+		versions = append(versions, v.Name)
+	}
+	return versions, nil
+}
+
+// upgradeSteps returns the processes of the cluster in upgrade order
+func upgradeSteps(c *opsmngr.AutomationConfig, name string) ([]*upgradeStep, error) {
+	for _, s := range c.Sharding {
+		if s.Name != name {
+			continue
+		}
+		shards := &upgradeStep{name: "shards"}
+		for _, shard := range s.Shards {
+			shards.processes = append(shards.processes, replicaSetProcesses(c, shard.RS)...)
+		}
+		mongos := &upgradeStep{name: "mongos"}
+		for _, p := range c.Processes {
+			if p.Cluster == name {
+				mongos.processes = append(mongos.processes, p)
+			}
+		}
+		return []*upgradeStep{
+			{name: "config servers", processes: replicaSetProcesses(c, s.ConfigServerReplica)},
+			shards,
+			mongos,
+		}, nil
+	}
+	for _, rs := range c.ReplicaSets {
+		if rs.ID == name {
+			return []*upgradeStep{{name: "members", processes: replicaSetProcesses(c, name)}}, nil
+		}
+	}
+	return nil, fmt.Errorf("cluster '%s' doesn't exist", name)
+}
+
+func replicaSetProcesses(c *opsmngr.AutomationConfig, id string) []*opsmngr.Process {
+	var processes []*opsmngr.Process
+	for _, rs := range c.ReplicaSets {
+		if rs.ID != id {
+			continue
+		}
+		for _, m := range rs.Members {
+			for _, p := range c.Processes {
+				if p.Name == m.Host {
+					processes = append(processes, p)
+				}
+			}
+		}
+	}
+	return processes
+}
+
+// setVersion sets the version of the processes not at the target yet, it returns whether any changed
+func (s *upgradeStep) setVersion(version string) bool {
+	changed := false
+	for _, p := range s.processes {
+		if p.Version != version {
+			p.Version = version
+			changed = true
+		}
+	}
+	return changed
+}
+
+// setFeatureCompatibilityVersion sets the feature compatibility version of every mongod, with dryRun it only reports whether any would change
+func setFeatureCompatibilityVersion(steps []*upgradeStep, fcv string, dryRun bool) bool {
+	changed := false
+	for _, s := range steps {
+		for _, p := range s.processes {
+			if p.ProcessType != mongod {
+				continue
+			}
+			if p.FeatureCompatibilityVersion != fcv {
+				changed = true
+				if !dryRun {
+					p.FeatureCompatibilityVersion = fcv
+				}
+			}
+		}
+	}
+	return changed
+}
+
+// targetVersion resolves the target against the available versions, a release series resolves to its latest version
+// in the edition of the cluster, only upgrades within the release series or to the next one are allowed
+func targetVersion(steps []*upgradeStep, to string, versions []string) (string, error) {
+	var processes []*opsmngr.Process
+	for _, s := range steps {
+		processes = append(processes, s.processes...)
+	}
+	if len(processes) == 0 {
+		return "", fmt.Errorf("no processes to upgrade")
+	}
+
+	edition := ""
+	if strings.HasSuffix(processes[0].Version, enterprise) {
+		edition = enterprise
+	}
+	target := ""
+	if strings.Count(to, ".") == 1 {
+		for _, v := range versions {
+			if series(v) == to && edition == versionEdition(v) && (target == "" || compareVersions(v, target) > 0) {
+				target = v
+			}
+		}
+	} else {
+		if !strings.HasSuffix(to, enterprise) {
+			to += edition
+		}
+		for _, v := range versions {
+			if v == to {
+				target = v
+			}
+		}
+	}
+	if target == "" {
+		return "", fmt.Errorf("version %s is not available", to)
+	}
+
+	fcv := ""
+	for _, p := range processes {
+		if compareVersions(p.Version, target) > 0 {
+			return "", fmt.Errorf("process %s runs %s, downgrading to %s is not supported", p.Name, p.Version, target)
+		}
+		if p.ProcessType == mongod && p.FeatureCompatibilityVersion != "" && (fcv == "" || compareVersions(p.FeatureCompatibilityVersion, fcv) < 0) {
+			fcv = p.FeatureCompatibilityVersion
+		}
+	}
+	if fcv == "" {
+		fcv = series(processes[0].Version)
+	}
+
+	if from, to := seriesIndex(fcv), seriesIndex(series(target)); from < 0 || to < 0 || to > from+1 {
+		return "", fmt.Errorf("can't upgrade from feature compatibility version %s to %s, upgrade one release series at a time", fcv, target)
+	}
+	return target, nil
+}
+
+func series(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
+
+func seriesIndex(s string) int {
+	for i, r := range releaseSeries {
+		if r == s {
+			return i
+		}
+	}
+	return -1
+}
+
+func versionEdition(version string) string {
+	if strings.HasSuffix(version, enterprise) {
+		return enterprise
+	}
+	return ""
+}
+
+// compareVersions compares the numeric parts of two versions, ignoring the edition
+func compareVersions(a, b string) int {
+	pa := strings.Split(strings.SplitN(a, "-", 2)[0], ".")
+	pb := strings.Split(strings.SplitN(b, "-", 2)[0], ".")
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x, _ = strconv.Atoi(pa[i])
+		}
+		if i < len(pb) {
+			y, _ = strconv.Atoi(pb[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// mongocli ops-manager cluster(s) upgrade <name> --to version [--manifest opsManagerVersion] [--force] [--interval interval] [--projectId projectId]
+func UpgradeBuilder() *cobra.Command {
+	opts := &UpgradeOpts{}
+	cmd := &cobra.Command{
+		Use:   "upgrade <name>",
+		Short: "Upgrade the MongoDB version of a cluster.",
+		Long: `Upgrade the binaries of the cluster, waiting for each change to be deployed, then set the feature compatibility version after confirmation.
+Sharded clusters upgrade their config servers first, then their shards and their mongos last.
+Only upgrades within the release series of the feature compatibility version or to the next one are allowed.
+An interrupted upgrade resumes where it stopped when the command runs again.`,
+		Example: `  $ mongocli om cluster upgrade myCluster --to 4.4 --projectId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitWatch(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), upgradeTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.to, flag.To, "", usage.UpgradeTo)
+	cmd.Flags().StringVar(&opts.manifest, flag.Manifest, "", usage.UpgradeManifest)
+	cmd.Flags().BoolVar(&opts.confirm, flag.Force, false, usage.Force)
+	cmd.Flags().DurationVar(&opts.Interval, flag.Interval, 0, usage.WatchInterval)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.To)
+
+	return cmd
+}
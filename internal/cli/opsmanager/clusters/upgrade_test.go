@@ -0,0 +1,284 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package clusters
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/mongodb/mongocli/internal/test/fixture"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+func withVersions(c *opsmngr.AutomationConfig, versions ...string) *opsmngr.AutomationConfig {
+	for _, v := range versions {
+		c.MongoDBVersions = append(c.MongoDBVersions, &map[string]interface{}{"name": v})
+	}
+	return c
+}
+
+func TestUpgrade_RunShardedCluster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockCloudManagerClustersUpgrader(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &UpgradeOpts{
+		WatchOpts: cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: upgradeTemplate, OutWriter: buf}},
+		name:      "myCluster",
+		to:        "4.4",
+		confirm:   true,
+		progress:  new(bytes.Buffer),
+		store:     mockStore,
+	}
+	current := withVersions(fixture.AutomationConfigWithOneShardedCluster("myCluster", false), "4.2.2", "4.4.1", "4.4.4", "4.4.4-ent")
+
+	var deployed []string
+	mockStore.
+		EXPECT().
+		GetAutomationConfig(opts.ProjectID).
+		Return(current, nil).
+		Times(4)
+	mockStore.
+		EXPECT().
+		UpdateAutomationConfig(opts.ProjectID, current).
+		DoAndReturn(func(_ string, c *opsmngr.AutomationConfig) error {
+			var state []string
+			for _, p := range c.Processes {
+				state = append(state, p.Version+"/"+p.FeatureCompatibilityVersion)
+			}
+			deployed = append(deployed, strings.Join(state, ","))
+			return nil
+		}).
+		Times(4)
+	mockStore.
+		EXPECT().
+		GetAutomationStatus(opts.ProjectID).
+		Return(fixture.AutomationStatus(), nil).
+		Times(4)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+
+	// processes are shard, config server and mongos
+	want := []string{
+		"4.2.2/4.2,4.4.4/4.2,4.2.2/4.2",
+		"4.4.4/4.2,4.4.4/4.2,4.2.2/4.2",
+		"4.4.4/4.2,4.4.4/4.2,4.4.4/4.2",
+		"4.4.4/4.4,4.4.4/4.4,4.4.4/4.2",
+	}
+	if strings.Join(deployed, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got deployments\n%s\nwant\n%s", strings.Join(deployed, "\n"), strings.Join(want, "\n"))
+	}
+	if got, want := buf.String(), "Cluster 'myCluster' upgraded to 4.4.4 with feature compatibility version 4.4.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestUpgrade_RunResume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockCloudManagerClustersUpgrader(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &UpgradeOpts{
+		WatchOpts: cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: upgradeTemplate, OutWriter: buf}},
+		name:      "myReplicaSet",
+		to:        "4.4",
+		confirm:   true,
+		progress:  new(bytes.Buffer),
+		store:     mockStore,
+	}
+	current := withVersions(fixture.AutomationConfigWithOneReplicaSet("myReplicaSet", false), "4.4.4")
+	current.Processes[0].Version = "4.4.4"
+
+	mockStore.
+		EXPECT().
+		GetAutomationConfig(opts.ProjectID).
+		Return(current, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		GetAutomationStatus(opts.ProjectID).
+		Return(fixture.AutomationStatus(), nil).
+		Times(2)
+	mockStore.
+		EXPECT().
+		UpdateAutomationConfig(opts.ProjectID, current).
+		Return(nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if fcv := current.Processes[0].FeatureCompatibilityVersion; fcv != "4.4" {
+		t.Errorf("got feature compatibility version %s, want 4.4", fcv)
+	}
+}
+
+func TestUpgrade_availableVersions(t *testing.T) {
+	current := withVersions(&opsmngr.AutomationConfig{}, "4.4.1")
+	manifest := &opsmngr.VersionManifest{Versions: []*opsmngr.Version{{Name: "4.4.1"}, {Name: "4.4.4"}}}
+
+	t.Run("manifest of the running Ops Manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockCloudManagerClustersUpgrader(ctrl)
+		mockManifestStore := mocks.NewMockVersionManifestGetter(ctrl)
+		defer ctrl.Finish()
+
+		opts := &UpgradeOpts{
+			WatchOpts:     cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: upgradeTemplate, OutWriter: new(bytes.Buffer)}},
+			name:          "myReplicaSet",
+			to:            "4.4",
+			confirm:       true,
+			progress:      new(bytes.Buffer),
+			manifestStore: mockManifestStore,
+			store:         mockStore,
+		}
+		mockStore.
+			EXPECT().
+			ServiceVersion().
+			Return("4.4.12.100.20210406T1640Z", nil).
+			Times(1)
+		mockManifestStore.
+			EXPECT().
+			GetVersionManifest("4.4.json").
+			Return(manifest, nil).
+			Times(1)
+
+		got, err := opts.availableVersions(current)
+		if err != nil {
+			t.Fatalf("availableVersions() unexpected error: %v", err)
+		}
+		if len(got) != 2 || got[1] != "4.4.4" {
+			t.Errorf("availableVersions() = %v; want the versions of the manifest", got)
+		}
+	})
+
+	t.Run("project versions when the manifest isn't available", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockCloudManagerClustersUpgrader(ctrl)
+		mockManifestStore := mocks.NewMockVersionManifestGetter(ctrl)
+		defer ctrl.Finish()
+
+		progress := new(bytes.Buffer)
+		opts := &UpgradeOpts{
+			WatchOpts:     cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: upgradeTemplate, OutWriter: new(bytes.Buffer)}},
+			name:          "myReplicaSet",
+			to:            "4.4",
+			confirm:       true,
+			progress:      progress,
+			manifestStore: mockManifestStore,
+			store:         mockStore,
+		}
+		mockStore.
+			EXPECT().
+			ServiceVersion().
+			Return("4.4.12.100.20210406T1640Z", nil).
+			Times(1)
+		mockManifestStore.
+			EXPECT().
+			GetVersionManifest("4.4.json").
+			Return(nil, errors.New("no internet access")).
+			Times(1)
+
+		got, err := opts.availableVersions(current)
+		if err != nil {
+			t.Fatalf("availableVersions() unexpected error: %v", err)
+		}
+		if len(got) != 1 || got[0] != "4.4.1" {
+			t.Errorf("availableVersions() = %v; want the versions of the project", got)
+		}
+		if !strings.Contains(progress.String(), "no internet access") {
+			t.Errorf("progress = %q; want the reason the manifest isn't used", progress.String())
+		}
+	})
+
+	t.Run("given manifest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := mocks.NewMockCloudManagerClustersUpgrader(ctrl)
+		mockManifestStore := mocks.NewMockVersionManifestGetter(ctrl)
+		defer ctrl.Finish()
+
+		opts := &UpgradeOpts{
+			WatchOpts:     cli.WatchOpts{OutputOpts: cli.OutputOpts{Template: upgradeTemplate, OutWriter: new(bytes.Buffer)}},
+			name:          "myReplicaSet",
+			to:            "4.4",
+			confirm:       true,
+			progress:      new(bytes.Buffer),
+			manifestStore: mockManifestStore,
+			manifest:      "5.0",
+			store:         mockStore,
+		}
+		mockManifestStore.
+			EXPECT().
+			GetVersionManifest("5.0.json").
+			Return(nil, errors.New("not found")).
+			Times(1)
+
+		if _, err := opts.availableVersions(current); err == nil {
+			t.Fatal("availableVersions() expected an error for a missing manifest")
+		}
+	})
+}
+
+func TestTargetVersion(t *testing.T) {
+	versions := []string{"4.2.2", "4.2.12", "4.4.1", "4.4.4", "4.4.4-ent", "5.0.0"}
+	tests := []struct {
+		version string
+		fcv     string
+		to      string
+		want    string
+		wantErr bool
+	}{
+		{version: "4.2.2", fcv: "4.2", to: "4.4", want: "4.4.4"},
+		{version: "4.2.2-ent", fcv: "4.2", to: "4.4", want: "4.4.4-ent"},
+		{version: "4.2.2", fcv: "4.2", to: "4.2.12", want: "4.2.12"},
+		{version: "4.4.1", fcv: "4.2", to: "4.4.4", want: "4.4.4"},
+		{version: "4.2.2", fcv: "4.2", to: "5.0", wantErr: true},
+		{version: "4.4.4", fcv: "4.4", to: "4.4.1", wantErr: true},
+		{version: "4.2.2", fcv: "4.2", to: "4.4.2", wantErr: true},
+	}
+	for _, tt := range tests {
+		steps := []*upgradeStep{{processes: []*opsmngr.Process{{ProcessType: mongod, Version: tt.version, FeatureCompatibilityVersion: tt.fcv}}}}
+		got, err := targetVersion(steps, tt.to, versions)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("targetVersion(%s -> %s) error = %v, wantErr %v", tt.version, tt.to, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("targetVersion(%s -> %s) = %s, want %s", tt.version, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestUpgradeBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		UpgradeBuilder(),
+		0,
+		[]string{flag.To, flag.Manifest, flag.Force, flag.Interval, flag.ProjectID, flag.Output},
+	)
+}
@@ -0,0 +1,226 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbusers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/convert"
+	"github.com/mongodb/mongocli/internal/file"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"go.mongodb.org/ops-manager/atmcfg"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	createAction = "create"
+	updateAction = "update"
+	deleteAction = "delete"
+
+	agentUserPrefix = "mms-"
+)
+
+var applyTemplate = `ACTION	USERNAME	DATABASE	ROLES{{range .}}
+{{.Action}}	{{.Username}}	{{.Database}}	{{.Roles}}{{end}}
+`
+
+// UserChange is a database user the file creates, updates or deletes
+type UserChange struct {
+	Action   string `json:"action"`
+	Username string `json:"username"`
+	Database string `json:"database"`
+	Roles    string `json:"roles,omitempty"`
+}
+
+type ApplyOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	cli.PlanOpts
+	filename string
+	prune    bool
+	fs       afero.Fs
+	store    store.AutomationPatcher
+}
+
+func (opts *ApplyOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ApplyOpts) Run() error {
+	users := new(convert.DatabaseUsersConfig)
+	if err := file.Load(opts.fs, opts.filename, users); err != nil {
+		return err
+	}
+	if err := users.Validate(); err != nil {
+		return err
+	}
+	if err := users.ExpandPasswords(); err != nil {
+		return err
+	}
+
+	current, err := opts.store.GetAutomationConfig(opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+	changes, err := opts.plan(users, current)
+	if err != nil {
+		return err
+	}
+	if err := opts.Print(changes); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Apply %d changes to the database users?", len(changes))
+	if ok, err := opts.Proceed(len(changes), message, "Database users not applied"); err != nil || !ok {
+		return err
+	}
+
+	if err := opts.store.UpdateAutomationConfig(opts.ConfigProjectID(), current); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(opts.Progress, "Database users applied, %d users changed.\n", len(changes)); err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(opts.Progress, cli.DeploymentStatus(config.OpsManagerURL(), opts.ConfigProjectID()))
+	return err
+}
+
+func mechanisms(u *opsmngr.MongoDBUser) []string {
+	if u.Mechanisms == nil {
+		return []string{}
+	}
+	m := append([]string{}, *u.Mechanisms...)
+	sort.Strings(m)
+	return m
+}
+
+// plan changes the users of the automation configuration and returns the changes, the credentials of existing users are left as they are.
+// With prune, users not in the file are removed, the agent users are always kept.
+func (opts *ApplyOpts) plan(desired *convert.DatabaseUsersConfig, current *opsmngr.AutomationConfig) ([]*UserChange, error) {
+	changes := []*UserChange{}
+	existing := map[string]*opsmngr.MongoDBUser{}
+	for _, u := range current.Auth.Users {
+		existing[u.Database+"/"+u.Username] = u
+	}
+
+	wanted := map[string]bool{}
+	for _, u := range desired.Users {
+		key := u.AuthDB() + "/" + u.Username
+		wanted[key] = true
+		m := u.Mechanisms
+		if len(m) == 0 {
+			m = []string{scramSHA1}
+		}
+		user := &opsmngr.MongoDBUser{
+			Database:                   u.AuthDB(),
+			Username:                   u.Username,
+			Roles:                      convert.BuildOMRoles(u.Roles),
+			AuthenticationRestrictions: []string{},
+			Mechanisms:                 &m,
+		}
+		change := &UserChange{Username: u.Username, Database: u.AuthDB(), Roles: strings.Join(convert.OMRoleNames(user.Roles), ",")}
+
+		c, ok := existing[key]
+		switch {
+		case !ok:
+			if u.Password == "" {
+				return nil, fmt.Errorf("user '%s' needs a password to be created", u.Username)
+			}
+			if err := atmcfg.ConfigureScramCredentials(user, u.Password); err != nil {
+				return nil, err
+			}
+			change.Action = createAction
+			atmcfg.AddUser(current, user)
+		case !reflect.DeepEqual(convert.OMRoleNames(c.Roles), convert.OMRoleNames(user.Roles)) ||
+			!reflect.DeepEqual(mechanisms(c), mechanisms(user)):
+			change.Action = updateAction
+			c.Roles = user.Roles
+			c.Mechanisms = user.Mechanisms
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	if !opts.prune {
+		return changes, nil
+	}
+	for _, c := range append([]*opsmngr.MongoDBUser{}, current.Auth.Users...) {
+		if wanted[c.Database+"/"+c.Username] || strings.HasPrefix(c.Username, agentUserPrefix) || c.Username == current.Auth.AutoUser {
+			continue
+		}
+		changes = append(changes, &UserChange{Action: deleteAction, Username: c.Username, Database: c.Database, Roles: strings.Join(convert.OMRoleNames(c.Roles), ",")})
+		if err := atmcfg.RemoveUser(current, c.Username, c.Database); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// mongocli ops-manager dbuser(s) apply --file users.yaml [--prune] [--dryRun] [--force] [--projectId projectId]
+func ApplyBuilder() *cobra.Command {
+	opts := &ApplyOpts{
+		fs: afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create, update and delete the database users of your project from a file.",
+		Long: `The file lists users with their username, authentication database, password, roles as roleName@dbName
+and mechanisms, SCRAM-SHA-1 by default. A password set to ${VARIABLE} is read from that environment variable.
+Missing users are created and users with other roles or mechanisms are updated, passwords of existing users are left as they are.
+Users not in the file are only deleted with --prune, the agent users are kept. All changes are deployed with a single automation update.`,
+		Example: `  $ export APP_PASSWORD=secret
+  $ mongocli om dbusers apply --file users.yaml --dryRun --projectId 5e2211c17a3e5a48f5497de3`,
+		Args: require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.Progress = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), applyTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.DBUsersFile)
+	cmd.Flags().BoolVar(&opts.prune, flag.Prune, false, usage.DBUsersPrune)
+	cmd.Flags().BoolVar(&opts.DryRun, flag.DryRun, false, usage.ApplyDryRun)
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagRequired(flag.File)
+	_ = cmd.MarkFlagFilename(flag.File)
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package dbusers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/mongodb/mongocli/internal/test/fixture"
+	"github.com/spf13/afero"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const usersFile = `users:
+  - username: app
+    password: secret
+    roles: [readWrite@app]
+  - username: test
+    database: test
+    roles: [readWrite@test]
+`
+
+func TestApply_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAutomationPatcher(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &ApplyOpts{
+		OutputOpts: cli.OutputOpts{Template: applyTemplate, OutWriter: buf},
+		filename:   "users.yaml",
+		prune:      true,
+		fs:         afero.NewMemMapFs(),
+		PlanOpts:   cli.PlanOpts{Confirm: true, Progress: new(bytes.Buffer)},
+		store:      mockStore,
+	}
+	opts.ProjectID = projectID
+	_ = afero.WriteFile(opts.fs, opts.filename, []byte(usersFile), 0600)
+
+	expected := fixture.AutomationConfigWithMongoDBUsers()
+	expected.Auth.Users = append(expected.Auth.Users,
+		&opsmngr.MongoDBUser{Username: "mms-automation", Database: "admin"},
+		&opsmngr.MongoDBUser{Username: "old", Database: "admin", Roles: []*opsmngr.Role{{Role: "read", Database: "test"}}},
+	)
+	mockStore.
+		EXPECT().
+		GetAutomationConfig(projectID).
+		Return(expected, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		UpdateAutomationConfig(projectID, expected).
+		Return(nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	want := `ACTION   USERNAME   DATABASE   ROLES
+create   app        admin      readWrite@app
+update   test       test       readWrite@test
+delete   old        admin      read@test
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if len(expected.Auth.Users) != 3 {
+		t.Fatalf("got %d users, want 3", len(expected.Auth.Users))
+	}
+	for _, u := range expected.Auth.Users {
+		if u.Username == "app" && u.ScramSha256Creds == nil {
+			t.Error("expected credentials for the new user")
+		}
+	}
+}
+
+func TestApplyBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ApplyBuilder(),
+		0,
+		[]string{flag.File, flag.Prune, flag.DryRun, flag.Force, flag.ProjectID, flag.Output},
+	)
+}
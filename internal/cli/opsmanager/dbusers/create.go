@@ -15,7 +15,9 @@
 package dbusers
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/mongodb/mongocli/internal/cli"
@@ -25,6 +27,7 @@ import (
 	"github.com/mongodb/mongocli/internal/flag"
 	"github.com/mongodb/mongocli/internal/store"
 	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"go.mongodb.org/ops-manager/atmcfg"
 	"go.mongodb.org/ops-manager/opsmngr"
@@ -34,18 +37,22 @@ const scramSHA1 = "SCRAM-SHA-1"
 
 type CreateOpts struct {
 	cli.GlobalOpts
-	username   string
-	password   string
-	authDB     string
-	roles      []string
-	mechanisms []string
-	store      store.AutomationPatcher
+	username    string
+	password    string
+	authDB      string
+	roles       []string
+	mechanisms  []string
+	deleteAfter string
+	fs          afero.Fs
+	store       store.AutomationPatcher
 }
 
-func (opts *CreateOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *CreateOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *CreateOpts) Run() error {
@@ -65,6 +72,13 @@ func (opts *CreateOpts) Run() error {
 		return err
 	}
 
+	if opts.deleteAfter != "" {
+		expiry := &Expiry{ProjectID: opts.ConfigProjectID(), Username: opts.username, Database: opts.authDB, DeleteAfter: opts.deleteAfter}
+		if err := trackExpiry(opts.fs, expiryFilename(), expiry); err != nil {
+			return err
+		}
+	}
+
 	fmt.Print(cli.DeploymentStatus(config.OpsManagerURL(), opts.ConfigProjectID()))
 
 	return nil
@@ -80,6 +94,16 @@ func (opts *CreateOpts) newDBUser() *opsmngr.MongoDBUser {
 	}
 }
 
+func (opts *CreateOpts) validateDeleteAfter() error {
+	if opts.deleteAfter == "" {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, opts.deleteAfter); err != nil {
+		return fmt.Errorf("invalid --%s, use an ISO 8601 date and time like 2021-06-01T00:00:00Z", flag.DeleteAfter)
+	}
+	return nil
+}
+
 func (opts *CreateOpts) Prompt() error {
 	if opts.password != "" {
 		return nil
@@ -92,7 +116,9 @@ func (opts *CreateOpts) Prompt() error {
 
 // mongocli atlas dbuser(s) create --username username --password password --role roleName@dbName [--projectId projectId]
 func CreateBuilder() *cobra.Command {
-	opts := &CreateOpts{}
+	opts := &CreateOpts{
+		fs: afero.NewOsFs(),
+	}
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a database user for your project.",
@@ -101,7 +127,7 @@ func CreateBuilder() *cobra.Command {
   $ mongocli om dbuser create --username <username>  --role readWriteAnyDatabase,clusterMonitor --mechanisms SCRAM-SHA-256 --projectId <projectId>`,
 		Args: require.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return opts.PreRunE(opts.ValidateProjectID, opts.initStore)
+			return opts.PreRunE(opts.validateDeleteAfter, opts.ValidateProjectID, opts.initStore(cmd.Context()))
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.Prompt(); err != nil {
@@ -116,6 +142,7 @@ func CreateBuilder() *cobra.Command {
 	cmd.Flags().StringVar(&opts.authDB, flag.AuthDB, convert.AdminDB, usage.AuthDB)
 	cmd.Flags().StringSliceVar(&opts.roles, flag.Role, []string{}, usage.Roles)
 	cmd.Flags().StringSliceVar(&opts.mechanisms, flag.Mechanisms, []string{scramSHA1}, usage.Mechanisms)
+	cmd.Flags().StringVar(&opts.deleteAfter, flag.DeleteAfter, "", usage.OMDBUsersDeleteAfter)
 
 	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
 
@@ -32,6 +32,9 @@ A user’s roles apply to all the clusters in the project.`,
 		CreateBuilder(),
 		ListBuilder(),
 		DeleteBuilder(),
+		RotatePasswordBuilder(),
+		ExpireBuilder(),
+		ApplyBuilder(),
 	)
 
 	return cmd
@@ -0,0 +1,142 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbusers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"go.mongodb.org/ops-manager/atmcfg"
+)
+
+var expireTemplate = `USERNAME	DATABASE	DELETE AFTER{{range .}}
+{{.Username}}	{{.Database}}	{{.DeleteAfter}}{{end}}
+`
+
+type ExpireOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	cli.PlanOpts
+	now        func() time.Time
+	fs         afero.Fs
+	expiryFile string
+	store      store.AutomationPatcher
+}
+
+func (opts *ExpireOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+// Run deletes the expired users of the project, users already deleted are only untracked
+func (opts *ExpireOpts) Run() error {
+	expiries, err := loadExpiries(opts.fs, opts.expiryFile)
+	if err != nil {
+		return err
+	}
+	now := opts.now()
+	expired := []*Expiry{}
+	var remaining []*Expiry
+	for _, e := range expiries {
+		if e.ProjectID == opts.ConfigProjectID() && e.expired(now) {
+			expired = append(expired, e)
+		} else {
+			remaining = append(remaining, e)
+		}
+	}
+
+	if err := opts.Print(expired); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Delete %d expired database users?", len(expired))
+	if ok, err := opts.Proceed(len(expired), message, "Expired database users not deleted"); err != nil || !ok {
+		return err
+	}
+
+	current, err := opts.store.GetAutomationConfig(opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+	deleted := 0
+	for _, e := range expired {
+		if atmcfg.RemoveUser(current, e.Username, e.Database) == nil {
+			deleted++
+		}
+	}
+	if deleted > 0 {
+		if err := opts.store.UpdateAutomationConfig(opts.ConfigProjectID(), current); err != nil {
+			return err
+		}
+	}
+	if err := saveExpiries(opts.fs, opts.expiryFile, remaining); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(opts.Progress, "%d expired database users deleted.\n", deleted); err != nil {
+		return err
+	}
+	if deleted > 0 {
+		_, err = fmt.Fprint(opts.Progress, cli.DeploymentStatus(config.OpsManagerURL(), opts.ConfigProjectID()))
+	}
+	return err
+}
+
+// mongocli ops-manager dbuser(s) expire [--dryRun] [--force] [--projectId projectId]
+func ExpireBuilder() *cobra.Command {
+	opts := &ExpireOpts{
+		now: time.Now,
+		fs:  afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "expire",
+		Short: "Delete the database users of your project past the date given with create --deleteAfter.",
+		Long: `Ops Manager has no user expiry, the date given with create --deleteAfter is tracked on this machine
+and this command deletes the users past it. Run it on a schedule from the machine that created the users.`,
+		Example: `  $ mongocli om dbuser expire --force --projectId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.Progress = cmd.ErrOrStderr()
+			opts.expiryFile = expiryFilename()
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), expireTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.DryRun, flag.DryRun, false, usage.ExpireDryRun)
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	return cmd
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// +build unit
+
+package dbusers
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/mongodb/mongocli/internal/test/fixture"
+	"github.com/spf13/afero"
+)
+
+const projectID = "5a0a1e7e0f2912c554080adc"
+
+func TestExpire_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAutomationPatcher(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	opts := &ExpireOpts{
+		OutputOpts: cli.OutputOpts{Template: expireTemplate, OutWriter: buf},
+		now:        func() time.Time { return time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC) },
+		fs:         afero.NewMemMapFs(),
+		expiryFile: "/config/expiry.json",
+		PlanOpts:   cli.PlanOpts{Confirm: true, Progress: new(bytes.Buffer)},
+		store:      mockStore,
+	}
+	opts.ProjectID = projectID
+	_ = saveExpiries(opts.fs, opts.expiryFile, []*Expiry{
+		{ProjectID: projectID, Username: "test", Database: "test", DeleteAfter: "2021-04-30T00:00:00Z"},
+		{ProjectID: projectID, Username: "later", Database: "admin", DeleteAfter: "2021-05-02T00:00:00Z"},
+		{ProjectID: "other", Username: "test", Database: "test", DeleteAfter: "2021-04-30T00:00:00Z"},
+	})
+
+	expected := fixture.AutomationConfigWithMongoDBUsers()
+	mockStore.
+		EXPECT().
+		GetAutomationConfig(projectID).
+		Return(expected, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		UpdateAutomationConfig(projectID, expected).
+		Return(nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if len(expected.Auth.Users) != 0 {
+		t.Errorf("expected the expired user to be removed, got %d users", len(expected.Auth.Users))
+	}
+	want := `USERNAME   DATABASE   DELETE AFTER
+test       test       2021-04-30T00:00:00Z
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	remaining, _ := loadExpiries(opts.fs, opts.expiryFile)
+	if len(remaining) != 2 {
+		t.Errorf("got %d tracked expiries, want 2", len(remaining))
+	}
+}
+
+func TestTrackExpiry(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	e := &Expiry{ProjectID: projectID, Username: "test", Database: "admin", DeleteAfter: "2021-04-30T00:00:00Z"}
+	_ = trackExpiry(fs, "expiry.json", e)
+	e2 := *e
+	e2.DeleteAfter = "2021-06-30T00:00:00Z"
+	if err := trackExpiry(fs, "expiry.json", &e2); err != nil {
+		t.Fatalf("trackExpiry() unexpected error: %v", err)
+	}
+	expiries, _ := loadExpiries(fs, "expiry.json")
+	if len(expiries) != 1 || expiries[0].DeleteAfter != e2.DeleteAfter {
+		t.Errorf("got %+v, want a single updated expiry", expiries)
+	}
+}
+
+func TestExpireBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		ExpireBuilder(),
+		0,
+		[]string{flag.DryRun, flag.Force, flag.ProjectID, flag.Output},
+	)
+}
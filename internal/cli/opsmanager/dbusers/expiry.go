@@ -0,0 +1,82 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbusers
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/file"
+	"github.com/spf13/afero"
+)
+
+// Expiry is the date after which the expire command deletes a database user,
+// Ops Manager has no user expiry so they're tracked on this machine.
+type Expiry struct {
+	ProjectID   string `json:"projectId"`
+	Username    string `json:"username"`
+	Database    string `json:"database"`
+	DeleteAfter string `json:"deleteAfter"`
+}
+
+func (e *Expiry) expired(now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, e.DeleteAfter)
+	return err == nil && t.Before(now)
+}
+
+func expiryFilename() string {
+	return filepath.Join(config.ConfigDir(), config.ToolName+"-dbuser-expiry.json")
+}
+
+// loadExpiries returns the tracked expiries, none when the file doesn't exist yet
+func loadExpiries(fs afero.Fs, filename string) ([]*Expiry, error) {
+	exists, err := afero.Exists(fs, filename)
+	if err != nil || !exists {
+		return nil, err
+	}
+	var expiries []*Expiry
+	if err := file.Load(fs, filename, &expiries); err != nil {
+		return nil, err
+	}
+	return expiries, nil
+}
+
+func saveExpiries(fs afero.Fs, filename string, expiries []*Expiry) error {
+	if err := fs.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(expiries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return afero.WriteFile(fs, filename, b, 0600)
+}
+
+// trackExpiry adds the expiry, replacing the one of the same user
+func trackExpiry(fs afero.Fs, filename string, e *Expiry) error {
+	expiries, err := loadExpiries(fs, filename)
+	if err != nil {
+		return err
+	}
+	tracked := []*Expiry{e}
+	for _, x := range expiries {
+		if x.ProjectID != e.ProjectID || x.Username != e.Username || x.Database != e.Database {
+			tracked = append(tracked, x)
+		}
+	}
+	return saveExpiries(fs, filename, tracked)
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbusers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/convert"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/store"
+	"github.com/mongodb/mongocli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"go.mongodb.org/ops-manager/atmcfg"
+)
+
+const (
+	rotatePasswordTemplate = `{{if .Password}}New password of database user '{{.Username}}': {{.Password}}{{else}}Password of database user '{{.Username}}' rotated.{{end}}
+`
+	externalDB = "$external"
+)
+
+type RotatePasswordOpts struct {
+	cli.GlobalOpts
+	cli.OutputOpts
+	cli.CredentialOpts
+	username string
+	authDB   string
+	store    store.AutomationPatcher
+}
+
+func (opts *RotatePasswordOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *RotatePasswordOpts) Run() error {
+	if opts.authDB == externalDB {
+		return fmt.Errorf("users of %s don't authenticate with a password", externalDB)
+	}
+	current, err := opts.store.GetAutomationConfig(opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+
+	found := false
+	password, err := opts.GeneratePassword()
+	if err != nil {
+		return err
+	}
+	for _, u := range current.Auth.Users {
+		if u.Username == opts.username && u.Database == opts.authDB {
+			if err := atmcfg.ConfigureScramCredentials(u, password); err != nil {
+				return err
+			}
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("user '%s' not found for '%s'", opts.username, opts.authDB)
+	}
+
+	if err := opts.store.UpdateAutomationConfig(opts.ConfigProjectID(), current); err != nil {
+		return err
+	}
+
+	credential := &cli.Credential{Username: opts.username, Password: password, Database: opts.authDB}
+	if err := opts.SaveCredential(credential); err != nil {
+		// the password is already rotated, print it rather than lose it
+		if printErr := opts.Print(credential); printErr != nil {
+			return printErr
+		}
+		return fmt.Errorf("password of database user '%s' rotated but not saved: %w", opts.username, err)
+	}
+	return opts.Print(credential)
+}
+
+// mongocli ops-manager dbuser(s) rotatePassword <username> [--authDB authDB] [--out file | --credentialHelper executable] [--projectId projectId]
+func RotatePasswordBuilder() *cobra.Command {
+	opts := &RotatePasswordOpts{}
+	opts.Fs = afero.NewOsFs()
+	cmd := &cobra.Command{
+		Use:   "rotatePassword <username>",
+		Short: "Set a new generated password for a database user of your project.",
+		Long: `The SCRAM credentials of the user are replaced in the automation configuration. The new password is printed,
+saved as JSON to the file given with --out, readable only by you, or handed to the executable given with --credentialHelper.`,
+		Example: `  $ mongocli om dbuser rotatePassword myUser --out myUser.json --projectId 5e2211c17a3e5a48f5497de3`,
+		Args:    require.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return opts.PreRunE(
+				opts.ValidateCredentialOpts,
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), rotatePasswordTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.username = args[0]
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.authDB, flag.AuthDB, convert.AdminDB, usage.AuthDB)
+	cmd.Flags().StringVar(&opts.Out, flag.Out, "", usage.CredentialOut)
+	cmd.Flags().StringVar(&opts.CredentialHelper, flag.CredentialHelper, "", usage.CredentialHelper)
+
+	cmd.Flags().StringVar(&opts.ProjectID, flag.ProjectID, "", usage.ProjectID)
+	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
+
+	_ = cmd.MarkFlagFilename(flag.Out)
+
+	return cmd
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// +build unit
+
+package dbusers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mongodb/mongocli/internal/cli"
+	"github.com/mongodb/mongocli/internal/flag"
+	"github.com/mongodb/mongocli/internal/mocks"
+	"github.com/mongodb/mongocli/internal/test"
+	"github.com/mongodb/mongocli/internal/test/fixture"
+	"github.com/spf13/afero"
+)
+
+func TestRotatePassword_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAutomationPatcher(ctrl)
+	defer ctrl.Finish()
+
+	buf := new(bytes.Buffer)
+	expected := fixture.AutomationConfigWithMongoDBUsers()
+	opts := &RotatePasswordOpts{
+		OutputOpts:     cli.OutputOpts{Template: rotatePasswordTemplate, OutWriter: buf},
+		CredentialOpts: cli.CredentialOpts{Out: "test.json", Fs: afero.NewMemMapFs()},
+		username:       "test",
+		authDB:         "test",
+		store:          mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		GetAutomationConfig(opts.ProjectID).
+		Return(expected, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		UpdateAutomationConfig(opts.ProjectID, expected).
+		Return(nil).
+		Times(1)
+
+	if err := opts.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if u := expected.Auth.Users[0]; u.ScramSha1Creds == nil || u.ScramSha256Creds == nil {
+		t.Error("expected new SCRAM credentials")
+	}
+	if got, want := buf.String(), "Password of database user 'test' rotated.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if exists, _ := afero.Exists(opts.Fs, opts.Out); !exists {
+		t.Error("expected the credential file")
+	}
+}
+
+func TestRotatePassword_RunNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockAutomationPatcher(ctrl)
+	defer ctrl.Finish()
+
+	opts := &RotatePasswordOpts{
+		username: "other",
+		authDB:   "admin",
+		store:    mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		GetAutomationConfig(opts.ProjectID).
+		Return(fixture.AutomationConfigWithMongoDBUsers(), nil).
+		Times(1)
+
+	if err := opts.Run(); err == nil {
+		t.Error("Run() expected an error")
+	}
+}
+
+func TestRotatePasswordBuilder(t *testing.T) {
+	test.CmdValidator(
+		t,
+		RotatePasswordBuilder(),
+		0,
+		[]string{flag.AuthDB, flag.Out, flag.CredentialHelper, flag.ProjectID, flag.Output},
+	)
+}
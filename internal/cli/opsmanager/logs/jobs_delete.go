@@ -15,6 +15,8 @@
 package logs
 
 import (
+	"context"
+
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/require"
 	"github.com/mongodb/mongocli/internal/config"
@@ -30,10 +32,12 @@ type JobsDeleteOpts struct {
 	store store.LogJobDeleter
 }
 
-func (opts *JobsDeleteOpts) initStore() error {
-	var err error
-	opts.store, err = store.New(config.Default())
-	return err
+func (opts *JobsDeleteOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(config.Default(), store.WithContext(ctx))
+		return err
+	}
 }
 
 func (opts *JobsDeleteOpts) Run() error {
@@ -51,7 +55,7 @@ func JobsDeleteOptsBuilder() *cobra.Command {
 		Args:    require.ExactArgs(1),
 		Short:   "Delete a log collection job from your project.",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			if err := opts.PreRunE(opts.ValidateProjectID, opts.initStore); err != nil {
+			if err := opts.PreRunE(opts.ValidateProjectID, opts.initStore(cmd.Context())); err != nil {
 				return err
 			}
 			opts.Entry = args[0]
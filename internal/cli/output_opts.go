@@ -15,6 +15,8 @@
 package cli
 
 import (
+	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -30,6 +32,7 @@ import (
 
 const (
 	jsonFormat     = "json"
+	csvFormat      = "csv"
 	jsonPath       = "json-path"
 	goTemplate     = "go-template"
 	goTemplateFile = "go-template-file"
@@ -37,6 +40,11 @@ const (
 
 var templateFormats = []string{goTemplate, goTemplateFile, jsonPath}
 
+// CSVRecorder is implemented by the results of commands that support the csv output
+type CSVRecorder interface {
+	Records() [][]string
+}
+
 type OutputOpts struct {
 	Template  string
 	OutWriter io.Writer
@@ -96,6 +104,14 @@ func (opts *OutputOpts) Print(o interface{}) error {
 		return jsonwriter.Print(opts.ConfigWriter(), o)
 	}
 
+	if opts.ConfigOutput() == csvFormat {
+		r, ok := o.(CSVRecorder)
+		if !ok {
+			return errors.New("csv output is not supported for this command")
+		}
+		return csv.NewWriter(opts.ConfigWriter()).WriteAll(r.Records())
+	}
+
 	outputType, val := opts.outputTypeAndValue()
 	if outputType == jsonPath {
 		return jsonpathwriter.Print(opts.ConfigWriter(), val, o)
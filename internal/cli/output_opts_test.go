@@ -17,6 +17,7 @@
 package cli
 
 import (
+	"bytes"
 	"io"
 	"testing"
 )
@@ -64,3 +65,23 @@ func TestOutputOpts_outputTypeAndValue(t *testing.T) {
 		})
 	}
 }
+
+type records [][]string
+
+func (r records) Records() [][]string {
+	return r
+}
+
+func TestOutputOpts_PrintCSV(t *testing.T) {
+	buf := new(bytes.Buffer)
+	opts := &OutputOpts{OutWriter: buf, Output: csvFormat}
+	if err := opts.Print(records{{"NAME", "NOTE"}, {"a", "b, c"}}); err != nil {
+		t.Fatalf("Print() unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "NAME,NOTE\na,\"b, c\"\n"; got != want {
+		t.Errorf("Print() got = %q, want %q", got, want)
+	}
+	if err := opts.Print(struct{}{}); err == nil {
+		t.Error("Print() expected an error for a result without records")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/mongodb/mongocli/internal/prompt"
+)
+
+// PlanOpts options required when applying planned changes.
+// A command can compose this struct, print its plan and then rely on the methods Proceed and Apply
+// to confirm the changes with the user and apply them.
+type PlanOpts struct {
+	DryRun   bool
+	Confirm  bool
+	Progress io.Writer
+}
+
+// Proceed reports whether the n planned changes should be applied, there's nothing to apply for
+// a dry run or an empty plan. Unless --force was given message is confirmed first and
+// declined is printed when the user says no.
+func (opts *PlanOpts) Proceed(n int, message, declined string) (bool, error) {
+	if opts.DryRun || n == 0 {
+		return false, nil
+	}
+	if !opts.Confirm {
+		p := prompt.NewConfirm(message)
+		if err := survey.AskOne(p, &opts.Confirm); err != nil {
+			return false, err
+		}
+		if !opts.Confirm {
+			_, err := fmt.Fprintln(opts.Progress, declined)
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Apply runs the operations of the plan in order and stops at the first failure,
+// describe returns the change of the operation at index i for its error.
+func (opts *PlanOpts) Apply(operations []func() error, describe func(i int) string) error {
+	for i, apply := range operations {
+		if err := apply(); err != nil {
+			return fmt.Errorf("%s: %w", describe(i), err)
+		}
+	}
+	return nil
+}
@@ -15,23 +15,37 @@
 package root
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
 
 	"github.com/mongodb/mongocli/internal/cli"
 	"github.com/mongodb/mongocli/internal/cli/atlas"
 	"github.com/mongodb/mongocli/internal/cli/cloudmanager"
 	cliconfig "github.com/mongodb/mongocli/internal/cli/config"
 	"github.com/mongodb/mongocli/internal/cli/iam"
+	"github.com/mongodb/mongocli/internal/cli/inventory"
 	"github.com/mongodb/mongocli/internal/cli/opsmanager"
 	"github.com/mongodb/mongocli/internal/cli/require"
+	"github.com/mongodb/mongocli/internal/config"
 	"github.com/mongodb/mongocli/internal/flag"
 	"github.com/mongodb/mongocli/internal/search"
+	"github.com/mongodb/mongocli/internal/store"
 	"github.com/mongodb/mongocli/internal/usage"
 	"github.com/spf13/cobra"
 )
 
 var (
-	rootCmd *cobra.Command
+	rootCmd   *cobra.Command
+	debug     bool
+	traceFile string
+	timeout   time.Duration
+	timedOut  int32
+	cancel    context.CancelFunc = func() {}
 
 	completionCmd = &cobra.Command{
 		Use:   "completion <bash|zsh|fish|powershell>",
@@ -88,10 +102,63 @@ func Builder(profile *string, argsWithoutProg []string) *cobra.Command {
 		cloudmanager.Builder(),
 		opsmanager.Builder(),
 		iam.Builder(),
+		inventory.Builder(),
 		completionCmd,
 	)
 
 	rootCmd.PersistentFlags().StringVarP(profile, flag.Profile, flag.ProfileShort, "", usage.Profile)
+	rootCmd.PersistentFlags().BoolVar(&debug, flag.Debug, false, usage.Debug)
+	rootCmd.PersistentFlags().StringVar(&traceFile, flag.TraceFile, "", usage.TraceFile)
+	rootCmd.PersistentFlags().DurationVar(&timeout, flag.Timeout, 0, usage.Timeout)
+	cobra.OnInitialize(initDebug, initTimeout)
 
 	return rootCmd
 }
+
+// initDebug enables HTTP tracing when requested via global flags,
+// environment variables are handled by the config package.
+func initDebug() {
+	if debug {
+		config.SetDebug(true)
+	}
+	if traceFile != "" {
+		config.SetTraceFile(traceFile)
+	}
+}
+
+// initTimeout cancels the running command once the --timeout duration expires.
+func initTimeout() {
+	if timeout <= 0 {
+		return
+	}
+	time.AfterFunc(timeout, func() {
+		atomic.StoreInt32(&timedOut, 1)
+		cancel()
+	})
+}
+
+// Execute runs the command with a context that is canceled when the user interrupts
+// the command (SIGINT or SIGTERM) or when the --timeout duration expires,
+// canceling any in-flight request.
+func Execute(cmd *cobra.Command) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx, cancel = context.WithCancel(ctx)
+	defer cancel()
+
+	err := cmd.ExecuteContext(ctx)
+	if err != nil && ctx.Err() != nil {
+		reason := "interrupted"
+		if atomic.LoadInt32(&timedOut) == 1 {
+			reason = fmt.Sprintf("timed out after %s", timeout)
+		}
+		completed, canceled := store.Requests()
+		w := cmd.ErrOrStderr()
+		_, _ = fmt.Fprintf(w, "Command %s, %d request(s) completed before that and were applied, they aren't rolled back.\n", reason, completed)
+		for _, r := range canceled {
+			_, _ = fmt.Fprintf(w, "Canceled while in flight: %s, the API may have applied it anyway.\n", r)
+		}
+		_, _ = fmt.Fprintln(w, "Check the current state before retrying.")
+	}
+	return err
+}
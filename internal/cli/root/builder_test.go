@@ -17,7 +17,11 @@
 package root
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+
+	"github.com/spf13/cobra"
 )
 
 func TestBuilder(t *testing.T) {
@@ -31,70 +35,70 @@ func TestBuilder(t *testing.T) {
 	}{
 		{
 			name: "atlas",
-			want: 6,
+			want: 7,
 			args: args{
 				argsWithoutProg: []string{"atlas"},
 			},
 		},
 		{
 			name: "ops-manager",
-			want: 5,
+			want: 6,
 			args: args{
 				argsWithoutProg: []string{"ops-manager"},
 			},
 		},
 		{
 			name: "cloud-manager",
-			want: 5,
+			want: 6,
 			args: args{
 				argsWithoutProg: []string{"cloud-manager"},
 			},
 		},
 		{
 			name: "ops-manager alias",
-			want: 5,
+			want: 6,
 			args: args{
 				argsWithoutProg: []string{"om"},
 			},
 		},
 		{
 			name: "cloud-manager alias",
-			want: 5,
+			want: 6,
 			args: args{
 				argsWithoutProg: []string{"cm"},
 			},
 		},
 		{
 			name: "iam",
-			want: 5,
+			want: 6,
 			args: args{
 				argsWithoutProg: []string{"iam"},
 			},
 		},
 		{
 			name: "empty",
-			want: 6,
+			want: 7,
 			args: args{
 				argsWithoutProg: []string{},
 			},
 		},
 		{
 			name: "autocomplete",
-			want: 6,
+			want: 7,
 			args: args{
 				argsWithoutProg: []string{"__complete"},
 			},
 		},
 		{
 			name: "completion",
-			want: 6,
+			want: 7,
 			args: args{
 				argsWithoutProg: []string{"completion"},
 			},
 		},
 		{
 			name: "--version",
-			want: 6,
+			want: 7,
 			args: args{
 				argsWithoutProg: []string{"completion"},
 			},
@@ -113,3 +117,26 @@ func TestBuilder(t *testing.T) {
 		})
 	}
 }
+
+func TestExecute(t *testing.T) {
+	var profile string
+	cmd := Builder(&profile, []string{"wait"})
+	cmd.AddCommand(&cobra.Command{
+		Use: "wait",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			<-cmd.Context().Done()
+			return cmd.Context().Err()
+		},
+	})
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"wait", "--timeout", "10ms"})
+
+	if err := Execute(cmd); err == nil {
+		t.Fatal("Execute() expected an error but got none")
+	}
+	if !strings.Contains(buf.String(), "timed out after 10ms") {
+		t.Errorf("Execute() output = %s, want timeout message", buf.String())
+	}
+}
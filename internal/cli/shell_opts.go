@@ -0,0 +1,119 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/mongosh"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	mongoShell   = "mongo"
+	mongoshShell = "mongosh"
+
+	DefaultShell = mongoshShell
+)
+
+// ShellOpts opens a MongoDB shell on a deployment as a database user,
+// the password comes from a credential file, a credential helper or a prompt, never from the command line.
+type ShellOpts struct {
+	Shell            string
+	ShellArgs        []string
+	Username         string
+	Password         string
+	AuthDB           string
+	CredentialFile   string
+	CredentialHelper string
+	Fs               afero.Fs
+}
+
+// ValidateShell checks the shell is supported and a single credential source is given
+func (opts *ShellOpts) ValidateShell() error {
+	if opts.Shell != mongoShell && opts.Shell != mongoshShell {
+		return fmt.Errorf("invalid shell %s, use %s or %s", opts.Shell, mongoshShell, mongoShell)
+	}
+	if opts.CredentialFile != "" && opts.CredentialHelper != "" {
+		return fmt.Errorf("use either a credential file or a credential helper, not both")
+	}
+	return nil
+}
+
+// SetShellArgs takes the arguments after -- as the arguments of the shell,
+// they must follow the n arguments of the command.
+func (opts *ShellOpts) SetShellArgs(cmd *cobra.Command, args []string, n int) error {
+	dash := cmd.ArgsLenAtDash()
+	switch {
+	case dash == -1 && len(args) == n:
+		return nil
+	case dash == n:
+		opts.ShellArgs = args[n:]
+		return nil
+	}
+	return fmt.Errorf("accepts %d arg(s), pass the arguments of the shell after --", n)
+}
+
+// LoadCredential fills the credential of the user from the credential file, the credential helper or a prompt,
+// without a user the shell connects unauthenticated.
+func (opts *ShellOpts) LoadCredential() error {
+	c := &Credential{Username: opts.Username, Password: opts.Password, Database: opts.AuthDB}
+	switch {
+	case opts.CredentialFile != "":
+		if err := loadCredential(opts.Fs, opts.CredentialFile, c); err != nil {
+			return err
+		}
+	case opts.Username == "":
+		return nil
+	case opts.CredentialHelper != "":
+		if err := fetchCredential(opts.CredentialHelper, c); err != nil {
+			return err
+		}
+	default:
+		prompt := &survey.Password{
+			Message: fmt.Sprintf("Password of %s:", opts.Username),
+		}
+		return survey.AskOne(prompt, &opts.Password)
+	}
+	opts.Username = c.Username
+	opts.Password = c.Password
+	if c.Database != "" {
+		opts.AuthDB = c.Database
+	}
+	return nil
+}
+
+func (opts *ShellOpts) binary() (string, error) {
+	if opts.Shell == mongoshShell && config.MongoShellPath() != "" {
+		return config.MongoShellPath(), nil
+	}
+	return mongosh.LookPath(opts.Shell)
+}
+
+// RunShell connects the shell to the connection string
+func (opts *ShellOpts) RunShell(mongoURI string) error {
+	binary, err := opts.binary()
+	if err != nil {
+		return err
+	}
+	var c *mongosh.Credentials
+	if opts.Username != "" {
+		c = &mongosh.Credentials{Username: opts.Username, Password: opts.Password, AuthDB: opts.AuthDB}
+	}
+	return mongosh.Connect(binary, mongoURI, c, opts.ShellArgs)
+}
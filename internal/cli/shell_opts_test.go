@@ -0,0 +1,80 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+func TestShellOpts_ValidateShell(t *testing.T) {
+	opts := &ShellOpts{Shell: "mongo"}
+	if err := opts.ValidateShell(); err != nil {
+		t.Errorf("ValidateShell() unexpected error: %v", err)
+	}
+	opts.Shell = "bash"
+	if err := opts.ValidateShell(); err == nil {
+		t.Error("ValidateShell() expected an error for an unsupported shell")
+	}
+}
+
+func TestShellOpts_SetShellArgs(t *testing.T) {
+	run := func(args ...string) (*ShellOpts, error) {
+		opts := new(ShellOpts)
+		cmd := &cobra.Command{
+			Use:           "connect",
+			Args:          cobra.MinimumNArgs(1),
+			SilenceUsage:  true,
+			SilenceErrors: true,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return opts.SetShellArgs(cmd, args, 1)
+			},
+		}
+		cmd.SetArgs(args)
+		return opts, cmd.Execute()
+	}
+
+	opts, err := run("myCluster", "--", "--quiet", "--norc")
+	if err != nil {
+		t.Fatalf("SetShellArgs() unexpected error: %v", err)
+	}
+	if len(opts.ShellArgs) != 2 || opts.ShellArgs[0] != "--quiet" {
+		t.Errorf("got %v, want [--quiet --norc]", opts.ShellArgs)
+	}
+
+	if _, err := run("myCluster", "other"); err == nil {
+		t.Error("SetShellArgs() expected an error for arguments before --")
+	}
+}
+
+func TestShellOpts_LoadCredential(t *testing.T) {
+	opts := &ShellOpts{CredentialFile: "credential.json", Fs: afero.NewMemMapFs()}
+	_ = afero.WriteFile(opts.Fs, opts.CredentialFile, []byte(`{"username": "user", "password": "secret", "database": "admin"}`), 0600)
+	if err := opts.LoadCredential(); err != nil {
+		t.Fatalf("LoadCredential() unexpected error: %v", err)
+	}
+	if opts.Username != "user" || opts.Password != "secret" || opts.AuthDB != "admin" {
+		t.Errorf("got %+v, want the credential of the file", opts)
+	}
+
+	opts = &ShellOpts{Username: "other", CredentialFile: "credential.json", Fs: opts.Fs}
+	if err := opts.LoadCredential(); err == nil {
+		t.Error("LoadCredential() expected an error for the credential of another user")
+	}
+}
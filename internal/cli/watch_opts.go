@@ -15,44 +15,185 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 type WatchOpts struct {
 	OutputOpts
-	s *spinner.Spinner
+	Interval time.Duration
+	ctx      context.Context
+	s        *spinner.Spinner
+	sleep    func(time.Duration)
 }
 
 const (
 	defaultWait = 4 * time.Second
+	maxWait     = 30 * time.Second
 	speed       = 100 * time.Millisecond
+	// DeletedState is the target state to wait for a resource to be gone
+	DeletedState = "DELETED"
 )
 
+// Watcher reports if the watched resource is done.
 type Watcher func() (bool, error)
 
-// InitOutput allow to init the OutputOpts in a functional way
+// StateWatcher returns the current state of the named resource.
+type StateWatcher func(name string) (string, error)
+
+// WatchEvent is written for every poll of a resource when the output is JSON.
+type WatchEvent struct {
+	Resource string `json:"resource"`
+	State    string `json:"state"`
+	Target   string `json:"target"`
+	Done     bool   `json:"done"`
+	Elapsed  string `json:"elapsed"`
+}
+
+// InitWatch stops the wait once ctx is canceled, by an interruption or the global --timeout.
+func (opts *WatchOpts) InitWatch(ctx context.Context) func() error {
+	return func() error {
+		opts.ctx = ctx
+		return nil
+	}
+}
+
+// Watch polls f until it's done, the poll interval backs off exponentially
+// from --interval up to 30s and the wait stops once the command is canceled.
 func (opts *WatchOpts) Watch(f Watcher) error {
 	if f == nil {
 		return errors.New("no watcher provided")
 	}
+	return opts.watch(func(time.Duration) (bool, string, error) {
+		done, err := f()
+		return done, "", err
+	})
+}
+
+// WatchStates polls f for every resource until each one reaches target.
+// A resource that is not found is DELETED, so target can be DeletedState.
+func (opts *WatchOpts) WatchStates(target string, f StateWatcher, names ...string) error {
+	if f == nil {
+		return errors.New("no watcher provided")
+	}
+	states := make(map[string]string, len(names))
+	pending := names
+	return opts.watch(func(elapsed time.Duration) (bool, string, error) {
+		remaining := make([]string, 0, len(pending))
+		for _, name := range pending {
+			state, err := f(name)
+			if err != nil && !(target == DeletedState && isNotFound(err)) {
+				return false, "", err
+			}
+			if err != nil {
+				state = DeletedState
+			}
+			states[name] = state
+			done := state == target
+			if !done {
+				remaining = append(remaining, name)
+			}
+			if err := opts.printEvent(WatchEvent{Resource: name, State: state, Target: target, Done: done, Elapsed: elapsed.String()}); err != nil {
+				return false, "", err
+			}
+		}
+		pending = remaining
+		if len(pending) == 0 {
+			return true, "", nil
+		}
+		last := make([]string, len(pending))
+		for i, name := range pending {
+			last[i] = fmt.Sprintf("%s is %s", name, states[name])
+		}
+		return false, fmt.Sprintf("waiting for %s, %s", target, strings.Join(last, ", ")), nil
+	})
+}
+
+// watch runs poll until it's done, it errs or the command is canceled,
+// poll returns a description of what is pending for the cancellation error.
+func (opts *WatchOpts) watch(poll func(elapsed time.Duration) (bool, string, error)) error {
+	wait := opts.Interval
+	if wait <= 0 {
+		wait = defaultWait
+	}
+	ctx := opts.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	start := time.Now()
 	opts.start()
+	defer opts.stop()
 	for {
-		done, err := f()
+		elapsed := time.Since(start).Round(time.Second)
+		done, pending, err := poll(elapsed)
 		if err != nil || done {
-			opts.stop()
 			return err
 		}
-		if !opts.IsTerminal() {
+		if !opts.IsTerminal() && opts.ConfigOutput() != jsonFormat {
 			if _, err = fmt.Fprint(opts.ConfigWriter(), "."); err != nil {
 				return err
 			}
 		}
-		time.Sleep(defaultWait)
+		if err = opts.pause(ctx, wait); err != nil {
+			return stoppedError(err, pending)
+		}
+		if wait *= 2; wait > maxWait {
+			wait = maxWait
+		}
+	}
+}
+
+// pause waits for d, or until ctx is canceled.
+func (opts *WatchOpts) pause(ctx context.Context, d time.Duration) error {
+	if opts.sleep != nil {
+		opts.sleep(d)
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func stoppedError(err error, pending string) error {
+	if pending == "" {
+		return fmt.Errorf("stopped waiting: %w", err)
+	}
+	return fmt.Errorf("stopped waiting %s: %w", pending, err)
+}
+
+// printEvent writes a progress event as a JSON line, other outputs have no events.
+func (opts *WatchOpts) printEvent(e WatchEvent) error {
+	if opts.ConfigOutput() != jsonFormat {
+		return nil
 	}
+	return json.NewEncoder(opts.ConfigWriter()).Encode(e)
+}
+
+// Print prints o, with JSON output the progress events already are the output
+// so there's nothing to print for a nil completion message.
+func (opts *WatchOpts) Print(o interface{}) error {
+	if o == nil && opts.ConfigOutput() == jsonFormat {
+		return nil
+	}
+	return opts.OutputOpts.Print(o)
+}
+
+func isNotFound(err error) bool {
+	var target *atlas.ErrorResponse
+	return errors.As(err, &target) && target.HTTPCode == http.StatusNotFound
 }
 
 func (opts *WatchOpts) start() {
@@ -0,0 +1,155 @@
+// Copyright 2020 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestWatchOpts_Watch(t *testing.T) {
+	t.Run("backoff", func(t *testing.T) {
+		var waits []time.Duration
+		opts := &WatchOpts{
+			OutputOpts: OutputOpts{OutWriter: new(bytes.Buffer), Output: "text"},
+			Interval:   10 * time.Second,
+			sleep:      func(d time.Duration) { waits = append(waits, d) },
+		}
+		calls := 0
+		err := opts.Watch(func() (bool, error) {
+			calls++
+			return calls == 5, nil
+		})
+		if err != nil {
+			t.Fatalf("Watch() unexpected error: %v", err)
+		}
+		want := []time.Duration{10 * time.Second, 20 * time.Second, maxWait, maxWait}
+		if len(waits) != len(want) {
+			t.Fatalf("waits = %v; want %v", waits, want)
+		}
+		for i := range want {
+			if waits[i] != want[i] {
+				t.Errorf("waits[%d] = %v; want %v", i, waits[i], want[i])
+			}
+		}
+	})
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		opts := &WatchOpts{
+			OutputOpts: OutputOpts{OutWriter: new(bytes.Buffer), Output: "text"},
+			ctx:        ctx,
+		}
+		err := opts.Watch(func() (bool, error) {
+			return false, nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Watch() error = %v; want %v", err, context.Canceled)
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		opts := &WatchOpts{OutputOpts: OutputOpts{OutWriter: new(bytes.Buffer), Output: "text"}}
+		expected := errors.New("test")
+		if err := opts.Watch(func() (bool, error) { return false, expected }); !errors.Is(err, expected) {
+			t.Fatalf("Watch() error = %v; want %v", err, expected)
+		}
+	})
+}
+
+func TestWatchOpts_WatchStates(t *testing.T) {
+	t.Run("multiple resources", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		opts := &WatchOpts{
+			OutputOpts: OutputOpts{OutWriter: buf, Output: jsonFormat},
+			sleep:      func(time.Duration) {},
+		}
+		polls := map[string]int{}
+		err := opts.WatchStates("IDLE", func(name string) (string, error) {
+			polls[name]++
+			if name == "b" && polls[name] == 1 {
+				return "CREATING", nil
+			}
+			return "IDLE", nil
+		}, "a", "b")
+		if err != nil {
+			t.Fatalf("WatchStates() unexpected error: %v", err)
+		}
+		if polls["a"] != 1 || polls["b"] != 2 {
+			t.Errorf("polls = %v; want a polled once and b twice", polls)
+		}
+		dec := json.NewDecoder(buf)
+		var events []WatchEvent
+		for dec.More() {
+			var e WatchEvent
+			if err := dec.Decode(&e); err != nil {
+				t.Fatalf("Decode() unexpected error: %v", err)
+			}
+			events = append(events, e)
+		}
+		if len(events) != 3 || events[1].State != "CREATING" || events[1].Done || !events[2].Done {
+			t.Errorf("events = %+v; want a done, b creating then done", events)
+		}
+	})
+	t.Run("deleted", func(t *testing.T) {
+		opts := &WatchOpts{
+			OutputOpts: OutputOpts{OutWriter: new(bytes.Buffer), Output: "text"},
+			sleep:      func(time.Duration) {},
+		}
+		calls := 0
+		err := opts.WatchStates(DeletedState, func(name string) (string, error) {
+			calls++
+			if calls == 1 {
+				return "DELETING", nil
+			}
+			return "", &atlas.ErrorResponse{HTTPCode: http.StatusNotFound}
+		}, "a")
+		if err != nil {
+			t.Fatalf("WatchStates() unexpected error: %v", err)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		opts := &WatchOpts{OutputOpts: OutputOpts{OutWriter: new(bytes.Buffer), Output: "text"}}
+		err := opts.WatchStates("IDLE", func(name string) (string, error) {
+			return "", &atlas.ErrorResponse{HTTPCode: http.StatusNotFound}
+		}, "a")
+		if err == nil {
+			t.Fatal("WatchStates() expected an error")
+		}
+	})
+	t.Run("cancellation reports states", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		opts := &WatchOpts{
+			OutputOpts: OutputOpts{OutWriter: new(bytes.Buffer), Output: "text"},
+			ctx:        ctx,
+		}
+		err := opts.WatchStates("IDLE", func(name string) (string, error) {
+			return "CREATING", nil
+		}, "a")
+		if err == nil || !strings.Contains(err.Error(), "a is CREATING") {
+			t.Fatalf("WatchStates() error = %v; want the last state", err)
+		}
+	})
+}
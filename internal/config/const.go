@@ -36,7 +36,16 @@ const (
 	opsManagerCACertificate      = "ops_manager_ca_certificate"
 	opsManagerSkipVerify         = "ops_manager_skip_verify"
 	opsManagerVersionManifestURL = "ops_manager_version_manifest_url"
+	opsManagerClientCertificate  = "ops_manager_client_certificate"
+	opsManagerClientCertKey      = "ops_manager_client_certificate_key"
+	requestTimeout               = "request_timeout"
+	httpProxy                    = "http_proxy"
+	noProxy                      = "no_proxy"
 	output                       = "output"
+	debug                        = "debug"
+	traceFile                    = "trace_file"
+	retryMaxAttempts             = "retry_max_attempts"
+	retryNonIdempotent           = "retry_non_idempotent"
 	fileFlags                    = os.O_CREATE | os.O_TRUNC | os.O_WRONLY
 	configPerm                   = 0600
 )
@@ -19,7 +19,9 @@ import (
 	"log"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mongodb/mongocli/internal/search"
 	"github.com/pelletier/go-toml"
@@ -46,6 +48,8 @@ type Profile struct {
 	name      string
 	configDir string
 	fs        afero.Fs
+	debug     bool
+	traceFile string
 }
 
 func Properties() []string {
@@ -60,7 +64,51 @@ func Properties() []string {
 		baseURL,
 		opsManagerCACertificate,
 		opsManagerSkipVerify,
+		opsManagerClientCertificate,
+		opsManagerClientCertKey,
 		mongoShellPath,
+		requestTimeout,
+		httpProxy,
+		noProxy,
+		retryMaxAttempts,
+		retryNonIdempotent,
+	}
+}
+
+// BooleanProperties returns the properties that only accept true or false
+func BooleanProperties() []string {
+	return []string{
+		retryNonIdempotent,
+	}
+}
+
+// IntProperties returns the properties that only accept positive integers
+func IntProperties() []string {
+	return []string{
+		retryMaxAttempts,
+	}
+}
+
+// DurationProperties returns the properties that only accept durations, such as 30s or 2m
+func DurationProperties() []string {
+	return []string{
+		requestTimeout,
+	}
+}
+
+// FileProperties returns the properties that point to a file
+func FileProperties() []string {
+	return []string{
+		opsManagerCACertificate,
+		opsManagerClientCertificate,
+		opsManagerClientCertKey,
+	}
+}
+
+// ProxyProperties returns the properties that only accept a proxy URL
+func ProxyProperties() []string {
+	return []string{
+		httpProxy,
 	}
 }
 
@@ -201,6 +249,18 @@ func (p *Profile) OpsManagerSkipVerify() string {
 	return p.GetString(opsManagerSkipVerify)
 }
 
+// OpsManagerClientCertificate get configured client certificate location used for mutual TLS
+func OpsManagerClientCertificate() string { return p.OpsManagerClientCertificate() }
+func (p *Profile) OpsManagerClientCertificate() string {
+	return p.GetString(opsManagerClientCertificate)
+}
+
+// OpsManagerClientCertificateKey get configured client certificate key location used for mutual TLS
+func OpsManagerClientCertificateKey() string { return p.OpsManagerClientCertificateKey() }
+func (p *Profile) OpsManagerClientCertificateKey() string {
+	return p.GetString(opsManagerClientCertKey)
+}
+
 // OpsManagerVersionManifestURL get configured ops manager version manifest base url
 func OpsManagerVersionManifestURL() string { return p.OpsManagerVersionManifestURL() }
 func (p *Profile) OpsManagerVersionManifestURL() string {
@@ -255,6 +315,75 @@ func (p *Profile) SetOutput(v string) {
 	p.Set(output, v)
 }
 
+// RequestTimeout get configured time to wait for a response, its body included, 0 if not set or invalid
+func RequestTimeout() time.Duration { return p.RequestTimeout() }
+func (p *Profile) RequestTimeout() time.Duration {
+	v, err := time.ParseDuration(p.GetString(requestTimeout))
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// HTTPProxy get configured proxy URL
+func HTTPProxy() string { return p.HTTPProxy() }
+func (p *Profile) HTTPProxy() string {
+	return p.GetString(httpProxy)
+}
+
+// NoProxy get configured comma separated list of hosts that bypass the proxy
+func NoProxy() string { return p.NoProxy() }
+func (p *Profile) NoProxy() string {
+	return p.GetString(noProxy)
+}
+
+// Debug returns true if HTTP exchanges should be logged,
+// either via the --debug flag or the MCLI_DEBUG environment variable
+func Debug() bool { return p.Debug() }
+func (p *Profile) Debug() bool {
+	return p.debug || viper.GetBool(debug)
+}
+
+// SetDebug enables HTTP debug logging for the current execution, this is not persisted
+func SetDebug(v bool) { p.SetDebug(v) }
+func (p *Profile) SetDebug(v bool) {
+	p.debug = v
+}
+
+// TraceFile get the file where HTTP exchanges are recorded,
+// either via the --traceFile flag or the MCLI_TRACE_FILE environment variable
+func TraceFile() string { return p.TraceFile() }
+func (p *Profile) TraceFile() string {
+	if p.traceFile != "" {
+		return p.traceFile
+	}
+	return viper.GetString(traceFile)
+}
+
+// SetTraceFile sets the trace file for the current execution, this is not persisted
+func SetTraceFile(v string) { p.SetTraceFile(v) }
+func (p *Profile) SetTraceFile(v string) {
+	p.traceFile = v
+}
+
+// RetryMaxAttempts get the configured maximum number of attempts for a request,
+// 0 if not set or invalid
+func RetryMaxAttempts() int { return p.RetryMaxAttempts() }
+func (p *Profile) RetryMaxAttempts() int {
+	v, err := strconv.Atoi(p.GetString(retryMaxAttempts))
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// RetryNonIdempotent returns true if POST and PATCH requests can also be retried
+func RetryNonIdempotent() bool { return p.RetryNonIdempotent() }
+func (p *Profile) RetryNonIdempotent() bool {
+	v, _ := strconv.ParseBool(p.GetString(retryNonIdempotent))
+	return v
+}
+
 // IsAccessSet return true if API keys have been set up.
 // For Ops Manager we also check for the base URL.
 func IsAccessSet() bool { return p.IsAccessSet() }
@@ -334,6 +463,12 @@ func (p *Profile) Filename() string {
 	return filepath.Join(p.configDir, ToolName+".toml")
 }
 
+// ConfigDir returns the directory of the configuration file
+func ConfigDir() string { return p.ConfigDir() }
+func (p *Profile) ConfigDir() string {
+	return p.configDir
+}
+
 // Rename replaces the Profile to a new Profile name, overwriting any Profile that existed before.
 func Rename(newProfileName string) error { return p.Rename(newProfileName) }
 func (p *Profile) Rename(newProfileName string) error {
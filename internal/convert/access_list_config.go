@@ -0,0 +1,97 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+// AccessListConfig is the document with the IP access list entries of a project
+type AccessListConfig struct {
+	Entries []*AccessListEntryConfig `yaml:"entries" json:"entries"`
+}
+
+// AccessListEntryConfig is an IP access list entry, exactly one of CIDR block, IP address or AWS security group is set.
+// DeleteAfter is a duration, like 720h, after which Atlas removes the entry.
+type AccessListEntryConfig struct {
+	CIDRBlock        string `yaml:"cidrBlock,omitempty" json:"cidrBlock,omitempty"`
+	IPAddress        string `yaml:"ipAddress,omitempty" json:"ipAddress,omitempty"`
+	AwsSecurityGroup string `yaml:"awsSecurityGroup,omitempty" json:"awsSecurityGroup,omitempty"`
+	Comment          string `yaml:"comment,omitempty" json:"comment,omitempty"`
+	DeleteAfter      string `yaml:"deleteAfter,omitempty" json:"deleteAfter,omitempty"`
+}
+
+// Key identifies an entry the way Atlas does, IP addresses are single address CIDR blocks
+func (e *AccessListEntryConfig) Key() string {
+	switch {
+	case e.AwsSecurityGroup != "":
+		return e.AwsSecurityGroup
+	case e.IPAddress != "" && strings.Contains(e.IPAddress, ":"):
+		return e.IPAddress + "/128"
+	case e.IPAddress != "":
+		return e.IPAddress + "/32"
+	}
+	return e.CIDRBlock
+}
+
+// NewProjectIPAccessList returns the Atlas entry of the project, temporary entries expire deleteAfter from now
+func (e *AccessListEntryConfig) NewProjectIPAccessList(projectID string, now time.Time) *atlas.ProjectIPAccessList {
+	entry := &atlas.ProjectIPAccessList{
+		GroupID:          projectID,
+		CIDRBlock:        e.CIDRBlock,
+		IPAddress:        e.IPAddress,
+		AwsSecurityGroup: e.AwsSecurityGroup,
+		Comment:          e.Comment,
+	}
+	if e.DeleteAfter != "" {
+		d, _ := time.ParseDuration(e.DeleteAfter)
+		entry.DeleteAfterDate = now.Add(d).UTC().Format(time.RFC3339)
+	}
+	return entry
+}
+
+// Validate checks every entry has a single value, a valid deleteAfter and isn't listed twice
+func (c *AccessListConfig) Validate() error {
+	if len(c.Entries) == 0 {
+		return errors.New("the file has no entries")
+	}
+	seen := map[string]bool{}
+	for i, e := range c.Entries {
+		set := 0
+		for _, v := range []string{e.CIDRBlock, e.IPAddress, e.AwsSecurityGroup} {
+			if v != "" {
+				set++
+			}
+		}
+		if set != 1 {
+			return fmt.Errorf("entry %d: set exactly one of cidrBlock, ipAddress or awsSecurityGroup", i+1)
+		}
+		if e.DeleteAfter != "" {
+			if d, err := time.ParseDuration(e.DeleteAfter); err != nil || d <= 0 {
+				return fmt.Errorf("entry %s: invalid deleteAfter %q, use a positive duration like 720h", e.Key(), e.DeleteAfter)
+			}
+		}
+		if seen[e.Key()] {
+			return fmt.Errorf("entry %s is listed more than once", e.Key())
+		}
+		seen[e.Key()] = true
+	}
+	return nil
+}
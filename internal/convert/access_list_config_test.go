@@ -0,0 +1,54 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package convert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccessListConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []*AccessListEntryConfig
+		wantErr bool
+	}{
+		{name: "valid", entries: []*AccessListEntryConfig{{CIDRBlock: "10.0.0.0/8"}, {IPAddress: "10.0.0.1", DeleteAfter: "24h"}}},
+		{name: "empty", wantErr: true},
+		{name: "no value", entries: []*AccessListEntryConfig{{Comment: "office"}}, wantErr: true},
+		{name: "two values", entries: []*AccessListEntryConfig{{CIDRBlock: "10.0.0.0/8", IPAddress: "10.0.0.1"}}, wantErr: true},
+		{name: "invalid deleteAfter", entries: []*AccessListEntryConfig{{IPAddress: "10.0.0.1", DeleteAfter: "tomorrow"}}, wantErr: true},
+		{name: "duplicate", entries: []*AccessListEntryConfig{{IPAddress: "10.0.0.1"}, {CIDRBlock: "10.0.0.1/32"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		c := &AccessListConfig{Entries: tt.entries}
+		if err := c.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestAccessListEntryConfig_NewProjectIPAccessList(t *testing.T) {
+	e := &AccessListEntryConfig{IPAddress: "2001:db8::1", Comment: "vpn", DeleteAfter: "24h"}
+	if got := e.Key(); got != "2001:db8::1/128" {
+		t.Errorf("Key() = %s, want 2001:db8::1/128", got)
+	}
+	got := e.NewProjectIPAccessList("1", time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC))
+	if got.GroupID != "1" || got.IPAddress != e.IPAddress || got.Comment != e.Comment || got.DeleteAfterDate != "2021-05-02T00:00:00Z" {
+		t.Errorf("NewProjectIPAccessList() = %+v", got)
+	}
+}
@@ -69,3 +69,19 @@ func BuildAtlasActions(a []string) []atlas.Action {
 	}
 	return actions
 }
+
+// JoinAtlasActions will merge the resources for a same action given actions must be unique.
+func JoinAtlasActions(newActions []atlas.Action) []atlas.Action {
+	out := make([]atlas.Action, 0)
+	actionMap := make(map[string]atlas.Action)
+	for _, action := range newActions {
+		if a, ok := actionMap[action.Action]; ok {
+			action.Resources = append(action.Resources, a.Resources...)
+		}
+		actionMap[action.Action] = action
+	}
+	for _, action := range actionMap {
+		out = append(out, action)
+	}
+	return out
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -147,3 +148,115 @@ func TestBuildAtlasActions(t *testing.T) {
 		})
 	}
 }
+
+func TestJoinAtlasActions(t *testing.T) {
+	type args struct {
+		newActions []mongodbatlas.Action
+	}
+	tests := []struct {
+		name string
+		args args
+		want []mongodbatlas.Action
+	}{
+		{
+			name: "empty",
+			args: args{
+				newActions: []mongodbatlas.Action{},
+			},
+			want: []mongodbatlas.Action{},
+		},
+		{
+			name: "no duplicate",
+			args: args{
+				newActions: []mongodbatlas.Action{
+					{
+						Action: "TEST",
+						Resources: []mongodbatlas.Resource{
+							{
+								Collection: "test",
+								Db:         "test",
+							},
+						},
+					},
+					{
+						Action: "TEST2",
+						Resources: []mongodbatlas.Resource{
+							{
+								Collection: "test",
+								Db:         "test",
+							},
+						},
+					},
+				},
+			},
+			want: []mongodbatlas.Action{
+				{
+					Action: "TEST",
+					Resources: []mongodbatlas.Resource{
+						{
+							Collection: "test",
+							Db:         "test",
+						},
+					},
+				},
+				{
+					Action: "TEST2",
+					Resources: []mongodbatlas.Resource{
+						{
+							Collection: "test",
+							Db:         "test",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicates",
+			args: args{
+				newActions: []mongodbatlas.Action{
+					{
+						Action: "TEST",
+						Resources: []mongodbatlas.Resource{
+							{
+								Collection: "test",
+								Db:         "test",
+							},
+						},
+					},
+					{
+						Action: "TEST",
+						Resources: []mongodbatlas.Resource{
+							{
+								Collection: "test",
+								Db:         "test1",
+							},
+						},
+					},
+				},
+			},
+			want: []mongodbatlas.Action{
+				{
+					Action: "TEST",
+					Resources: []mongodbatlas.Resource{
+						{
+							Collection: "test",
+							Db:         "test1",
+						},
+						{
+							Collection: "test",
+							Db:         "test",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		args := tt.args
+		want := tt.want
+		t.Run(tt.name, func(t *testing.T) {
+			got := JoinAtlasActions(args.newActions)
+			assert.ElementsMatch(t, got, want)
+		})
+	}
+}
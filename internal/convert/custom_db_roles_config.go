@@ -0,0 +1,65 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"errors"
+	"fmt"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+// CustomDBRolesConfig is the document with the custom database roles of a project
+type CustomDBRolesConfig struct {
+	Roles []*CustomDBRoleConfig `yaml:"roles" json:"roles"`
+}
+
+// CustomDBRoleConfig is a custom database role, actions use the action[@dbName.collection] format
+// and inherited roles the roleName@dbName format.
+type CustomDBRoleConfig struct {
+	Name           string   `yaml:"name" json:"name"`
+	Actions        []string `yaml:"actions,omitempty" json:"actions,omitempty"`
+	InheritedRoles []string `yaml:"inheritedRoles,omitempty" json:"inheritedRoles,omitempty"`
+}
+
+// Validate checks every role has a name, actions or inherited roles and is listed once
+func (c *CustomDBRolesConfig) Validate() error {
+	if len(c.Roles) == 0 {
+		return errors.New("the file has no roles")
+	}
+	seen := map[string]bool{}
+	for i, r := range c.Roles {
+		if r.Name == "" {
+			return fmt.Errorf("role %d has no name", i+1)
+		}
+		if len(r.Actions) == 0 && len(r.InheritedRoles) == 0 {
+			return fmt.Errorf("role '%s' has neither actions nor inherited roles", r.Name)
+		}
+		if seen[r.Name] {
+			return fmt.Errorf("role '%s' is listed more than once", r.Name)
+		}
+		seen[r.Name] = true
+	}
+	return nil
+}
+
+// NewCustomDBRole returns the Atlas custom database role
+func (r *CustomDBRoleConfig) NewCustomDBRole() *atlas.CustomDBRole {
+	return &atlas.CustomDBRole{
+		RoleName:       r.Name,
+		Actions:        JoinAtlasActions(BuildAtlasActions(r.Actions)),
+		InheritedRoles: BuildAtlasInheritedRoles(r.InheritedRoles),
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package convert
+
+import (
+	"testing"
+)
+
+func TestCustomDBRolesConfig_Validate(t *testing.T) {
+	c := &CustomDBRolesConfig{Roles: []*CustomDBRoleConfig{{Name: "empty"}}}
+	if err := c.Validate(); err == nil {
+		t.Error("Validate() expected an error for a role without actions or inherited roles")
+	}
+	c.Roles = append(c.Roles, &CustomDBRoleConfig{Name: "empty", InheritedRoles: []string{"read@orders"}})
+	c.Roles[0].Actions = []string{"FIND@orders.items"}
+	if err := c.Validate(); err == nil {
+		t.Error("Validate() expected an error for a role listed twice")
+	}
+}
+
+func TestCustomDBRoleConfig_NewCustomDBRole(t *testing.T) {
+	r := &CustomDBRoleConfig{Name: "reader", Actions: []string{"FIND@orders.items", "FIND@orders.carts"}, InheritedRoles: []string{"read@orders"}}
+	got := r.NewCustomDBRole()
+	if got.RoleName != "reader" || len(got.Actions) != 1 || len(got.Actions[0].Resources) != 2 || len(got.InheritedRoles) != 1 {
+		t.Errorf("NewCustomDBRole() = %+v", got)
+	}
+}
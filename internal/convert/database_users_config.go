@@ -0,0 +1,143 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const noneAuthType = "NONE"
+
+// DatabaseUsersConfig is the document with the database users of a project,
+// roles use the roleName@dbName[.collection] format and scopes the resourceName:resourceType format.
+type DatabaseUsersConfig struct {
+	Users []*DatabaseUserConfig `yaml:"users" json:"users"`
+}
+
+// DatabaseUserConfig is a database user of a project, a password set to ${VARIABLE} is read from that environment variable.
+// Scopes and the external authentication types are Atlas only, mechanisms are Ops Manager only.
+type DatabaseUserConfig struct {
+	Username     string   `yaml:"username" json:"username"`
+	Database     string   `yaml:"database,omitempty" json:"database,omitempty"`
+	Password     string   `yaml:"password,omitempty" json:"password,omitempty"`
+	Roles        []string `yaml:"roles" json:"roles"`
+	Scopes       []string `yaml:"scopes,omitempty" json:"scopes,omitempty"`
+	X509Type     string   `yaml:"x509Type,omitempty" json:"x509Type,omitempty"`
+	LDAPAuthType string   `yaml:"ldapAuthType,omitempty" json:"ldapAuthType,omitempty"`
+	AWSIAMType   string   `yaml:"awsIAMType,omitempty" json:"awsIAMType,omitempty"`
+	Mechanisms   []string `yaml:"mechanisms,omitempty" json:"mechanisms,omitempty"`
+}
+
+// External reports whether the user authenticates with X.509, LDAP or AWS IAM rather than a password
+func (u *DatabaseUserConfig) External() bool {
+	for _, t := range []string{u.X509Type, u.LDAPAuthType, u.AWSIAMType} {
+		if t != "" && t != noneAuthType {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthDB returns the authentication database of the user, $external for external users and admin by default
+func (u *DatabaseUserConfig) AuthDB() string {
+	switch {
+	case u.Database != "":
+		return u.Database
+	case u.External():
+		return ExternalAuthDB
+	}
+	return AdminDB
+}
+
+// Validate checks every user has a username and is listed once per authentication database
+func (c *DatabaseUsersConfig) Validate() error {
+	if len(c.Users) == 0 {
+		return errors.New("the file has no users")
+	}
+	seen := map[string]bool{}
+	for i, u := range c.Users {
+		if u.Username == "" {
+			return fmt.Errorf("user %d has no username", i+1)
+		}
+		key := u.AuthDB() + "/" + u.Username
+		if seen[key] {
+			return fmt.Errorf("user '%s' of '%s' is listed more than once", u.Username, u.AuthDB())
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// ExpandPasswords replaces the passwords referencing an environment variable with its value
+func (c *DatabaseUsersConfig) ExpandPasswords() error {
+	env := new(EnvExpander)
+	for _, u := range c.Users {
+		u.Password = env.Expand(u.Password)
+	}
+	return env.Err()
+}
+
+// NewAtlasDatabaseUser returns the Atlas user of the project
+func (u *DatabaseUserConfig) NewAtlasDatabaseUser(projectID string) *atlas.DatabaseUser {
+	return &atlas.DatabaseUser{
+		GroupID:      projectID,
+		Username:     u.Username,
+		DatabaseName: u.AuthDB(),
+		Password:     u.Password,
+		Roles:        BuildAtlasRoles(u.Roles),
+		Scopes:       BuildAtlasScopes(u.Scopes),
+		X509Type:     u.X509Type,
+		LDAPAuthType: u.LDAPAuthType,
+		AWSIAMType:   u.AWSIAMType,
+	}
+}
+
+// AtlasRoleNames returns the roles in the roleName@dbName[.collection] format, sorted
+func AtlasRoleNames(roles []atlas.Role) []string {
+	names := make([]string, len(roles))
+	for i, r := range roles {
+		names[i] = r.RoleName + roleSep + r.DatabaseName
+		if r.CollectionName != "" {
+			names[i] += collectionSep + r.CollectionName
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AtlasScopeNames returns the scopes in the resourceName:resourceType format, sorted
+func AtlasScopeNames(scopes []atlas.Scope) []string {
+	names := make([]string, len(scopes))
+	for i, s := range scopes {
+		names[i] = s.Name + scopeSep + s.Type
+	}
+	sort.Strings(names)
+	return names
+}
+
+// OMRoleNames returns the roles in the roleName@dbName format, sorted
+func OMRoleNames(roles []*opsmngr.Role) []string {
+	names := make([]string, len(roles))
+	for i, r := range roles {
+		names[i] = r.Role + roleSep + r.Database
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package convert
+
+import (
+	"os"
+	"testing"
+)
+
+func TestDatabaseUsersConfig_Validate(t *testing.T) {
+	c := &DatabaseUsersConfig{Users: []*DatabaseUserConfig{
+		{Username: "app"},
+		{Username: "app", Database: "admin"},
+	}}
+	if err := c.Validate(); err == nil {
+		t.Error("Validate() expected an error for a user listed twice")
+	}
+	c.Users[1].X509Type = "MANAGED"
+	c.Users[1].Database = ""
+	if err := c.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if got := c.Users[1].AuthDB(); got != ExternalAuthDB {
+		t.Errorf("AuthDB() got %s, want %s", got, ExternalAuthDB)
+	}
+}
+
+func TestDatabaseUsersConfig_ExpandPasswords(t *testing.T) {
+	_ = os.Setenv("TEST_DB_PASSWORD", "secret")
+	defer os.Unsetenv("TEST_DB_PASSWORD")
+
+	c := &DatabaseUsersConfig{Users: []*DatabaseUserConfig{{Username: "app", Password: "${TEST_DB_PASSWORD}"}}}
+	if err := c.ExpandPasswords(); err != nil {
+		t.Fatalf("ExpandPasswords() unexpected error: %v", err)
+	}
+	if c.Users[0].Password != "secret" {
+		t.Errorf("got %s, want secret", c.Users[0].Password)
+	}
+
+	c.Users[0].Password = "${TEST_DB_PASSWORD_UNSET}"
+	if err := c.ExpandPasswords(); err == nil {
+		t.Error("ExpandPasswords() expected an error for an unset variable")
+	}
+}
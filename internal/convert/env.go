@@ -0,0 +1,51 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var envReferenceRe = regexp.MustCompile(`^\$\{([A-Za-z_][A-Za-z0-9_]*)\}$`)
+
+// EnvExpander reads the secrets of a document from the environment variables they reference as ${VARIABLE}
+type EnvExpander struct {
+	missing []string
+}
+
+// Expand returns the value of the environment variable when the whole value is a ${VARIABLE} reference,
+// other values, like pa$$word, are kept as they are
+func (e *EnvExpander) Expand(value string) string {
+	m := envReferenceRe.FindStringSubmatch(value)
+	if m == nil {
+		return value
+	}
+	v, ok := os.LookupEnv(m[1])
+	if !ok {
+		e.missing = append(e.missing, m[1])
+	}
+	return v
+}
+
+// Err returns an error with the referenced environment variables that aren't set
+func (e *EnvExpander) Err() error {
+	if len(e.missing) > 0 {
+		return fmt.Errorf("environment variables not set: %s", strings.Join(e.missing, ", "))
+	}
+	return nil
+}
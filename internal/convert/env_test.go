@@ -0,0 +1,54 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package convert
+
+import (
+	"os"
+	"testing"
+)
+
+func TestEnvExpander_Expand(t *testing.T) {
+	_ = os.Setenv("TEST_ENV_SECRET", "secret")
+	defer os.Unsetenv("TEST_ENV_SECRET")
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "${TEST_ENV_SECRET}", want: "secret"},
+		{value: "pa$$word", want: "pa$$word"},
+		{value: "$TEST_ENV_SECRET", want: "$TEST_ENV_SECRET"},
+		{value: "my${TEST_ENV_SECRET}", want: "my${TEST_ENV_SECRET}"},
+		{value: "", want: ""},
+	}
+	e := new(EnvExpander)
+	for _, tt := range tests {
+		if got := e.Expand(tt.value); got != tt.want {
+			t.Errorf("Expand(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+	if err := e.Err(); err != nil {
+		t.Errorf("Err() unexpected error: %v", err)
+	}
+
+	if got := e.Expand("${TEST_ENV_SECRET_UNSET}"); got != "" {
+		t.Errorf("Expand() = %q, want an empty value", got)
+	}
+	if err := e.Err(); err == nil {
+		t.Error("Err() expected an error for an unset variable")
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func (s *Server) listAccessLists(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.project(w, params); !ok {
+		return
+	}
+	results := s.sortedAccessLists(params["groupID"])
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
+
+func (s *Server) sortedAccessLists(projectID string) []*atlas.ProjectIPAccessList {
+	results := make([]*atlas.ProjectIPAccessList, 0, len(s.accessLists[projectID]))
+	for _, e := range s.accessLists[projectID] {
+		results = append(results, e)
+	}
+	sort.Slice(results, func(i, j int) bool { return accessListKey(results[i]) < accessListKey(results[j]) })
+	return results
+}
+
+// createAccessLists adds or replaces entries, the endpoint accepts a list
+// and replies with the whole access list of the project.
+func (s *Server) createAccessLists(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.project(w, params)
+	if !ok {
+		return
+	}
+	var entries []*atlas.ProjectIPAccessList
+	if !decode(w, r, &entries) {
+		return
+	}
+	for _, e := range entries {
+		if accessListKey(e) == "" {
+			writeError(w, http.StatusBadRequest, "INVALID_IP_ADDRESS_OR_CIDR_NOTATION", "An IP address, CIDR block or AWS security group must be specified.")
+			return
+		}
+	}
+	if s.accessLists[p.ID] == nil {
+		s.accessLists[p.ID] = map[string]*atlas.ProjectIPAccessList{}
+	}
+	for _, e := range entries {
+		if e.IPAddress != "" && e.CIDRBlock == "" {
+			e.CIDRBlock = e.IPAddress + "/32"
+		}
+		e.GroupID = p.ID
+		s.accessLists[p.ID][accessListKey(e)] = e
+		s.addEvent(p.OrgID, p.ID, "NETWORK_PERMISSION_ENTRY_ADDED")
+	}
+	results := s.sortedAccessLists(p.ID)
+	writeJSON(w, http.StatusCreated, paginated(results, len(results)))
+}
+
+func (s *Server) getAccessList(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	e, ok := s.accessList(w, params)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *Server) deleteAccessList(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	e, ok := s.accessList(w, params)
+	if !ok {
+		return
+	}
+	delete(s.accessLists[params["groupID"]], accessListKey(e))
+	s.addEvent(s.projects[params["groupID"]].OrgID, params["groupID"], "NETWORK_PERMISSION_ENTRY_REMOVED")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// accessList returns the entry of the request path or writes a not found error,
+// an IP address entry can be looked up by its address or its /32 block.
+func (s *Server) accessList(w http.ResponseWriter, params map[string]string) (*atlas.ProjectIPAccessList, bool) {
+	if _, ok := s.project(w, params); !ok {
+		return nil, false
+	}
+	for _, e := range s.accessLists[params["groupID"]] {
+		if e.IPAddress == params["entry"] || e.CIDRBlock == params["entry"] || e.AwsSecurityGroup == params["entry"] {
+			return e, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "ATLAS_NETWORK_PERMISSION_ENTRY_NOT_FOUND", fmt.Sprintf("IP Address %s not on Atlas access list for group %s.", params["entry"], params["groupID"]))
+	return nil, false
+}
+
+func accessListKey(e *atlas.ProjectIPAccessList) string {
+	switch {
+	case e.AwsSecurityGroup != "":
+		return e.AwsSecurityGroup
+	case e.CIDRBlock != "":
+		return e.CIDRBlock
+	case e.IPAddress != "":
+		return e.IPAddress + "/32"
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+// AddAlert seeds an alert for the given project, the fake has no monitoring to raise them.
+func (s *Server) AddAlert(projectID string, a *atlas.Alert) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if a.ID == "" {
+		a.ID = newID()
+	}
+	if a.Status == "" {
+		a.Status = "OPEN"
+	}
+	if a.Created == "" {
+		a.Created = now()
+	}
+	a.GroupID = projectID
+	a.Updated = a.Created
+	if s.alerts[projectID] == nil {
+		s.alerts[projectID] = map[string]*atlas.Alert{}
+	}
+	s.alerts[projectID][a.ID] = a
+}
+
+func (s *Server) listAlerts(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if _, ok := s.project(w, params); !ok {
+		return
+	}
+	status := r.URL.Query().Get("status")
+	results := make([]*atlas.Alert, 0, len(s.alerts[params["groupID"]]))
+	for _, a := range s.alerts[params["groupID"]] {
+		if status == "" || a.Status == status {
+			results = append(results, a)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Created > results[j].Created })
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
+
+func (s *Server) getAlert(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	a, ok := s.alert(w, params)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+// acknowledgeAlert acknowledges or, with an empty acknowledgedUntil, unacknowledges an alert.
+func (s *Server) acknowledgeAlert(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	a, ok := s.alert(w, params)
+	if !ok {
+		return
+	}
+	req := new(atlas.AcknowledgeRequest)
+	if !decode(w, r, req) {
+		return
+	}
+	a.AcknowledgedUntil = ""
+	if req.AcknowledgedUntil != nil {
+		a.AcknowledgedUntil = *req.AcknowledgedUntil
+	}
+	a.AcknowledgementComment = req.AcknowledgementComment
+	a.AcknowledgingUsername = s.publicKey
+	a.Updated = now()
+	s.addEvent(s.projects[params["groupID"]].OrgID, params["groupID"], "ALERT_ACKNOWLEDGED_AUDIT")
+	writeJSON(w, http.StatusOK, a)
+}
+
+// alert returns the alert of the request path or writes a not found error.
+func (s *Server) alert(w http.ResponseWriter, params map[string]string) (*atlas.Alert, bool) {
+	if _, ok := s.project(w, params); !ok {
+		return nil, false
+	}
+	a, ok := s.alerts[params["groupID"]][params["alertID"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ALERT_NOT_FOUND", fmt.Sprintf("No alert with ID %s exists in group %s.", params["alertID"], params["groupID"]))
+	}
+	return a, ok
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeapi
+
+import (
+	"crypto/md5" //nolint:gosec // digest authentication requires MD5
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const realm = "MMS Public API"
+
+// authenticate validates the digest credentials of the request,
+// otherwise it replies with a challenge and returns false.
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Digest ") {
+		s.challenge(w)
+		return false
+	}
+	params := parseDigest(strings.TrimPrefix(auth, "Digest "))
+
+	s.mu.Lock()
+	validNonce := s.nonces[params["nonce"]]
+	s.mu.Unlock()
+
+	if !validNonce || params["username"] != s.publicKey || params["response"] != s.expectedResponse(r.Method, params) {
+		s.challenge(w)
+		return false
+	}
+	return true
+}
+
+func (s *Server) challenge(w http.ResponseWriter) {
+	nonce := newID()
+	s.mu.Lock()
+	s.nonces[nonce] = true
+	s.mu.Unlock()
+
+	w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Digest realm="%s", domain="", nonce="%s", algorithm=MD5, qop="auth", stale=false`, realm, nonce))
+	writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "You are not authorized for this resource.")
+}
+
+func (s *Server) expectedResponse(method string, params map[string]string) string {
+	ha1 := md5Hex(fmt.Sprintf("%s:%s:%s", s.publicKey, realm, s.privateKey))
+	ha2 := md5Hex(fmt.Sprintf("%s:%s", method, params["uri"]))
+	if params["qop"] == "" {
+		return md5Hex(fmt.Sprintf("%s:%s:%s", ha1, params["nonce"], ha2))
+	}
+	return md5Hex(fmt.Sprintf("%s:%s:%s:%s:%s:%s", ha1, params["nonce"], params["nc"], params["cnonce"], params["qop"], ha2))
+}
+
+// parseDigest parses the comma separated key=value pairs of a digest Authorization header.
+func parseDigest(v string) map[string]string {
+	params := map[string]string{}
+	for _, pair := range strings.Split(v, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		params[kv[0]] = strings.Trim(kv[1], `"`)
+	}
+	return params
+}
+
+func md5Hex(s string) string {
+	h := md5.Sum([]byte(s)) //nolint:gosec // digest authentication requires MD5
+	return hex.EncodeToString(h[:])
+}
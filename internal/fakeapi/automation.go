@@ -0,0 +1,204 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	replicaSetType = "REPLICA_SET"
+	shardedType    = "SHARDED_REPLICA_SET"
+)
+
+func newAutomationConfig() *opsmngr.AutomationConfig {
+	return &opsmngr.AutomationConfig{
+		Auth: opsmngr.Auth{
+			AutoAuthMechanism: "MONGODB-CR",
+			Disabled:          true,
+			UsersDelete:       []*opsmngr.MongoDBUser{},
+			Users:             []*opsmngr.MongoDBUser{},
+		},
+		Processes:   []*opsmngr.Process{},
+		ReplicaSets: []*opsmngr.ReplicaSet{},
+		Sharding:    []*opsmngr.ShardingConfig{},
+		Version:     1,
+	}
+}
+
+func (s *Server) getAutomationConfig(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.project(w, params); !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.automationConfigs[params["groupID"]])
+}
+
+func (s *Server) updateAutomationConfig(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.project(w, params)
+	if !ok {
+		return
+	}
+	ac := new(opsmngr.AutomationConfig)
+	if !decode(w, r, ac) {
+		return
+	}
+	current := s.automationConfigs[p.ID]
+	if ac.Version != current.Version {
+		writeError(w, http.StatusConflict, "CONFLICTING_AUTOMATION_CONFIG", "Another session or user has already published changes.")
+		return
+	}
+	ac.Version = current.Version + 1
+	s.automationConfigs[p.ID] = ac
+	s.addEvent(p.OrgID, p.ID, "AUTOMATION_CONFIG_PUBLISHED_AUDIT")
+	w.WriteHeader(http.StatusOK)
+}
+
+// getAutomationStatus reports every process at the goal version,
+// the fake applies changes as soon as they are published.
+func (s *Server) getAutomationStatus(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.project(w, params); !ok {
+		return
+	}
+	ac := s.automationConfigs[params["groupID"]]
+	status := &opsmngr.AutomationStatus{
+		GoalVersion: ac.Version,
+		Processes:   make([]opsmngr.ProcessStatus, 0, len(ac.Processes)),
+	}
+	for _, p := range ac.Processes {
+		status.Processes = append(status.Processes, opsmngr.ProcessStatus{
+			Plan:                    []string{},
+			LastGoalVersionAchieved: ac.Version,
+			Name:                    p.Name,
+			Hostname:                p.Hostname,
+		})
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+// clusters derives the clusters of a project from its automation config,
+// a replica set that is part of a sharded cluster is not listed on its own.
+func (s *Server) clusters(projectID string) []*opsmngr.Cluster {
+	ac := s.automationConfigs[projectID]
+	shardRS := map[string]bool{}
+	results := make([]*opsmngr.Cluster, 0, len(ac.ReplicaSets)+len(ac.Sharding))
+	for _, sc := range ac.Sharding {
+		shardRS[sc.ConfigServerReplica] = true
+		for _, sh := range sc.Shards {
+			shardRS[sh.RS] = true
+		}
+		results = append(results, &opsmngr.Cluster{
+			ClusterName: sc.Name,
+			GroupID:     projectID,
+			ID:          clusterID(projectID, sc.Name),
+			TypeName:    shardedType,
+		})
+	}
+	for _, rs := range ac.ReplicaSets {
+		if shardRS[rs.ID] {
+			continue
+		}
+		results = append(results, &opsmngr.Cluster{
+			ClusterName:    rs.ID,
+			GroupID:        projectID,
+			ID:             clusterID(projectID, rs.ID),
+			ReplicaSetName: rs.ID,
+			TypeName:       replicaSetType,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].ClusterName < results[j].ClusterName })
+	return results
+}
+
+// clusterID returns a stable identifier so clusters keep their ID between config changes.
+func clusterID(projectID, name string) string {
+	return md5Hex(projectID + "/" + name)[:24]
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.project(w, params); !ok {
+		return
+	}
+	results := s.clusters(params["groupID"])
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.project(w, params); !ok {
+		return
+	}
+	for _, c := range s.clusters(params["groupID"]) {
+		if c.ID == params["clusterID"] {
+			writeJSON(w, http.StatusOK, c)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "CLUSTER_NOT_FOUND", fmt.Sprintf("No cluster with ID %s exists in group %s.", params["clusterID"], params["groupID"]))
+}
+
+func (s *Server) listAllClusters(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	projects := s.sortedProjects("")
+	result := &opsmngr.AllClustersProjects{
+		Results:    make([]*opsmngr.AllClustersProject, 0, len(projects)),
+		TotalCount: len(projects),
+	}
+	for _, p := range projects {
+		ac := s.automationConfigs[p.ID]
+		entry := &opsmngr.AllClustersProject{
+			GroupName: p.Name,
+			OrgName:   s.orgs[p.OrgID].Name,
+			GroupID:   p.ID,
+			OrgID:     p.OrgID,
+			Tags:      []string{},
+			Clusters:  []opsmngr.AllClustersCluster{},
+		}
+		for _, c := range s.clusters(p.ID) {
+			versions, nodes := processVersions(ac, c)
+			entry.Clusters = append(entry.Clusters, opsmngr.AllClustersCluster{
+				ClusterID:    c.ID,
+				Name:         c.ClusterName,
+				Type:         c.TypeName,
+				Availability: "available",
+				Versions:     versions,
+				AuthEnabled:  !ac.Auth.Disabled,
+				NodeCount:    nodes,
+			})
+		}
+		result.Results = append(result.Results, entry)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// processVersions returns the distinct MongoDB versions and the number of processes of a cluster.
+func processVersions(ac *opsmngr.AutomationConfig, c *opsmngr.Cluster) ([]string, int64) {
+	seen := map[string]bool{}
+	versions := []string{}
+	var nodes int64
+	for _, p := range ac.Processes {
+		if p.Args26.Replication != nil && p.Args26.Replication.ReplSetName == c.ReplicaSetName && c.ReplicaSetName != "" ||
+			c.TypeName == shardedType && p.Cluster == c.ClusterName {
+			nodes++
+			if !seen[p.Version] {
+				seen[p.Version] = true
+				versions = append(versions, p.Version)
+			}
+		}
+	}
+	sort.Strings(versions)
+	return versions, nodes
+}
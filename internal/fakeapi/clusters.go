@@ -0,0 +1,124 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	defaultMongoDBMajorVersion = "4.4"
+	idle                       = "IDLE"
+)
+
+func (s *Server) listAtlasClusters(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.project(w, params); !ok {
+		return
+	}
+	results := make([]*atlas.Cluster, 0, len(s.atlasClusters[params["groupID"]]))
+	for _, c := range s.atlasClusters[params["groupID"]] {
+		results = append(results, c)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
+
+// createAtlasCluster stores the cluster as IDLE,
+// the fake has no provisioning so watchers return straight away.
+func (s *Server) createAtlasCluster(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.project(w, params)
+	if !ok {
+		return
+	}
+	c := new(atlas.Cluster)
+	if !decode(w, r, c) {
+		return
+	}
+	if c.Name == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attribute name was not specified.")
+		return
+	}
+	if _, exists := s.atlasClusters[p.ID][c.Name]; exists {
+		writeError(w, http.StatusConflict, "DUPLICATE_CLUSTER_NAME", fmt.Sprintf("A cluster named %s is already present in group %s.", c.Name, p.ID))
+		return
+	}
+	if s.atlasClusters[p.ID] == nil {
+		s.atlasClusters[p.ID] = map[string]*atlas.Cluster{}
+	}
+	c.ID = newID()
+	c.GroupID = p.ID
+	if c.MongoDBMajorVersion == "" {
+		c.MongoDBMajorVersion = defaultMongoDBMajorVersion
+	}
+	c.MongoDBVersion = c.MongoDBMajorVersion + ".0"
+	c.SrvAddress = fmt.Sprintf("mongodb+srv://%s.fake.mongodb.net", c.Name)
+	c.MongoURI = fmt.Sprintf("mongodb://%s-shard-00-00.fake.mongodb.net:27017", c.Name)
+	c.ConnectionStrings = &atlas.ConnectionStrings{
+		Standard:    c.MongoURI,
+		StandardSrv: c.SrvAddress,
+	}
+	c.StateName = idle
+	s.atlasClusters[p.ID][c.Name] = c
+	s.addEvent(p.OrgID, p.ID, "CLUSTER_CREATED")
+	writeJSON(w, http.StatusCreated, c)
+}
+
+func (s *Server) getAtlasCluster(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	c, ok := s.atlasCluster(w, params)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+// updateAtlasCluster merges the request over the stored cluster, the same way a PATCH does.
+func (s *Server) updateAtlasCluster(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	c, ok := s.atlasCluster(w, params)
+	if !ok {
+		return
+	}
+	if !decode(w, r, c) {
+		return
+	}
+	c.Name = params["name"]
+	c.StateName = idle
+	s.addEvent(s.projects[params["groupID"]].OrgID, params["groupID"], "CLUSTER_UPDATE_SUBMITTED")
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) deleteAtlasCluster(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.atlasCluster(w, params); !ok {
+		return
+	}
+	delete(s.atlasClusters[params["groupID"]], params["name"])
+	s.addEvent(s.projects[params["groupID"]].OrgID, params["groupID"], "CLUSTER_DELETE_SUBMITTED")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// atlasCluster returns the cluster of the request path or writes a not found error.
+func (s *Server) atlasCluster(w http.ResponseWriter, params map[string]string) (*atlas.Cluster, bool) {
+	if _, ok := s.project(w, params); !ok {
+		return nil, false
+	}
+	c, ok := s.atlasClusters[params["groupID"]][params["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "CLUSTER_NOT_FOUND", fmt.Sprintf("No cluster named %s exists in group %s.", params["name"], params["groupID"]))
+	}
+	return c, ok
+}
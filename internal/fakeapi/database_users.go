@@ -0,0 +1,116 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func (s *Server) listDatabaseUsers(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.project(w, params); !ok {
+		return
+	}
+	results := make([]*atlas.DatabaseUser, 0, len(s.databaseUsers[params["groupID"]]))
+	for _, u := range s.databaseUsers[params["groupID"]] {
+		results = append(results, u)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Username < results[j].Username })
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
+
+// createDatabaseUser stores the user, passwords are never returned the same as the API.
+func (s *Server) createDatabaseUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.project(w, params)
+	if !ok {
+		return
+	}
+	u := new(atlas.DatabaseUser)
+	if !decode(w, r, u) {
+		return
+	}
+	if u.Username == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attribute username was not specified.")
+		return
+	}
+	u.DatabaseName = u.GetAuthDB()
+	key := userKey(u.DatabaseName, u.Username)
+	if _, exists := s.databaseUsers[p.ID][key]; exists {
+		writeError(w, http.StatusConflict, "USER_ALREADY_EXISTS", fmt.Sprintf("The specified user %s already exists.", u.Username))
+		return
+	}
+	if s.databaseUsers[p.ID] == nil {
+		s.databaseUsers[p.ID] = map[string]*atlas.DatabaseUser{}
+	}
+	u.GroupID = p.ID
+	u.Password = ""
+	if u.Scopes == nil {
+		u.Scopes = []atlas.Scope{}
+	}
+	s.databaseUsers[p.ID][key] = u
+	s.addEvent(p.OrgID, p.ID, "MONGODB_USER_ADDED")
+	writeJSON(w, http.StatusCreated, u)
+}
+
+func (s *Server) getDatabaseUser(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	u, ok := s.databaseUser(w, params)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, u)
+}
+
+func (s *Server) updateDatabaseUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	u, ok := s.databaseUser(w, params)
+	if !ok {
+		return
+	}
+	if !decode(w, r, u) {
+		return
+	}
+	u.Username = params["username"]
+	u.DatabaseName = params["authDB"]
+	u.Password = ""
+	s.addEvent(s.projects[params["groupID"]].OrgID, params["groupID"], "MONGODB_USER_UPDATED")
+	writeJSON(w, http.StatusOK, u)
+}
+
+func (s *Server) deleteDatabaseUser(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.databaseUser(w, params); !ok {
+		return
+	}
+	delete(s.databaseUsers[params["groupID"]], userKey(params["authDB"], params["username"]))
+	s.addEvent(s.projects[params["groupID"]].OrgID, params["groupID"], "MONGODB_USER_DELETED")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// databaseUser returns the user of the request path or writes a not found error.
+func (s *Server) databaseUser(w http.ResponseWriter, params map[string]string) (*atlas.DatabaseUser, bool) {
+	if _, ok := s.project(w, params); !ok {
+		return nil, false
+	}
+	u, ok := s.databaseUsers[params["groupID"]][userKey(params["authDB"], params["username"])]
+	if !ok {
+		writeError(w, http.StatusNotFound, "USER_NOT_FOUND", fmt.Sprintf("No user with username %s exists.", params["username"]))
+	}
+	return u, ok
+}
+
+func userKey(authDB, username string) string {
+	return authDB + "/" + username
+}
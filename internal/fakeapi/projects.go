@@ -0,0 +1,174 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+func (s *Server) listOrgs(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	results := make([]*atlas.Organization, 0, len(s.orgs))
+	for _, o := range s.orgs {
+		results = append(results, o)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
+
+func (s *Server) getOrg(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	o, ok := s.orgs[params["orgID"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ORG_NOT_FOUND", fmt.Sprintf("No organization with ID %s exists.", params["orgID"]))
+		return
+	}
+	writeJSON(w, http.StatusOK, o)
+}
+
+func (s *Server) listOrgProjects(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.orgs[params["orgID"]]; !ok {
+		writeError(w, http.StatusNotFound, "ORG_NOT_FOUND", fmt.Sprintf("No organization with ID %s exists.", params["orgID"]))
+		return
+	}
+	results := s.sortedProjects(params["orgID"])
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	results := s.sortedProjects("")
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
+
+func (s *Server) sortedProjects(orgID string) []*opsmngr.Project {
+	results := make([]*opsmngr.Project, 0, len(s.projects))
+	for _, p := range s.projects {
+		if orgID == "" || p.OrgID == orgID {
+			results = append(results, p)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	return results
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	p := new(opsmngr.Project)
+	if !decode(w, r, p) {
+		return
+	}
+	if p.Name == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attribute name was not specified.")
+		return
+	}
+	for _, existing := range s.projects {
+		if existing.Name == p.Name {
+			writeError(w, http.StatusConflict, "GROUP_ALREADY_EXISTS", fmt.Sprintf("A group with name %s already exists.", p.Name))
+			return
+		}
+	}
+	if p.OrgID == "" {
+		p.OrgID = s.DefaultOrgID
+	}
+	if _, ok := s.orgs[p.OrgID]; !ok {
+		writeError(w, http.StatusNotFound, "ORG_NOT_FOUND", fmt.Sprintf("No organization with ID %s exists.", p.OrgID))
+		return
+	}
+	p.ID = newID()
+	p.AgentAPIKey = newID()
+	p.HostCounts = &opsmngr.HostCount{}
+	s.projects[p.ID] = p
+	s.automationConfigs[p.ID] = newAutomationConfig()
+	s.addEvent(p.OrgID, p.ID, "GROUP_CREATED")
+	writeJSON(w, http.StatusCreated, p)
+}
+
+func (s *Server) getProjectByName(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	for _, p := range s.projects {
+		if p.Name == params["name"] {
+			writeJSON(w, http.StatusOK, p)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "GROUP_NAME_NOT_FOUND", fmt.Sprintf("No group with name %s exists.", params["name"]))
+}
+
+func (s *Server) getProject(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.project(w, params)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.project(w, params)
+	if !ok {
+		return
+	}
+	delete(s.projects, p.ID)
+	delete(s.automationConfigs, p.ID)
+	delete(s.atlasClusters, p.ID)
+	delete(s.databaseUsers, p.ID)
+	delete(s.accessLists, p.ID)
+	delete(s.alerts, p.ID)
+	s.addEvent(p.OrgID, "", "GROUP_DELETED")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// project returns the project of the request path or writes a not found error.
+func (s *Server) project(w http.ResponseWriter, params map[string]string) (*opsmngr.Project, bool) {
+	p, ok := s.projects[params["groupID"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "GROUP_NOT_FOUND", fmt.Sprintf("No group with ID %s exists.", params["groupID"]))
+	}
+	return p, ok
+}
+
+// addEvent records an event, mutations of the fake generate events the same way the API does.
+func (s *Server) addEvent(orgID, projectID, eventType string) {
+	s.events = append(s.events, &atlas.Event{
+		ID:            newID(),
+		Created:       now(),
+		EventTypeName: eventType,
+		GroupID:       projectID,
+		OrgID:         orgID,
+	})
+}
+
+func (s *Server) listProjectEvents(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.project(w, params); !ok {
+		return
+	}
+	results := make([]*atlas.Event, 0)
+	for i := len(s.events) - 1; i >= 0; i-- {
+		if s.events[i].GroupID == params["groupID"] {
+			results = append(results, s.events[i])
+		}
+	}
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
+
+func (s *Server) listOrgEvents(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	results := make([]*atlas.Event, 0)
+	for i := len(s.events) - 1; i >= 0; i-- {
+		if s.events[i].OrgID == params["orgID"] {
+			results = append(results, s.events[i])
+		}
+	}
+	writeJSON(w, http.StatusOK, paginated(results, len(results)))
+}
@@ -0,0 +1,232 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fakeapi provides a stateful, in-memory fake of the public
+// Atlas, Cloud Manager and Ops Manager APIs used by the store package.
+//
+// It is meant for tests that run the real binary offline, for example:
+//
+//	srv := fakeapi.New(publicKey, privateKey)
+//	ts := httptest.NewServer(srv)
+//	// mongocli --service ops-manager with ops_manager_url=ts.URL + "/"
+package fakeapi
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	atlasPrefix  = "/api/atlas/v1.0"
+	publicPrefix = "/api/public/v1.0"
+)
+
+// Server is an http.Handler faking the public APIs, all state is kept in memory.
+type Server struct {
+	mu         sync.Mutex
+	publicKey  string
+	privateKey string
+	nonces     map[string]bool
+	routes     []route
+
+	orgs              map[string]*atlas.Organization
+	projects          map[string]*opsmngr.Project
+	automationConfigs map[string]*opsmngr.AutomationConfig
+	atlasClusters     map[string]map[string]*atlas.Cluster
+	databaseUsers     map[string]map[string]*atlas.DatabaseUser
+	accessLists       map[string]map[string]*atlas.ProjectIPAccessList
+	alerts            map[string]map[string]*atlas.Alert
+	events            []*atlas.Event
+
+	// DefaultOrgID is the organization available when the server starts.
+	DefaultOrgID string
+}
+
+// New returns a fake API server accepting the given API keys with digest authentication.
+func New(publicKey, privateKey string) *Server {
+	s := &Server{
+		publicKey:         publicKey,
+		privateKey:        privateKey,
+		nonces:            map[string]bool{},
+		orgs:              map[string]*atlas.Organization{},
+		projects:          map[string]*opsmngr.Project{},
+		automationConfigs: map[string]*opsmngr.AutomationConfig{},
+		atlasClusters:     map[string]map[string]*atlas.Cluster{},
+		databaseUsers:     map[string]map[string]*atlas.DatabaseUser{},
+		accessLists:       map[string]map[string]*atlas.ProjectIPAccessList{},
+		alerts:            map[string]map[string]*atlas.Alert{},
+	}
+	s.DefaultOrgID = newID()
+	s.orgs[s.DefaultOrgID] = &atlas.Organization{ID: s.DefaultOrgID, Name: "default"}
+	s.registerRoutes()
+	return s
+}
+
+func (s *Server) registerRoutes() {
+	for _, prefix := range []string{atlasPrefix, publicPrefix} {
+		s.handle(prefix, http.MethodGet, "/orgs", s.listOrgs)
+		s.handle(prefix, http.MethodGet, "/orgs/{orgID}", s.getOrg)
+		s.handle(prefix, http.MethodGet, "/orgs/{orgID}/groups", s.listOrgProjects)
+		s.handle(prefix, http.MethodGet, "/orgs/{orgID}/events", s.listOrgEvents)
+		s.handle(prefix, http.MethodGet, "/groups", s.listProjects)
+		s.handle(prefix, http.MethodPost, "/groups", s.createProject)
+		s.handle(prefix, http.MethodGet, "/groups/byName/{name}", s.getProjectByName)
+		s.handle(prefix, http.MethodGet, "/groups/{groupID}", s.getProject)
+		s.handle(prefix, http.MethodDelete, "/groups/{groupID}", s.deleteProject)
+		s.handle(prefix, http.MethodGet, "/groups/{groupID}/alerts", s.listAlerts)
+		s.handle(prefix, http.MethodGet, "/groups/{groupID}/alerts/{alertID}", s.getAlert)
+		s.handle(prefix, http.MethodPatch, "/groups/{groupID}/alerts/{alertID}", s.acknowledgeAlert)
+		s.handle(prefix, http.MethodGet, "/groups/{groupID}/events", s.listProjectEvents)
+	}
+
+	s.handle(atlasPrefix, http.MethodGet, "/groups/{groupID}/clusters", s.listAtlasClusters)
+	s.handle(atlasPrefix, http.MethodPost, "/groups/{groupID}/clusters", s.createAtlasCluster)
+	s.handle(atlasPrefix, http.MethodGet, "/groups/{groupID}/clusters/{name}", s.getAtlasCluster)
+	s.handle(atlasPrefix, http.MethodPatch, "/groups/{groupID}/clusters/{name}", s.updateAtlasCluster)
+	s.handle(atlasPrefix, http.MethodDelete, "/groups/{groupID}/clusters/{name}", s.deleteAtlasCluster)
+	s.handle(atlasPrefix, http.MethodGet, "/groups/{groupID}/databaseUsers", s.listDatabaseUsers)
+	s.handle(atlasPrefix, http.MethodPost, "/groups/{groupID}/databaseUsers", s.createDatabaseUser)
+	s.handle(atlasPrefix, http.MethodGet, "/groups/{groupID}/databaseUsers/{authDB}/{username}", s.getDatabaseUser)
+	s.handle(atlasPrefix, http.MethodPatch, "/groups/{groupID}/databaseUsers/{authDB}/{username}", s.updateDatabaseUser)
+	s.handle(atlasPrefix, http.MethodDelete, "/groups/{groupID}/databaseUsers/{authDB}/{username}", s.deleteDatabaseUser)
+	s.handle(atlasPrefix, http.MethodGet, "/groups/{groupID}/accessList", s.listAccessLists)
+	s.handle(atlasPrefix, http.MethodPost, "/groups/{groupID}/accessList", s.createAccessLists)
+	s.handle(atlasPrefix, http.MethodGet, "/groups/{groupID}/accessList/{entry}", s.getAccessList)
+	s.handle(atlasPrefix, http.MethodDelete, "/groups/{groupID}/accessList/{entry}", s.deleteAccessList)
+
+	s.handle(publicPrefix, http.MethodGet, "/groups/{groupID}/automationConfig", s.getAutomationConfig)
+	s.handle(publicPrefix, http.MethodPut, "/groups/{groupID}/automationConfig", s.updateAutomationConfig)
+	s.handle(publicPrefix, http.MethodGet, "/groups/{groupID}/automationStatus", s.getAutomationStatus)
+	s.handle(publicPrefix, http.MethodGet, "/groups/{groupID}/clusters", s.listClusters)
+	s.handle(publicPrefix, http.MethodGet, "/groups/{groupID}/clusters/{clusterID}", s.getCluster)
+	s.handle(publicPrefix, http.MethodGet, "/clusters", s.listAllClusters)
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params map[string]string)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+func (s *Server) handle(prefix, method, pattern string, h handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(prefix+pattern, "/"), "/"),
+		handler:  h,
+	})
+}
+
+// match returns the path parameters if the route matches the escaped path segments.
+func (rt *route) match(method string, segments []string) (map[string]string, bool) {
+	if rt.method != method || len(rt.segments) != len(segments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, seg := range rt.segments {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			v, err := url.PathUnescape(segments[i])
+			if err != nil {
+				return nil, false
+			}
+			params[seg[1:len(seg)-1]] = v
+			continue
+		}
+		if seg != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authenticate(w, r) {
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+	pathFound := false
+	for i := range s.routes {
+		params, ok := s.routes[i].match(r.Method, segments)
+		if !ok {
+			if _, samePath := s.routes[i].match(s.routes[i].method, segments); samePath {
+				pathFound = true
+			}
+			continue
+		}
+		s.mu.Lock()
+		s.routes[i].handler(w, r, params)
+		s.mu.Unlock()
+		return
+	}
+	if pathFound {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", fmt.Sprintf("%s is not supported for %s", r.Method, r.URL.Path))
+		return
+	}
+	writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Cannot find resource %s", r.URL.Path))
+}
+
+// newID returns a random ObjectID like identifier.
+func newID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, detail string) {
+	writeJSON(w, status, map[string]interface{}{
+		"detail":    detail,
+		"error":     status,
+		"errorCode": code,
+		"reason":    http.StatusText(status),
+	})
+}
+
+// paginated wraps results the way list endpoints do.
+func paginated(results interface{}, total int) map[string]interface{} {
+	return map[string]interface{}{
+		"links":      []interface{}{},
+		"results":    results,
+		"totalCount": total,
+	}
+}
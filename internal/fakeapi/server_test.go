@@ -0,0 +1,189 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package fakeapi
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/store"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+const (
+	testPublicKey  = "public"
+	testPrivateKey = "private"
+)
+
+type testConfig struct {
+	service    string
+	url        string
+	privateKey string
+}
+
+func (c *testConfig) Service() string                        { return c.service }
+func (c *testConfig) PublicAPIKey() string                   { return testPublicKey }
+func (c *testConfig) PrivateAPIKey() string                  { return c.privateKey }
+func (c *testConfig) OpsManagerURL() string                  { return c.url + "/" }
+func (c *testConfig) OpsManagerCACertificate() string        { return "" }
+func (c *testConfig) OpsManagerSkipVerify() string           { return "" }
+func (c *testConfig) OpsManagerClientCertificate() string    { return "" }
+func (c *testConfig) OpsManagerClientCertificateKey() string { return "" }
+func (c *testConfig) OpsManagerVersionManifestURL() string   { return "" }
+func (c *testConfig) RequestTimeout() time.Duration          { return 0 }
+func (c *testConfig) HTTPProxy() string                      { return "" }
+func (c *testConfig) NoProxy() string                        { return "" }
+func (c *testConfig) Debug() bool                            { return false }
+func (c *testConfig) TraceFile() string                      { return "" }
+func (c *testConfig) RetryMaxAttempts() int                  { return 1 }
+func (c *testConfig) RetryNonIdempotent() bool               { return false }
+
+func newTestStore(t *testing.T, service string) (*Server, *store.Store) {
+	t.Helper()
+	srv := New(testPublicKey, testPrivateKey)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	s, err := store.New(&testConfig{service: service, url: ts.URL, privateKey: testPrivateKey})
+	if err != nil {
+		t.Fatalf("store.New() unexpected error: %v", err)
+	}
+	return srv, s
+}
+
+func TestServer_authentication(t *testing.T) {
+	srv := New(testPublicKey, testPrivateKey)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	s, err := store.New(&testConfig{service: config.OpsManagerService, url: ts.URL, privateKey: "wrong"})
+	if err != nil {
+		t.Fatalf("store.New() unexpected error: %v", err)
+	}
+	if _, err := s.Projects(nil); err == nil {
+		t.Fatal("Projects() expected an error with invalid keys")
+	}
+}
+
+func TestServer_opsManager(t *testing.T) {
+	srv, s := newTestStore(t, config.OpsManagerService)
+
+	p, err := s.CreateProject("test", "")
+	if err != nil {
+		t.Fatalf("CreateProject() unexpected error: %v", err)
+	}
+	project := p.(*opsmngr.Project)
+	if project.OrgID != srv.DefaultOrgID {
+		t.Errorf("OrgID = %s; want %s", project.OrgID, srv.DefaultOrgID)
+	}
+	if _, err = s.CreateProject("test", ""); err == nil {
+		t.Error("CreateProject() expected a conflict for a duplicated name")
+	}
+
+	ac, err := s.GetAutomationConfig(project.ID)
+	if err != nil {
+		t.Fatalf("GetAutomationConfig() unexpected error: %v", err)
+	}
+	ac.Processes = append(ac.Processes, &opsmngr.Process{
+		Name:     "myReplicaSet_1",
+		Hostname: "host0",
+		Version:  "4.4.4",
+		Args26:   opsmngr.Args26{Replication: &opsmngr.Replication{ReplSetName: "myReplicaSet"}},
+	})
+	ac.ReplicaSets = append(ac.ReplicaSets, &opsmngr.ReplicaSet{ID: "myReplicaSet"})
+	if err = s.UpdateAutomationConfig(project.ID, ac); err != nil {
+		t.Fatalf("UpdateAutomationConfig() unexpected error: %v", err)
+	}
+	if err = s.UpdateAutomationConfig(project.ID, ac); err == nil {
+		t.Error("UpdateAutomationConfig() expected a conflict for a stale version")
+	}
+
+	status, err := s.GetAutomationStatus(project.ID)
+	if err != nil {
+		t.Fatalf("GetAutomationStatus() unexpected error: %v", err)
+	}
+	if status.GoalVersion != 2 || len(status.Processes) != 1 || status.Processes[0].LastGoalVersionAchieved != 2 {
+		t.Errorf("GetAutomationStatus() = %+v; want one process at goal version 2", status)
+	}
+
+	all, err := s.ListAllProjectClusters()
+	if err != nil {
+		t.Fatalf("ListAllProjectClusters() unexpected error: %v", err)
+	}
+	if len(all.Results) != 1 || len(all.Results[0].Clusters) != 1 {
+		t.Fatalf("ListAllProjectClusters() = %+v; want one cluster", all)
+	}
+	if c := all.Results[0].Clusters[0]; c.Name != "myReplicaSet" || c.NodeCount != 1 || c.Type != replicaSetType {
+		t.Errorf("cluster = %+v; want myReplicaSet with one node", c)
+	}
+}
+
+func TestServer_atlas(t *testing.T) {
+	srv, s := newTestStore(t, config.CloudService)
+
+	p, err := s.CreateProject("test", "")
+	if err != nil {
+		t.Fatalf("CreateProject() unexpected error: %v", err)
+	}
+	projectID := p.(*atlas.Project).ID
+
+	if _, err = s.CreateCluster(&atlas.Cluster{GroupID: projectID, Name: "Cluster0"}); err != nil {
+		t.Fatalf("CreateCluster() unexpected error: %v", err)
+	}
+	c, err := s.AtlasCluster(projectID, "Cluster0")
+	if err != nil {
+		t.Fatalf("AtlasCluster() unexpected error: %v", err)
+	}
+	if c.StateName != idle {
+		t.Errorf("StateName = %s; want %s", c.StateName, idle)
+	}
+
+	if _, err = s.CreateProjectIPAccessList([]*atlas.ProjectIPAccessList{{GroupID: projectID, CIDRBlock: "10.0.0.0/24"}}); err != nil {
+		t.Fatalf("CreateProjectIPAccessList() unexpected error: %v", err)
+	}
+	if err = s.DeleteProjectIPAccessList(projectID, "10.0.0.0/24"); err != nil {
+		t.Fatalf("DeleteProjectIPAccessList() unexpected error: %v", err)
+	}
+	entries, err := s.ProjectIPAccessLists(projectID, nil)
+	if err != nil {
+		t.Fatalf("ProjectIPAccessLists() unexpected error: %v", err)
+	}
+	if entries.TotalCount != 0 {
+		t.Errorf("TotalCount = %d; want 0", entries.TotalCount)
+	}
+
+	srv.AddAlert(projectID, &atlas.Alert{ID: "5d1113b25a115342acc2d1aa", EventTypeName: "HOST_DOWN"})
+	until := "2030-01-01T00:00:00Z"
+	a, err := s.AcknowledgeAlert(projectID, "5d1113b25a115342acc2d1aa", &atlas.AcknowledgeRequest{AcknowledgedUntil: &until})
+	if err != nil {
+		t.Fatalf("AcknowledgeAlert() unexpected error: %v", err)
+	}
+	if a.AcknowledgedUntil != until {
+		t.Errorf("AcknowledgedUntil = %s; want %s", a.AcknowledgedUntil, until)
+	}
+
+	events, err := s.ProjectEvents(projectID, nil)
+	if err != nil {
+		t.Fatalf("ProjectEvents() unexpected error: %v", err)
+	}
+	if events.TotalCount != 5 {
+		t.Errorf("TotalCount = %d; want 5", events.TotalCount)
+	}
+}
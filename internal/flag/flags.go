@@ -23,6 +23,10 @@ const (
 	URL                             = "url"                             // URL flag
 	Secret                          = "secret"                          // Secret flag
 	ProjectID                       = "projectId"                       // ProjectID flag to use a project ID
+	AllProjects                     = "allProjects"                     // AllProjects flag
+	ProjectName                     = "projectName"                     // ProjectName flag
+	ProjectTag                      = "projectTag"                      // ProjectTag flag
+	Diff                            = "diff"                            // Diff flag
 	ProcessName                     = "processName"                     // Process Name
 	HostID                          = "hostId"                          // HostID flag
 	Since                           = "since"                           // Since flag
@@ -153,6 +157,7 @@ const (
 	OplogTS                         = "oplogTs"                         // OplogTS flag
 	OplogInc                        = "oplogInc"                        // OplogInc flag
 	PointInTimeUTCMillis            = "pointInTimeUTCMillis"            // PointInTimeUTCMillis flag
+	At                              = "at"                              // At flag
 	Expires                         = "expires"                         // Expires flag
 	MaxDownloads                    = "maxDownloads"                    // MaxDownloads flag
 	ExpirationHours                 = "expirationHours"                 // ExpirationHours flag
@@ -206,6 +211,12 @@ const (
 	AccountID                       = "accountId"                       // AccountID flag
 	RouteTableCidrBlock             = "routeTableCidrBlock"             // RouteTableCidrBlock flag
 	VpcID                           = "vpcId"                           // VpcID flag
+	SubnetIDs                       = "subnetIds"                       // SubnetIDs flag
+	SecurityGroupIDs                = "securityGroupIds"                // SecurityGroupIDs flag
+	Subnet                          = "subnet"                          // Subnet flag
+	AccessGranted                   = "accessGranted"                   // AccessGranted flag
+	RouteTableID                    = "routeTableId"                    // RouteTableID flag
+	Template                        = "template"                        // Template flag
 	GCPProjectID                    = "gcpProjectId"                    // GCPProjectID flag
 	Network                         = "network"                         // Network flag
 	Name                            = "name"                            // Name flag
@@ -246,5 +257,31 @@ const (
 	MonthlySnapshotRetentionMonths  = "monthlySnapshotRetentionMonths"  // MonthlySnapshotRetentionMonths flag
 	Policy                          = "policy"                          // Policy flag
 	SystemID                        = "systemId"                        // SystemID flag
+	Debug                           = "debug"                           // Debug flag
+	TraceFile                       = "traceFile"                       // TraceFile flag
+	Timeout                         = "timeout"                         // Timeout flag
+	Interval                        = "interval"                        // Interval flag
+	State                           = "state"                           // State flag
+	Wait                            = "wait"                            // Wait flag
+	KeepDaily                       = "keepDaily"                       // KeepDaily flag
+	KeepWeekly                      = "keepWeekly"                      // KeepWeekly flag
+	KeepMonthly                     = "keepMonthly"                     // KeepMonthly flag
+	DryRun                          = "dryRun"                          // DryRun flag
+	MaxSnapshotAge                  = "maxSnapshotAge"                  // MaxSnapshotAge flag
+	MinRetentionDays                = "minRetentionDays"                // MinRetentionDays flag
+	To                              = "to"                              // To flag
+	Manifest                        = "manifest"                        // Manifest flag
+	Prune                           = "prune"                           // Prune flag
+	TTL                             = "ttl"                             // TTL flag
+	CredentialHelper                = "credentialHelper"                // CredentialHelper flag
+	ExpiringWithin                  = "expiringWithin"                  // ExpiringWithin flag
+	Shell                           = "shell"                           // Shell flag
+	CredentialFile                  = "credentialFile"                  // CredentialFile flag
+	DBUser                          = "dbUser"                          // DBUser flag
+	PasswordEnv                     = "passwordEnv"                     // PasswordEnv flag
+	RetryWrites                     = "retryWrites"                     // RetryWrites flag
+	ReadPreference                  = "readPreference"                  // ReadPreference flag
+	AuthSource                      = "authSource"                      // AuthSource flag
+	TLS                             = "tls"                             // TLS flag
 
 )
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: AutomationGetter,AutomationUpdater,AutomationStatusGetter,AutomationPatcher,CloudManagerClustersLister,CloudManagerClustersDescriber,CloudManagerClustersDeleter)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: AutomationGetter,AutomationUpdater,AutomationStatusGetter,AutomationPatcher,CloudManagerClustersLister,CloudManagerClustersDescriber,CloudManagerClustersDeleter,CloudManagerClustersUpgrader)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -392,3 +392,85 @@ func (mr *MockCloudManagerClustersDeleterMockRecorder) UpdateAutomationConfig(ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutomationConfig", reflect.TypeOf((*MockCloudManagerClustersDeleter)(nil).UpdateAutomationConfig), arg0, arg1)
 }
+
+// MockCloudManagerClustersUpgrader is a mock of CloudManagerClustersUpgrader interface
+type MockCloudManagerClustersUpgrader struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloudManagerClustersUpgraderMockRecorder
+}
+
+// MockCloudManagerClustersUpgraderMockRecorder is the mock recorder for MockCloudManagerClustersUpgrader
+type MockCloudManagerClustersUpgraderMockRecorder struct {
+	mock *MockCloudManagerClustersUpgrader
+}
+
+// NewMockCloudManagerClustersUpgrader creates a new mock instance
+func NewMockCloudManagerClustersUpgrader(ctrl *gomock.Controller) *MockCloudManagerClustersUpgrader {
+	mock := &MockCloudManagerClustersUpgrader{ctrl: ctrl}
+	mock.recorder = &MockCloudManagerClustersUpgraderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCloudManagerClustersUpgrader) EXPECT() *MockCloudManagerClustersUpgraderMockRecorder {
+	return m.recorder
+}
+
+// GetAutomationConfig mocks base method
+func (m *MockCloudManagerClustersUpgrader) GetAutomationConfig(arg0 string) (*opsmngr.AutomationConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationConfig", arg0)
+	ret0, _ := ret[0].(*opsmngr.AutomationConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutomationConfig indicates an expected call of GetAutomationConfig
+func (mr *MockCloudManagerClustersUpgraderMockRecorder) GetAutomationConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationConfig", reflect.TypeOf((*MockCloudManagerClustersUpgrader)(nil).GetAutomationConfig), arg0)
+}
+
+// GetAutomationStatus mocks base method
+func (m *MockCloudManagerClustersUpgrader) GetAutomationStatus(arg0 string) (*opsmngr.AutomationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationStatus", arg0)
+	ret0, _ := ret[0].(*opsmngr.AutomationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutomationStatus indicates an expected call of GetAutomationStatus
+func (mr *MockCloudManagerClustersUpgraderMockRecorder) GetAutomationStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationStatus", reflect.TypeOf((*MockCloudManagerClustersUpgrader)(nil).GetAutomationStatus), arg0)
+}
+
+// ServiceVersion mocks base method
+func (m *MockCloudManagerClustersUpgrader) ServiceVersion() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceVersion")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceVersion indicates an expected call of ServiceVersion
+func (mr *MockCloudManagerClustersUpgraderMockRecorder) ServiceVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceVersion", reflect.TypeOf((*MockCloudManagerClustersUpgrader)(nil).ServiceVersion))
+}
+
+// UpdateAutomationConfig mocks base method
+func (m *MockCloudManagerClustersUpgrader) UpdateAutomationConfig(arg0 string, arg1 *opsmngr.AutomationConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutomationConfig", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAutomationConfig indicates an expected call of UpdateAutomationConfig
+func (mr *MockCloudManagerClustersUpgraderMockRecorder) UpdateAutomationConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutomationConfig", reflect.TypeOf((*MockCloudManagerClustersUpgrader)(nil).UpdateAutomationConfig), arg0, arg1)
+}
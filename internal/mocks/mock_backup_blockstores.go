@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: BlockstoresLister,BlockstoresDescriber,BlockstoresCreator,BlockstoresUpdater,BlockstoresDeleter)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: BlockstoresLister,BlockstoresDescriber,BlockstoresCreator,BlockstoresUpdater,BlockstoresDeleter,BackupInfrastructureExporter,BackupInfrastructureApplier)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -199,3 +199,349 @@ func (mr *MockBlockstoresDeleterMockRecorder) DeleteBlockstore(arg0 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlockstore", reflect.TypeOf((*MockBlockstoresDeleter)(nil).DeleteBlockstore), arg0)
 }
+
+// MockBackupInfrastructureExporter is a mock of BackupInfrastructureExporter interface
+type MockBackupInfrastructureExporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupInfrastructureExporterMockRecorder
+}
+
+// MockBackupInfrastructureExporterMockRecorder is the mock recorder for MockBackupInfrastructureExporter
+type MockBackupInfrastructureExporterMockRecorder struct {
+	mock *MockBackupInfrastructureExporter
+}
+
+// NewMockBackupInfrastructureExporter creates a new mock instance
+func NewMockBackupInfrastructureExporter(ctrl *gomock.Controller) *MockBackupInfrastructureExporter {
+	mock := &MockBackupInfrastructureExporter{ctrl: ctrl}
+	mock.recorder = &MockBackupInfrastructureExporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBackupInfrastructureExporter) EXPECT() *MockBackupInfrastructureExporterMockRecorder {
+	return m.recorder
+}
+
+// ListBlockstores mocks base method
+func (m *MockBackupInfrastructureExporter) ListBlockstores(arg0 *mongodbatlas.ListOptions) (*opsmngr.BackupStores, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlockstores", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStores)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockstores indicates an expected call of ListBlockstores
+func (mr *MockBackupInfrastructureExporterMockRecorder) ListBlockstores(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockstores", reflect.TypeOf((*MockBackupInfrastructureExporter)(nil).ListBlockstores), arg0)
+}
+
+// ListFileSystems mocks base method
+func (m *MockBackupInfrastructureExporter) ListFileSystems(arg0 *mongodbatlas.ListOptions) (*opsmngr.FileSystemStoreConfigurations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFileSystems", arg0)
+	ret0, _ := ret[0].(*opsmngr.FileSystemStoreConfigurations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFileSystems indicates an expected call of ListFileSystems
+func (mr *MockBackupInfrastructureExporterMockRecorder) ListFileSystems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFileSystems", reflect.TypeOf((*MockBackupInfrastructureExporter)(nil).ListFileSystems), arg0)
+}
+
+// ListOplogs mocks base method
+func (m *MockBackupInfrastructureExporter) ListOplogs(arg0 *mongodbatlas.ListOptions) (*opsmngr.BackupStores, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOplogs", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStores)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOplogs indicates an expected call of ListOplogs
+func (mr *MockBackupInfrastructureExporterMockRecorder) ListOplogs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOplogs", reflect.TypeOf((*MockBackupInfrastructureExporter)(nil).ListOplogs), arg0)
+}
+
+// ListS3Blockstores mocks base method
+func (m *MockBackupInfrastructureExporter) ListS3Blockstores(arg0 *mongodbatlas.ListOptions) (*opsmngr.S3Blockstores, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListS3Blockstores", arg0)
+	ret0, _ := ret[0].(*opsmngr.S3Blockstores)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListS3Blockstores indicates an expected call of ListS3Blockstores
+func (mr *MockBackupInfrastructureExporterMockRecorder) ListS3Blockstores(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListS3Blockstores", reflect.TypeOf((*MockBackupInfrastructureExporter)(nil).ListS3Blockstores), arg0)
+}
+
+// ListSyncs mocks base method
+func (m *MockBackupInfrastructureExporter) ListSyncs(arg0 *mongodbatlas.ListOptions) (*opsmngr.BackupStores, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSyncs", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStores)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSyncs indicates an expected call of ListSyncs
+func (mr *MockBackupInfrastructureExporterMockRecorder) ListSyncs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSyncs", reflect.TypeOf((*MockBackupInfrastructureExporter)(nil).ListSyncs), arg0)
+}
+
+// MockBackupInfrastructureApplier is a mock of BackupInfrastructureApplier interface
+type MockBackupInfrastructureApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupInfrastructureApplierMockRecorder
+}
+
+// MockBackupInfrastructureApplierMockRecorder is the mock recorder for MockBackupInfrastructureApplier
+type MockBackupInfrastructureApplierMockRecorder struct {
+	mock *MockBackupInfrastructureApplier
+}
+
+// NewMockBackupInfrastructureApplier creates a new mock instance
+func NewMockBackupInfrastructureApplier(ctrl *gomock.Controller) *MockBackupInfrastructureApplier {
+	mock := &MockBackupInfrastructureApplier{ctrl: ctrl}
+	mock.recorder = &MockBackupInfrastructureApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBackupInfrastructureApplier) EXPECT() *MockBackupInfrastructureApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateBlockstore mocks base method
+func (m *MockBackupInfrastructureApplier) CreateBlockstore(arg0 *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlockstore", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBlockstore indicates an expected call of CreateBlockstore
+func (mr *MockBackupInfrastructureApplierMockRecorder) CreateBlockstore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlockstore", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).CreateBlockstore), arg0)
+}
+
+// CreateFileSystems mocks base method
+func (m *MockBackupInfrastructureApplier) CreateFileSystems(arg0 *opsmngr.FileSystemStoreConfiguration) (*opsmngr.FileSystemStoreConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFileSystems", arg0)
+	ret0, _ := ret[0].(*opsmngr.FileSystemStoreConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFileSystems indicates an expected call of CreateFileSystems
+func (mr *MockBackupInfrastructureApplierMockRecorder) CreateFileSystems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFileSystems", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).CreateFileSystems), arg0)
+}
+
+// CreateOplog mocks base method
+func (m *MockBackupInfrastructureApplier) CreateOplog(arg0 *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOplog", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOplog indicates an expected call of CreateOplog
+func (mr *MockBackupInfrastructureApplierMockRecorder) CreateOplog(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOplog", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).CreateOplog), arg0)
+}
+
+// CreateS3Blockstores mocks base method
+func (m *MockBackupInfrastructureApplier) CreateS3Blockstores(arg0 *opsmngr.S3Blockstore) (*opsmngr.S3Blockstore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateS3Blockstores", arg0)
+	ret0, _ := ret[0].(*opsmngr.S3Blockstore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateS3Blockstores indicates an expected call of CreateS3Blockstores
+func (mr *MockBackupInfrastructureApplierMockRecorder) CreateS3Blockstores(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateS3Blockstores", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).CreateS3Blockstores), arg0)
+}
+
+// CreateSync mocks base method
+func (m *MockBackupInfrastructureApplier) CreateSync(arg0 *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSync", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSync indicates an expected call of CreateSync
+func (mr *MockBackupInfrastructureApplierMockRecorder) CreateSync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSync", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).CreateSync), arg0)
+}
+
+// ListBlockstores mocks base method
+func (m *MockBackupInfrastructureApplier) ListBlockstores(arg0 *mongodbatlas.ListOptions) (*opsmngr.BackupStores, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlockstores", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStores)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockstores indicates an expected call of ListBlockstores
+func (mr *MockBackupInfrastructureApplierMockRecorder) ListBlockstores(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockstores", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).ListBlockstores), arg0)
+}
+
+// ListFileSystems mocks base method
+func (m *MockBackupInfrastructureApplier) ListFileSystems(arg0 *mongodbatlas.ListOptions) (*opsmngr.FileSystemStoreConfigurations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFileSystems", arg0)
+	ret0, _ := ret[0].(*opsmngr.FileSystemStoreConfigurations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFileSystems indicates an expected call of ListFileSystems
+func (mr *MockBackupInfrastructureApplierMockRecorder) ListFileSystems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFileSystems", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).ListFileSystems), arg0)
+}
+
+// ListOplogs mocks base method
+func (m *MockBackupInfrastructureApplier) ListOplogs(arg0 *mongodbatlas.ListOptions) (*opsmngr.BackupStores, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOplogs", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStores)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOplogs indicates an expected call of ListOplogs
+func (mr *MockBackupInfrastructureApplierMockRecorder) ListOplogs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOplogs", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).ListOplogs), arg0)
+}
+
+// ListS3Blockstores mocks base method
+func (m *MockBackupInfrastructureApplier) ListS3Blockstores(arg0 *mongodbatlas.ListOptions) (*opsmngr.S3Blockstores, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListS3Blockstores", arg0)
+	ret0, _ := ret[0].(*opsmngr.S3Blockstores)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListS3Blockstores indicates an expected call of ListS3Blockstores
+func (mr *MockBackupInfrastructureApplierMockRecorder) ListS3Blockstores(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListS3Blockstores", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).ListS3Blockstores), arg0)
+}
+
+// ListSyncs mocks base method
+func (m *MockBackupInfrastructureApplier) ListSyncs(arg0 *mongodbatlas.ListOptions) (*opsmngr.BackupStores, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSyncs", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStores)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSyncs indicates an expected call of ListSyncs
+func (mr *MockBackupInfrastructureApplierMockRecorder) ListSyncs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSyncs", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).ListSyncs), arg0)
+}
+
+// UpdateBlockstore mocks base method
+func (m *MockBackupInfrastructureApplier) UpdateBlockstore(arg0 *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBlockstore", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBlockstore indicates an expected call of UpdateBlockstore
+func (mr *MockBackupInfrastructureApplierMockRecorder) UpdateBlockstore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBlockstore", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).UpdateBlockstore), arg0)
+}
+
+// UpdateFileSystems mocks base method
+func (m *MockBackupInfrastructureApplier) UpdateFileSystems(arg0 *opsmngr.FileSystemStoreConfiguration) (*opsmngr.FileSystemStoreConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileSystems", arg0)
+	ret0, _ := ret[0].(*opsmngr.FileSystemStoreConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFileSystems indicates an expected call of UpdateFileSystems
+func (mr *MockBackupInfrastructureApplierMockRecorder) UpdateFileSystems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileSystems", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).UpdateFileSystems), arg0)
+}
+
+// UpdateOplog mocks base method
+func (m *MockBackupInfrastructureApplier) UpdateOplog(arg0 string, arg1 *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOplog", arg0, arg1)
+	ret0, _ := ret[0].(*opsmngr.BackupStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOplog indicates an expected call of UpdateOplog
+func (mr *MockBackupInfrastructureApplierMockRecorder) UpdateOplog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOplog", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).UpdateOplog), arg0, arg1)
+}
+
+// UpdateS3Blockstores mocks base method
+func (m *MockBackupInfrastructureApplier) UpdateS3Blockstores(arg0 string, arg1 *opsmngr.S3Blockstore) (*opsmngr.S3Blockstore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateS3Blockstores", arg0, arg1)
+	ret0, _ := ret[0].(*opsmngr.S3Blockstore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateS3Blockstores indicates an expected call of UpdateS3Blockstores
+func (mr *MockBackupInfrastructureApplierMockRecorder) UpdateS3Blockstores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateS3Blockstores", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).UpdateS3Blockstores), arg0, arg1)
+}
+
+// UpdateSync mocks base method
+func (m *MockBackupInfrastructureApplier) UpdateSync(arg0 string, arg1 *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSync", arg0, arg1)
+	ret0, _ := ret[0].(*opsmngr.BackupStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSync indicates an expected call of UpdateSync
+func (mr *MockBackupInfrastructureApplierMockRecorder) UpdateSync(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSync", reflect.TypeOf((*MockBackupInfrastructureApplier)(nil).UpdateSync), arg0, arg1)
+}
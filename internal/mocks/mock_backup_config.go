@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: BackupConfigGetter,BackupConfigLister,BackupConfigUpdater)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: BackupConfigGetter,BackupConfigLister,BackupConfigUpdater,BackupReporter,BackupApplier)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -124,3 +124,244 @@ func (mr *MockBackupConfigUpdaterMockRecorder) UpdateBackupConfig(arg0 interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupConfig", reflect.TypeOf((*MockBackupConfigUpdater)(nil).UpdateBackupConfig), arg0)
 }
+
+// MockBackupReporter is a mock of BackupReporter interface
+type MockBackupReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupReporterMockRecorder
+}
+
+// MockBackupReporterMockRecorder is the mock recorder for MockBackupReporter
+type MockBackupReporterMockRecorder struct {
+	mock *MockBackupReporter
+}
+
+// NewMockBackupReporter creates a new mock instance
+func NewMockBackupReporter(ctrl *gomock.Controller) *MockBackupReporter {
+	mock := &MockBackupReporter{ctrl: ctrl}
+	mock.recorder = &MockBackupReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBackupReporter) EXPECT() *MockBackupReporterMockRecorder {
+	return m.recorder
+}
+
+// BackupPolicy mocks base method
+func (m *MockBackupReporter) BackupPolicy(arg0, arg1 string) (*mongodbatlas.CloudProviderSnapshotBackupPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.CloudProviderSnapshotBackupPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupPolicy indicates an expected call of BackupPolicy
+func (mr *MockBackupReporterMockRecorder) BackupPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupPolicy", reflect.TypeOf((*MockBackupReporter)(nil).BackupPolicy), arg0, arg1)
+}
+
+// ContinuousSnapshots mocks base method
+func (m *MockBackupReporter) ContinuousSnapshots(arg0, arg1 string, arg2 *mongodbatlas.ListOptions) (*mongodbatlas.ContinuousSnapshots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContinuousSnapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.ContinuousSnapshots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContinuousSnapshots indicates an expected call of ContinuousSnapshots
+func (mr *MockBackupReporterMockRecorder) ContinuousSnapshots(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinuousSnapshots", reflect.TypeOf((*MockBackupReporter)(nil).ContinuousSnapshots), arg0, arg1, arg2)
+}
+
+// GetOrgProjects mocks base method
+func (m *MockBackupReporter) GetOrgProjects(arg0 string, arg1 *mongodbatlas.ListOptions) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgProjects", arg0, arg1)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgProjects indicates an expected call of GetOrgProjects
+func (mr *MockBackupReporterMockRecorder) GetOrgProjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgProjects", reflect.TypeOf((*MockBackupReporter)(nil).GetOrgProjects), arg0, arg1)
+}
+
+// GetSnapshotSchedule mocks base method
+func (m *MockBackupReporter) GetSnapshotSchedule(arg0, arg1 string) (*opsmngr.SnapshotSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*opsmngr.SnapshotSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotSchedule indicates an expected call of GetSnapshotSchedule
+func (mr *MockBackupReporterMockRecorder) GetSnapshotSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotSchedule", reflect.TypeOf((*MockBackupReporter)(nil).GetSnapshotSchedule), arg0, arg1)
+}
+
+// ListBackupConfigs mocks base method
+func (m *MockBackupReporter) ListBackupConfigs(arg0 string, arg1 *mongodbatlas.ListOptions) (*opsmngr.BackupConfigs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupConfigs", arg0, arg1)
+	ret0, _ := ret[0].(*opsmngr.BackupConfigs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupConfigs indicates an expected call of ListBackupConfigs
+func (mr *MockBackupReporterMockRecorder) ListBackupConfigs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupConfigs", reflect.TypeOf((*MockBackupReporter)(nil).ListBackupConfigs), arg0, arg1)
+}
+
+// ProjectClusters mocks base method
+func (m *MockBackupReporter) ProjectClusters(arg0 string, arg1 *mongodbatlas.ListOptions) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectClusters", arg0, arg1)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectClusters indicates an expected call of ProjectClusters
+func (mr *MockBackupReporterMockRecorder) ProjectClusters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectClusters", reflect.TypeOf((*MockBackupReporter)(nil).ProjectClusters), arg0, arg1)
+}
+
+// Projects mocks base method
+func (m *MockBackupReporter) Projects(arg0 *mongodbatlas.ListOptions) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Projects", arg0)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Projects indicates an expected call of Projects
+func (mr *MockBackupReporterMockRecorder) Projects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Projects", reflect.TypeOf((*MockBackupReporter)(nil).Projects), arg0)
+}
+
+// Snapshots mocks base method
+func (m *MockBackupReporter) Snapshots(arg0, arg1 string, arg2 *mongodbatlas.ListOptions) (*mongodbatlas.CloudProviderSnapshots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.CloudProviderSnapshots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshots indicates an expected call of Snapshots
+func (mr *MockBackupReporterMockRecorder) Snapshots(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockBackupReporter)(nil).Snapshots), arg0, arg1, arg2)
+}
+
+// MockBackupApplier is a mock of BackupApplier interface
+type MockBackupApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupApplierMockRecorder
+}
+
+// MockBackupApplierMockRecorder is the mock recorder for MockBackupApplier
+type MockBackupApplierMockRecorder struct {
+	mock *MockBackupApplier
+}
+
+// NewMockBackupApplier creates a new mock instance
+func NewMockBackupApplier(ctrl *gomock.Controller) *MockBackupApplier {
+	mock := &MockBackupApplier{ctrl: ctrl}
+	mock.recorder = &MockBackupApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBackupApplier) EXPECT() *MockBackupApplierMockRecorder {
+	return m.recorder
+}
+
+// GetSnapshotSchedule mocks base method
+func (m *MockBackupApplier) GetSnapshotSchedule(arg0, arg1 string) (*opsmngr.SnapshotSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*opsmngr.SnapshotSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotSchedule indicates an expected call of GetSnapshotSchedule
+func (mr *MockBackupApplierMockRecorder) GetSnapshotSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotSchedule", reflect.TypeOf((*MockBackupApplier)(nil).GetSnapshotSchedule), arg0, arg1)
+}
+
+// ListBackupConfigs mocks base method
+func (m *MockBackupApplier) ListBackupConfigs(arg0 string, arg1 *mongodbatlas.ListOptions) (*opsmngr.BackupConfigs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupConfigs", arg0, arg1)
+	ret0, _ := ret[0].(*opsmngr.BackupConfigs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupConfigs indicates an expected call of ListBackupConfigs
+func (mr *MockBackupApplierMockRecorder) ListBackupConfigs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupConfigs", reflect.TypeOf((*MockBackupApplier)(nil).ListBackupConfigs), arg0, arg1)
+}
+
+// ProjectClusters mocks base method
+func (m *MockBackupApplier) ProjectClusters(arg0 string, arg1 *mongodbatlas.ListOptions) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectClusters", arg0, arg1)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectClusters indicates an expected call of ProjectClusters
+func (mr *MockBackupApplierMockRecorder) ProjectClusters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectClusters", reflect.TypeOf((*MockBackupApplier)(nil).ProjectClusters), arg0, arg1)
+}
+
+// UpdateBackupConfig mocks base method
+func (m *MockBackupApplier) UpdateBackupConfig(arg0 *opsmngr.BackupConfig) (*opsmngr.BackupConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBackupConfig", arg0)
+	ret0, _ := ret[0].(*opsmngr.BackupConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBackupConfig indicates an expected call of UpdateBackupConfig
+func (mr *MockBackupApplierMockRecorder) UpdateBackupConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupConfig", reflect.TypeOf((*MockBackupApplier)(nil).UpdateBackupConfig), arg0)
+}
+
+// UpdateSnapshotSchedule mocks base method
+func (m *MockBackupApplier) UpdateSnapshotSchedule(arg0, arg1 string, arg2 *opsmngr.SnapshotSchedule) (*opsmngr.SnapshotSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnapshotSchedule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*opsmngr.SnapshotSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSnapshotSchedule indicates an expected call of UpdateSnapshotSchedule
+func (mr *MockBackupApplierMockRecorder) UpdateSnapshotSchedule(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotSchedule", reflect.TypeOf((*MockBackupApplier)(nil).UpdateSnapshotSchedule), arg0, arg1, arg2)
+}
@@ -1,11 +1,12 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: RestoreJobsLister,RestoreJobsCreator,SnapshotsLister,SnapshotsCreator,SnapshotsDescriber,SnapshotsDeleter)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: RestoreJobsLister,RestoreJobsCreator,RestoreJobsDescriber,RestoreArchiveDownloader,BackupPolicyDescriber,RestoreJobsStarter,SnapshotsLister,SnapshotsCreator,SnapshotsDescriber,SnapshotsDeleter,SnapshotsPruner)
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
 	gomock "github.com/golang/mock/gomock"
+	store "github.com/mongodb/mongocli/internal/store"
 	mongodbatlas "go.mongodb.org/atlas/mongodbatlas"
 	reflect "reflect"
 )
@@ -86,6 +87,233 @@ func (mr *MockRestoreJobsCreatorMockRecorder) CreateRestoreJobs(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestoreJobs", reflect.TypeOf((*MockRestoreJobsCreator)(nil).CreateRestoreJobs), arg0, arg1, arg2)
 }
 
+// MockRestoreJobsDescriber is a mock of RestoreJobsDescriber interface
+type MockRestoreJobsDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestoreJobsDescriberMockRecorder
+}
+
+// MockRestoreJobsDescriberMockRecorder is the mock recorder for MockRestoreJobsDescriber
+type MockRestoreJobsDescriberMockRecorder struct {
+	mock *MockRestoreJobsDescriber
+}
+
+// NewMockRestoreJobsDescriber creates a new mock instance
+func NewMockRestoreJobsDescriber(ctrl *gomock.Controller) *MockRestoreJobsDescriber {
+	mock := &MockRestoreJobsDescriber{ctrl: ctrl}
+	mock.recorder = &MockRestoreJobsDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRestoreJobsDescriber) EXPECT() *MockRestoreJobsDescriberMockRecorder {
+	return m.recorder
+}
+
+// RestoreJob mocks base method
+func (m *MockRestoreJobsDescriber) RestoreJob(arg0, arg1, arg2 string) (*mongodbatlas.CloudProviderSnapshotRestoreJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.CloudProviderSnapshotRestoreJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreJob indicates an expected call of RestoreJob
+func (mr *MockRestoreJobsDescriberMockRecorder) RestoreJob(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreJob", reflect.TypeOf((*MockRestoreJobsDescriber)(nil).RestoreJob), arg0, arg1, arg2)
+}
+
+// MockRestoreArchiveDownloader is a mock of RestoreArchiveDownloader interface
+type MockRestoreArchiveDownloader struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestoreArchiveDownloaderMockRecorder
+}
+
+// MockRestoreArchiveDownloaderMockRecorder is the mock recorder for MockRestoreArchiveDownloader
+type MockRestoreArchiveDownloaderMockRecorder struct {
+	mock *MockRestoreArchiveDownloader
+}
+
+// NewMockRestoreArchiveDownloader creates a new mock instance
+func NewMockRestoreArchiveDownloader(ctrl *gomock.Controller) *MockRestoreArchiveDownloader {
+	mock := &MockRestoreArchiveDownloader{ctrl: ctrl}
+	mock.recorder = &MockRestoreArchiveDownloaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRestoreArchiveDownloader) EXPECT() *MockRestoreArchiveDownloaderMockRecorder {
+	return m.recorder
+}
+
+// DownloadRestoreArchive mocks base method
+func (m *MockRestoreArchiveDownloader) DownloadRestoreArchive(arg0 string, arg1 int64) (*store.RestoreArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadRestoreArchive", arg0, arg1)
+	ret0, _ := ret[0].(*store.RestoreArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadRestoreArchive indicates an expected call of DownloadRestoreArchive
+func (mr *MockRestoreArchiveDownloaderMockRecorder) DownloadRestoreArchive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadRestoreArchive", reflect.TypeOf((*MockRestoreArchiveDownloader)(nil).DownloadRestoreArchive), arg0, arg1)
+}
+
+// MockBackupPolicyDescriber is a mock of BackupPolicyDescriber interface
+type MockBackupPolicyDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupPolicyDescriberMockRecorder
+}
+
+// MockBackupPolicyDescriberMockRecorder is the mock recorder for MockBackupPolicyDescriber
+type MockBackupPolicyDescriberMockRecorder struct {
+	mock *MockBackupPolicyDescriber
+}
+
+// NewMockBackupPolicyDescriber creates a new mock instance
+func NewMockBackupPolicyDescriber(ctrl *gomock.Controller) *MockBackupPolicyDescriber {
+	mock := &MockBackupPolicyDescriber{ctrl: ctrl}
+	mock.recorder = &MockBackupPolicyDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBackupPolicyDescriber) EXPECT() *MockBackupPolicyDescriberMockRecorder {
+	return m.recorder
+}
+
+// BackupPolicy mocks base method
+func (m *MockBackupPolicyDescriber) BackupPolicy(arg0, arg1 string) (*mongodbatlas.CloudProviderSnapshotBackupPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.CloudProviderSnapshotBackupPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupPolicy indicates an expected call of BackupPolicy
+func (mr *MockBackupPolicyDescriberMockRecorder) BackupPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupPolicy", reflect.TypeOf((*MockBackupPolicyDescriber)(nil).BackupPolicy), arg0, arg1)
+}
+
+// MockRestoreJobsStarter is a mock of RestoreJobsStarter interface
+type MockRestoreJobsStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestoreJobsStarterMockRecorder
+}
+
+// MockRestoreJobsStarterMockRecorder is the mock recorder for MockRestoreJobsStarter
+type MockRestoreJobsStarterMockRecorder struct {
+	mock *MockRestoreJobsStarter
+}
+
+// NewMockRestoreJobsStarter creates a new mock instance
+func NewMockRestoreJobsStarter(ctrl *gomock.Controller) *MockRestoreJobsStarter {
+	mock := &MockRestoreJobsStarter{ctrl: ctrl}
+	mock.recorder = &MockRestoreJobsStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRestoreJobsStarter) EXPECT() *MockRestoreJobsStarterMockRecorder {
+	return m.recorder
+}
+
+// AtlasCluster mocks base method
+func (m *MockRestoreJobsStarter) AtlasCluster(arg0, arg1 string) (*mongodbatlas.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AtlasCluster", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AtlasCluster indicates an expected call of AtlasCluster
+func (mr *MockRestoreJobsStarterMockRecorder) AtlasCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AtlasCluster", reflect.TypeOf((*MockRestoreJobsStarter)(nil).AtlasCluster), arg0, arg1)
+}
+
+// BackupPolicy mocks base method
+func (m *MockRestoreJobsStarter) BackupPolicy(arg0, arg1 string) (*mongodbatlas.CloudProviderSnapshotBackupPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.CloudProviderSnapshotBackupPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupPolicy indicates an expected call of BackupPolicy
+func (mr *MockRestoreJobsStarterMockRecorder) BackupPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupPolicy", reflect.TypeOf((*MockRestoreJobsStarter)(nil).BackupPolicy), arg0, arg1)
+}
+
+// CreateRestoreJobs mocks base method
+func (m *MockRestoreJobsStarter) CreateRestoreJobs(arg0, arg1 string, arg2 *mongodbatlas.CloudProviderSnapshotRestoreJob) (*mongodbatlas.CloudProviderSnapshotRestoreJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestoreJobs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.CloudProviderSnapshotRestoreJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRestoreJobs indicates an expected call of CreateRestoreJobs
+func (mr *MockRestoreJobsStarterMockRecorder) CreateRestoreJobs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestoreJobs", reflect.TypeOf((*MockRestoreJobsStarter)(nil).CreateRestoreJobs), arg0, arg1, arg2)
+}
+
+// DownloadRestoreArchive mocks base method
+func (m *MockRestoreJobsStarter) DownloadRestoreArchive(arg0 string, arg1 int64) (*store.RestoreArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadRestoreArchive", arg0, arg1)
+	ret0, _ := ret[0].(*store.RestoreArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadRestoreArchive indicates an expected call of DownloadRestoreArchive
+func (mr *MockRestoreJobsStarterMockRecorder) DownloadRestoreArchive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadRestoreArchive", reflect.TypeOf((*MockRestoreJobsStarter)(nil).DownloadRestoreArchive), arg0, arg1)
+}
+
+// RestoreJob mocks base method
+func (m *MockRestoreJobsStarter) RestoreJob(arg0, arg1, arg2 string) (*mongodbatlas.CloudProviderSnapshotRestoreJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.CloudProviderSnapshotRestoreJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreJob indicates an expected call of RestoreJob
+func (mr *MockRestoreJobsStarterMockRecorder) RestoreJob(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreJob", reflect.TypeOf((*MockRestoreJobsStarter)(nil).RestoreJob), arg0, arg1, arg2)
+}
+
+// Snapshots mocks base method
+func (m *MockRestoreJobsStarter) Snapshots(arg0, arg1 string, arg2 *mongodbatlas.ListOptions) (*mongodbatlas.CloudProviderSnapshots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.CloudProviderSnapshots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshots indicates an expected call of Snapshots
+func (mr *MockRestoreJobsStarterMockRecorder) Snapshots(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockRestoreJobsStarter)(nil).Snapshots), arg0, arg1, arg2)
+}
+
 // MockSnapshotsLister is a mock of SnapshotsLister interface
 type MockSnapshotsLister struct {
 	ctrl     *gomock.Controller
@@ -236,3 +464,55 @@ func (mr *MockSnapshotsDeleterMockRecorder) DeleteSnapshot(arg0, arg1, arg2 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockSnapshotsDeleter)(nil).DeleteSnapshot), arg0, arg1, arg2)
 }
+
+// MockSnapshotsPruner is a mock of SnapshotsPruner interface
+type MockSnapshotsPruner struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotsPrunerMockRecorder
+}
+
+// MockSnapshotsPrunerMockRecorder is the mock recorder for MockSnapshotsPruner
+type MockSnapshotsPrunerMockRecorder struct {
+	mock *MockSnapshotsPruner
+}
+
+// NewMockSnapshotsPruner creates a new mock instance
+func NewMockSnapshotsPruner(ctrl *gomock.Controller) *MockSnapshotsPruner {
+	mock := &MockSnapshotsPruner{ctrl: ctrl}
+	mock.recorder = &MockSnapshotsPrunerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSnapshotsPruner) EXPECT() *MockSnapshotsPrunerMockRecorder {
+	return m.recorder
+}
+
+// DeleteSnapshot mocks base method
+func (m *MockSnapshotsPruner) DeleteSnapshot(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot
+func (mr *MockSnapshotsPrunerMockRecorder) DeleteSnapshot(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockSnapshotsPruner)(nil).DeleteSnapshot), arg0, arg1, arg2)
+}
+
+// Snapshots mocks base method
+func (m *MockSnapshotsPruner) Snapshots(arg0, arg1 string, arg2 *mongodbatlas.ListOptions) (*mongodbatlas.CloudProviderSnapshots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.CloudProviderSnapshots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshots indicates an expected call of Snapshots
+func (mr *MockSnapshotsPrunerMockRecorder) Snapshots(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockSnapshotsPruner)(nil).Snapshots), arg0, arg1, arg2)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: DatabaseRoleLister,DatabaseRoleCreator,DatabaseRoleDeleter,DatabaseRoleUpdater,DatabaseRoleDescriber)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: DatabaseRoleLister,DatabaseRoleCreator,DatabaseRoleDeleter,DatabaseRoleUpdater,DatabaseRoleDescriber,DatabaseRoleApplier)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -213,3 +213,100 @@ func (mr *MockDatabaseRoleDescriberMockRecorder) DatabaseRole(arg0, arg1 interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseRole", reflect.TypeOf((*MockDatabaseRoleDescriber)(nil).DatabaseRole), arg0, arg1)
 }
+
+// MockDatabaseRoleApplier is a mock of DatabaseRoleApplier interface
+type MockDatabaseRoleApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseRoleApplierMockRecorder
+}
+
+// MockDatabaseRoleApplierMockRecorder is the mock recorder for MockDatabaseRoleApplier
+type MockDatabaseRoleApplierMockRecorder struct {
+	mock *MockDatabaseRoleApplier
+}
+
+// NewMockDatabaseRoleApplier creates a new mock instance
+func NewMockDatabaseRoleApplier(ctrl *gomock.Controller) *MockDatabaseRoleApplier {
+	mock := &MockDatabaseRoleApplier{ctrl: ctrl}
+	mock.recorder = &MockDatabaseRoleApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDatabaseRoleApplier) EXPECT() *MockDatabaseRoleApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateDatabaseRole mocks base method
+func (m *MockDatabaseRoleApplier) CreateDatabaseRole(arg0 string, arg1 *mongodbatlas.CustomDBRole) (*mongodbatlas.CustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabaseRole", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.CustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabaseRole indicates an expected call of CreateDatabaseRole
+func (mr *MockDatabaseRoleApplierMockRecorder) CreateDatabaseRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabaseRole", reflect.TypeOf((*MockDatabaseRoleApplier)(nil).CreateDatabaseRole), arg0, arg1)
+}
+
+// DatabaseRole mocks base method
+func (m *MockDatabaseRoleApplier) DatabaseRole(arg0, arg1 string) (*mongodbatlas.CustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseRole", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.CustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseRole indicates an expected call of DatabaseRole
+func (mr *MockDatabaseRoleApplierMockRecorder) DatabaseRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseRole", reflect.TypeOf((*MockDatabaseRoleApplier)(nil).DatabaseRole), arg0, arg1)
+}
+
+// DatabaseRoles mocks base method
+func (m *MockDatabaseRoleApplier) DatabaseRoles(arg0 string, arg1 *mongodbatlas.ListOptions) (*[]mongodbatlas.CustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseRoles", arg0, arg1)
+	ret0, _ := ret[0].(*[]mongodbatlas.CustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseRoles indicates an expected call of DatabaseRoles
+func (mr *MockDatabaseRoleApplierMockRecorder) DatabaseRoles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseRoles", reflect.TypeOf((*MockDatabaseRoleApplier)(nil).DatabaseRoles), arg0, arg1)
+}
+
+// DeleteDatabaseRole mocks base method
+func (m *MockDatabaseRoleApplier) DeleteDatabaseRole(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabaseRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabaseRole indicates an expected call of DeleteDatabaseRole
+func (mr *MockDatabaseRoleApplierMockRecorder) DeleteDatabaseRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseRole", reflect.TypeOf((*MockDatabaseRoleApplier)(nil).DeleteDatabaseRole), arg0, arg1)
+}
+
+// UpdateDatabaseRole mocks base method
+func (m *MockDatabaseRoleApplier) UpdateDatabaseRole(arg0, arg1 string, arg2 *mongodbatlas.CustomDBRole) (*mongodbatlas.CustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDatabaseRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.CustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDatabaseRole indicates an expected call of UpdateDatabaseRole
+func (mr *MockDatabaseRoleApplierMockRecorder) UpdateDatabaseRole(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatabaseRole", reflect.TypeOf((*MockDatabaseRoleApplier)(nil).UpdateDatabaseRole), arg0, arg1, arg2)
+}
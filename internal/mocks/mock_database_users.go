@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: DatabaseUserLister,DatabaseUserCreator,DatabaseUserDeleter,DatabaseUserUpdater,DatabaseUserDescriber,DBUserCertificateLister,DBUserCertificateCreator)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: DatabaseUserLister,DatabaseUserCreator,DatabaseUserDeleter,DatabaseUserUpdater,DatabaseUserDescriber,DBUserCertificateLister,DBUserCertificateCreator,DatabaseUserPasswordRotator,DatabaseUserApplier,DBUserCertificateRenewer)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -274,3 +274,206 @@ func (mr *MockDBUserCertificateCreatorMockRecorder) CreateDBUserCertificate(arg0
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDBUserCertificate", reflect.TypeOf((*MockDBUserCertificateCreator)(nil).CreateDBUserCertificate), arg0, arg1, arg2)
 }
+
+// MockDatabaseUserPasswordRotator is a mock of DatabaseUserPasswordRotator interface
+type MockDatabaseUserPasswordRotator struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseUserPasswordRotatorMockRecorder
+}
+
+// MockDatabaseUserPasswordRotatorMockRecorder is the mock recorder for MockDatabaseUserPasswordRotator
+type MockDatabaseUserPasswordRotatorMockRecorder struct {
+	mock *MockDatabaseUserPasswordRotator
+}
+
+// NewMockDatabaseUserPasswordRotator creates a new mock instance
+func NewMockDatabaseUserPasswordRotator(ctrl *gomock.Controller) *MockDatabaseUserPasswordRotator {
+	mock := &MockDatabaseUserPasswordRotator{ctrl: ctrl}
+	mock.recorder = &MockDatabaseUserPasswordRotatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDatabaseUserPasswordRotator) EXPECT() *MockDatabaseUserPasswordRotatorMockRecorder {
+	return m.recorder
+}
+
+// DatabaseUser mocks base method
+func (m *MockDatabaseUserPasswordRotator) DatabaseUser(arg0, arg1, arg2 string) (*mongodbatlas.DatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.DatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUser indicates an expected call of DatabaseUser
+func (mr *MockDatabaseUserPasswordRotatorMockRecorder) DatabaseUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUser", reflect.TypeOf((*MockDatabaseUserPasswordRotator)(nil).DatabaseUser), arg0, arg1, arg2)
+}
+
+// UpdateDatabaseUser mocks base method
+func (m *MockDatabaseUserPasswordRotator) UpdateDatabaseUser(arg0 *mongodbatlas.DatabaseUser) (*mongodbatlas.DatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDatabaseUser", arg0)
+	ret0, _ := ret[0].(*mongodbatlas.DatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDatabaseUser indicates an expected call of UpdateDatabaseUser
+func (mr *MockDatabaseUserPasswordRotatorMockRecorder) UpdateDatabaseUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatabaseUser", reflect.TypeOf((*MockDatabaseUserPasswordRotator)(nil).UpdateDatabaseUser), arg0)
+}
+
+// MockDatabaseUserApplier is a mock of DatabaseUserApplier interface
+type MockDatabaseUserApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseUserApplierMockRecorder
+}
+
+// MockDatabaseUserApplierMockRecorder is the mock recorder for MockDatabaseUserApplier
+type MockDatabaseUserApplierMockRecorder struct {
+	mock *MockDatabaseUserApplier
+}
+
+// NewMockDatabaseUserApplier creates a new mock instance
+func NewMockDatabaseUserApplier(ctrl *gomock.Controller) *MockDatabaseUserApplier {
+	mock := &MockDatabaseUserApplier{ctrl: ctrl}
+	mock.recorder = &MockDatabaseUserApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDatabaseUserApplier) EXPECT() *MockDatabaseUserApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateDatabaseUser mocks base method
+func (m *MockDatabaseUserApplier) CreateDatabaseUser(arg0 *mongodbatlas.DatabaseUser) (*mongodbatlas.DatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabaseUser", arg0)
+	ret0, _ := ret[0].(*mongodbatlas.DatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabaseUser indicates an expected call of CreateDatabaseUser
+func (mr *MockDatabaseUserApplierMockRecorder) CreateDatabaseUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabaseUser", reflect.TypeOf((*MockDatabaseUserApplier)(nil).CreateDatabaseUser), arg0)
+}
+
+// DatabaseUsers mocks base method
+func (m *MockDatabaseUserApplier) DatabaseUsers(arg0 string, arg1 *mongodbatlas.ListOptions) ([]mongodbatlas.DatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUsers", arg0, arg1)
+	ret0, _ := ret[0].([]mongodbatlas.DatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUsers indicates an expected call of DatabaseUsers
+func (mr *MockDatabaseUserApplierMockRecorder) DatabaseUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockDatabaseUserApplier)(nil).DatabaseUsers), arg0, arg1)
+}
+
+// DeleteDatabaseUser mocks base method
+func (m *MockDatabaseUserApplier) DeleteDatabaseUser(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabaseUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabaseUser indicates an expected call of DeleteDatabaseUser
+func (mr *MockDatabaseUserApplierMockRecorder) DeleteDatabaseUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseUser", reflect.TypeOf((*MockDatabaseUserApplier)(nil).DeleteDatabaseUser), arg0, arg1, arg2)
+}
+
+// UpdateDatabaseUser mocks base method
+func (m *MockDatabaseUserApplier) UpdateDatabaseUser(arg0 *mongodbatlas.DatabaseUser) (*mongodbatlas.DatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDatabaseUser", arg0)
+	ret0, _ := ret[0].(*mongodbatlas.DatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDatabaseUser indicates an expected call of UpdateDatabaseUser
+func (mr *MockDatabaseUserApplierMockRecorder) UpdateDatabaseUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatabaseUser", reflect.TypeOf((*MockDatabaseUserApplier)(nil).UpdateDatabaseUser), arg0)
+}
+
+// MockDBUserCertificateRenewer is a mock of DBUserCertificateRenewer interface
+type MockDBUserCertificateRenewer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBUserCertificateRenewerMockRecorder
+}
+
+// MockDBUserCertificateRenewerMockRecorder is the mock recorder for MockDBUserCertificateRenewer
+type MockDBUserCertificateRenewerMockRecorder struct {
+	mock *MockDBUserCertificateRenewer
+}
+
+// NewMockDBUserCertificateRenewer creates a new mock instance
+func NewMockDBUserCertificateRenewer(ctrl *gomock.Controller) *MockDBUserCertificateRenewer {
+	mock := &MockDBUserCertificateRenewer{ctrl: ctrl}
+	mock.recorder = &MockDBUserCertificateRenewerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDBUserCertificateRenewer) EXPECT() *MockDBUserCertificateRenewerMockRecorder {
+	return m.recorder
+}
+
+// CreateDBUserCertificate mocks base method
+func (m *MockDBUserCertificateRenewer) CreateDBUserCertificate(arg0, arg1 string, arg2 int) (*mongodbatlas.UserCertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDBUserCertificate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.UserCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDBUserCertificate indicates an expected call of CreateDBUserCertificate
+func (mr *MockDBUserCertificateRenewerMockRecorder) CreateDBUserCertificate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDBUserCertificate", reflect.TypeOf((*MockDBUserCertificateRenewer)(nil).CreateDBUserCertificate), arg0, arg1, arg2)
+}
+
+// DBUserCertificates mocks base method
+func (m *MockDBUserCertificateRenewer) DBUserCertificates(arg0, arg1 string) ([]mongodbatlas.UserCertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DBUserCertificates", arg0, arg1)
+	ret0, _ := ret[0].([]mongodbatlas.UserCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DBUserCertificates indicates an expected call of DBUserCertificates
+func (mr *MockDBUserCertificateRenewerMockRecorder) DBUserCertificates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DBUserCertificates", reflect.TypeOf((*MockDBUserCertificateRenewer)(nil).DBUserCertificates), arg0, arg1)
+}
+
+// DatabaseUsers mocks base method
+func (m *MockDBUserCertificateRenewer) DatabaseUsers(arg0 string, arg1 *mongodbatlas.ListOptions) ([]mongodbatlas.DatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUsers", arg0, arg1)
+	ret0, _ := ret[0].([]mongodbatlas.DatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUsers indicates an expected call of DatabaseUsers
+func (mr *MockDBUserCertificateRenewerMockRecorder) DatabaseUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockDBUserCertificateRenewer)(nil).DatabaseUsers), arg0, arg1)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: IPInfoDescriber)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	mongodbatlas "go.mongodb.org/atlas/mongodbatlas"
+	reflect "reflect"
+)
+
+// MockIPInfoDescriber is a mock of IPInfoDescriber interface
+type MockIPInfoDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPInfoDescriberMockRecorder
+}
+
+// MockIPInfoDescriberMockRecorder is the mock recorder for MockIPInfoDescriber
+type MockIPInfoDescriberMockRecorder struct {
+	mock *MockIPInfoDescriber
+}
+
+// NewMockIPInfoDescriber creates a new mock instance
+func NewMockIPInfoDescriber(ctrl *gomock.Controller) *MockIPInfoDescriber {
+	mock := &MockIPInfoDescriber{ctrl: ctrl}
+	mock.recorder = &MockIPInfoDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockIPInfoDescriber) EXPECT() *MockIPInfoDescriberMockRecorder {
+	return m.recorder
+}
+
+// IPInfo mocks base method
+func (m *MockIPInfoDescriber) IPInfo() (*mongodbatlas.IPInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IPInfo")
+	ret0, _ := ret[0].(*mongodbatlas.IPInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IPInfo indicates an expected call of IPInfo
+func (mr *MockIPInfoDescriberMockRecorder) IPInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IPInfo", reflect.TypeOf((*MockIPInfoDescriber)(nil).IPInfo))
+}
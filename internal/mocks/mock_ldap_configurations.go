@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: LDAPConfigurationVerifier,LDAPConfigurationDescriber,LDAPConfigurationSaver,LDAPConfigurationDeleter,LDAPConfigurationGetter)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: LDAPConfigurationVerifier,LDAPConfigurationDescriber,LDAPConfigurationSaver,LDAPConfigurationDeleter,LDAPConfigurationGetter,LDAPConfigurationApplier)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -198,3 +198,71 @@ func (mr *MockLDAPConfigurationGetterMockRecorder) GetLDAPConfiguration(arg0 int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLDAPConfiguration", reflect.TypeOf((*MockLDAPConfigurationGetter)(nil).GetLDAPConfiguration), arg0)
 }
+
+// MockLDAPConfigurationApplier is a mock of LDAPConfigurationApplier interface
+type MockLDAPConfigurationApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockLDAPConfigurationApplierMockRecorder
+}
+
+// MockLDAPConfigurationApplierMockRecorder is the mock recorder for MockLDAPConfigurationApplier
+type MockLDAPConfigurationApplierMockRecorder struct {
+	mock *MockLDAPConfigurationApplier
+}
+
+// NewMockLDAPConfigurationApplier creates a new mock instance
+func NewMockLDAPConfigurationApplier(ctrl *gomock.Controller) *MockLDAPConfigurationApplier {
+	mock := &MockLDAPConfigurationApplier{ctrl: ctrl}
+	mock.recorder = &MockLDAPConfigurationApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLDAPConfigurationApplier) EXPECT() *MockLDAPConfigurationApplierMockRecorder {
+	return m.recorder
+}
+
+// GetStatusLDAPConfiguration mocks base method
+func (m *MockLDAPConfigurationApplier) GetStatusLDAPConfiguration(arg0, arg1 string) (*mongodbatlas.LDAPConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusLDAPConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.LDAPConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusLDAPConfiguration indicates an expected call of GetStatusLDAPConfiguration
+func (mr *MockLDAPConfigurationApplierMockRecorder) GetStatusLDAPConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusLDAPConfiguration", reflect.TypeOf((*MockLDAPConfigurationApplier)(nil).GetStatusLDAPConfiguration), arg0, arg1)
+}
+
+// SaveLDAPConfiguration mocks base method
+func (m *MockLDAPConfigurationApplier) SaveLDAPConfiguration(arg0 string, arg1 *mongodbatlas.LDAPConfiguration) (*mongodbatlas.LDAPConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLDAPConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.LDAPConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveLDAPConfiguration indicates an expected call of SaveLDAPConfiguration
+func (mr *MockLDAPConfigurationApplierMockRecorder) SaveLDAPConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLDAPConfiguration", reflect.TypeOf((*MockLDAPConfigurationApplier)(nil).SaveLDAPConfiguration), arg0, arg1)
+}
+
+// VerifyLDAPConfiguration mocks base method
+func (m *MockLDAPConfigurationApplier) VerifyLDAPConfiguration(arg0 string, arg1 *mongodbatlas.LDAP) (*mongodbatlas.LDAPConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLDAPConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.LDAPConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLDAPConfiguration indicates an expected call of VerifyLDAPConfiguration
+func (mr *MockLDAPConfigurationApplierMockRecorder) VerifyLDAPConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLDAPConfiguration", reflect.TypeOf((*MockLDAPConfigurationApplier)(nil).VerifyLDAPConfiguration), arg0, arg1)
+}
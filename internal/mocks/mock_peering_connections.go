@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: PeeringConnectionLister,PeeringConnectionDescriber,PeeringConnectionDeleter,AzurePeeringConnectionCreator,AWSPeeringConnectionCreator,GCPPeeringConnectionCreator,PeeringConnectionCreator)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: PeeringConnectionLister,PeeringConnectionDescriber,PeeringConnectionDeleter,AzurePeeringConnectionCreator,AWSPeeringConnectionCreator,GCPPeeringConnectionCreator,PeeringConnectionCreator,AWSPeeringConnectionProvisioner,AzurePeeringConnectionProvisioner,GCPPeeringConnectionProvisioner)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -379,3 +379,312 @@ func (mr *MockPeeringConnectionCreatorMockRecorder) CreatePeeringConnection(arg0
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePeeringConnection", reflect.TypeOf((*MockPeeringConnectionCreator)(nil).CreatePeeringConnection), arg0, arg1)
 }
+
+// MockAWSPeeringConnectionProvisioner is a mock of AWSPeeringConnectionProvisioner interface
+type MockAWSPeeringConnectionProvisioner struct {
+	ctrl     *gomock.Controller
+	recorder *MockAWSPeeringConnectionProvisionerMockRecorder
+}
+
+// MockAWSPeeringConnectionProvisionerMockRecorder is the mock recorder for MockAWSPeeringConnectionProvisioner
+type MockAWSPeeringConnectionProvisionerMockRecorder struct {
+	mock *MockAWSPeeringConnectionProvisioner
+}
+
+// NewMockAWSPeeringConnectionProvisioner creates a new mock instance
+func NewMockAWSPeeringConnectionProvisioner(ctrl *gomock.Controller) *MockAWSPeeringConnectionProvisioner {
+	mock := &MockAWSPeeringConnectionProvisioner{ctrl: ctrl}
+	mock.recorder = &MockAWSPeeringConnectionProvisionerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAWSPeeringConnectionProvisioner) EXPECT() *MockAWSPeeringConnectionProvisionerMockRecorder {
+	return m.recorder
+}
+
+// AWSContainers mocks base method
+func (m *MockAWSPeeringConnectionProvisioner) AWSContainers(arg0 string) ([]mongodbatlas.Container, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AWSContainers", arg0)
+	ret0, _ := ret[0].([]mongodbatlas.Container)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AWSContainers indicates an expected call of AWSContainers
+func (mr *MockAWSPeeringConnectionProvisionerMockRecorder) AWSContainers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AWSContainers", reflect.TypeOf((*MockAWSPeeringConnectionProvisioner)(nil).AWSContainers), arg0)
+}
+
+// CreateContainer mocks base method
+func (m *MockAWSPeeringConnectionProvisioner) CreateContainer(arg0 string, arg1 *mongodbatlas.Container) (*mongodbatlas.Container, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContainer", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Container)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContainer indicates an expected call of CreateContainer
+func (mr *MockAWSPeeringConnectionProvisionerMockRecorder) CreateContainer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContainer", reflect.TypeOf((*MockAWSPeeringConnectionProvisioner)(nil).CreateContainer), arg0, arg1)
+}
+
+// CreatePeeringConnection mocks base method
+func (m *MockAWSPeeringConnectionProvisioner) CreatePeeringConnection(arg0 string, arg1 *mongodbatlas.Peer) (*mongodbatlas.Peer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePeeringConnection", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Peer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePeeringConnection indicates an expected call of CreatePeeringConnection
+func (mr *MockAWSPeeringConnectionProvisionerMockRecorder) CreatePeeringConnection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePeeringConnection", reflect.TypeOf((*MockAWSPeeringConnectionProvisioner)(nil).CreatePeeringConnection), arg0, arg1)
+}
+
+// CreateProjectIPAccessList mocks base method
+func (m *MockAWSPeeringConnectionProvisioner) CreateProjectIPAccessList(arg0 []*mongodbatlas.ProjectIPAccessList) (*mongodbatlas.ProjectIPAccessLists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectIPAccessList", arg0)
+	ret0, _ := ret[0].(*mongodbatlas.ProjectIPAccessLists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectIPAccessList indicates an expected call of CreateProjectIPAccessList
+func (mr *MockAWSPeeringConnectionProvisionerMockRecorder) CreateProjectIPAccessList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectIPAccessList", reflect.TypeOf((*MockAWSPeeringConnectionProvisioner)(nil).CreateProjectIPAccessList), arg0)
+}
+
+// PeeringConnection mocks base method
+func (m *MockAWSPeeringConnectionProvisioner) PeeringConnection(arg0, arg1 string) (*mongodbatlas.Peer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeeringConnection", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Peer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeeringConnection indicates an expected call of PeeringConnection
+func (mr *MockAWSPeeringConnectionProvisionerMockRecorder) PeeringConnection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeeringConnection", reflect.TypeOf((*MockAWSPeeringConnectionProvisioner)(nil).PeeringConnection), arg0, arg1)
+}
+
+// PeeringConnections mocks base method
+func (m *MockAWSPeeringConnectionProvisioner) PeeringConnections(arg0 string, arg1 *mongodbatlas.ContainersListOptions) ([]mongodbatlas.Peer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeeringConnections", arg0, arg1)
+	ret0, _ := ret[0].([]mongodbatlas.Peer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeeringConnections indicates an expected call of PeeringConnections
+func (mr *MockAWSPeeringConnectionProvisionerMockRecorder) PeeringConnections(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeeringConnections", reflect.TypeOf((*MockAWSPeeringConnectionProvisioner)(nil).PeeringConnections), arg0, arg1)
+}
+
+// MockAzurePeeringConnectionProvisioner is a mock of AzurePeeringConnectionProvisioner interface
+type MockAzurePeeringConnectionProvisioner struct {
+	ctrl     *gomock.Controller
+	recorder *MockAzurePeeringConnectionProvisionerMockRecorder
+}
+
+// MockAzurePeeringConnectionProvisionerMockRecorder is the mock recorder for MockAzurePeeringConnectionProvisioner
+type MockAzurePeeringConnectionProvisionerMockRecorder struct {
+	mock *MockAzurePeeringConnectionProvisioner
+}
+
+// NewMockAzurePeeringConnectionProvisioner creates a new mock instance
+func NewMockAzurePeeringConnectionProvisioner(ctrl *gomock.Controller) *MockAzurePeeringConnectionProvisioner {
+	mock := &MockAzurePeeringConnectionProvisioner{ctrl: ctrl}
+	mock.recorder = &MockAzurePeeringConnectionProvisionerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAzurePeeringConnectionProvisioner) EXPECT() *MockAzurePeeringConnectionProvisionerMockRecorder {
+	return m.recorder
+}
+
+// AzureContainers mocks base method
+func (m *MockAzurePeeringConnectionProvisioner) AzureContainers(arg0 string) ([]mongodbatlas.Container, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureContainers", arg0)
+	ret0, _ := ret[0].([]mongodbatlas.Container)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AzureContainers indicates an expected call of AzureContainers
+func (mr *MockAzurePeeringConnectionProvisionerMockRecorder) AzureContainers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureContainers", reflect.TypeOf((*MockAzurePeeringConnectionProvisioner)(nil).AzureContainers), arg0)
+}
+
+// CreateContainer mocks base method
+func (m *MockAzurePeeringConnectionProvisioner) CreateContainer(arg0 string, arg1 *mongodbatlas.Container) (*mongodbatlas.Container, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContainer", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Container)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContainer indicates an expected call of CreateContainer
+func (mr *MockAzurePeeringConnectionProvisionerMockRecorder) CreateContainer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContainer", reflect.TypeOf((*MockAzurePeeringConnectionProvisioner)(nil).CreateContainer), arg0, arg1)
+}
+
+// CreatePeeringConnection mocks base method
+func (m *MockAzurePeeringConnectionProvisioner) CreatePeeringConnection(arg0 string, arg1 *mongodbatlas.Peer) (*mongodbatlas.Peer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePeeringConnection", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Peer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePeeringConnection indicates an expected call of CreatePeeringConnection
+func (mr *MockAzurePeeringConnectionProvisionerMockRecorder) CreatePeeringConnection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePeeringConnection", reflect.TypeOf((*MockAzurePeeringConnectionProvisioner)(nil).CreatePeeringConnection), arg0, arg1)
+}
+
+// PeeringConnection mocks base method
+func (m *MockAzurePeeringConnectionProvisioner) PeeringConnection(arg0, arg1 string) (*mongodbatlas.Peer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeeringConnection", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Peer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeeringConnection indicates an expected call of PeeringConnection
+func (mr *MockAzurePeeringConnectionProvisionerMockRecorder) PeeringConnection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeeringConnection", reflect.TypeOf((*MockAzurePeeringConnectionProvisioner)(nil).PeeringConnection), arg0, arg1)
+}
+
+// PeeringConnections mocks base method
+func (m *MockAzurePeeringConnectionProvisioner) PeeringConnections(arg0 string, arg1 *mongodbatlas.ContainersListOptions) ([]mongodbatlas.Peer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeeringConnections", arg0, arg1)
+	ret0, _ := ret[0].([]mongodbatlas.Peer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeeringConnections indicates an expected call of PeeringConnections
+func (mr *MockAzurePeeringConnectionProvisionerMockRecorder) PeeringConnections(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeeringConnections", reflect.TypeOf((*MockAzurePeeringConnectionProvisioner)(nil).PeeringConnections), arg0, arg1)
+}
+
+// MockGCPPeeringConnectionProvisioner is a mock of GCPPeeringConnectionProvisioner interface
+type MockGCPPeeringConnectionProvisioner struct {
+	ctrl     *gomock.Controller
+	recorder *MockGCPPeeringConnectionProvisionerMockRecorder
+}
+
+// MockGCPPeeringConnectionProvisionerMockRecorder is the mock recorder for MockGCPPeeringConnectionProvisioner
+type MockGCPPeeringConnectionProvisionerMockRecorder struct {
+	mock *MockGCPPeeringConnectionProvisioner
+}
+
+// NewMockGCPPeeringConnectionProvisioner creates a new mock instance
+func NewMockGCPPeeringConnectionProvisioner(ctrl *gomock.Controller) *MockGCPPeeringConnectionProvisioner {
+	mock := &MockGCPPeeringConnectionProvisioner{ctrl: ctrl}
+	mock.recorder = &MockGCPPeeringConnectionProvisionerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGCPPeeringConnectionProvisioner) EXPECT() *MockGCPPeeringConnectionProvisionerMockRecorder {
+	return m.recorder
+}
+
+// CreateContainer mocks base method
+func (m *MockGCPPeeringConnectionProvisioner) CreateContainer(arg0 string, arg1 *mongodbatlas.Container) (*mongodbatlas.Container, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContainer", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Container)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContainer indicates an expected call of CreateContainer
+func (mr *MockGCPPeeringConnectionProvisionerMockRecorder) CreateContainer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContainer", reflect.TypeOf((*MockGCPPeeringConnectionProvisioner)(nil).CreateContainer), arg0, arg1)
+}
+
+// CreatePeeringConnection mocks base method
+func (m *MockGCPPeeringConnectionProvisioner) CreatePeeringConnection(arg0 string, arg1 *mongodbatlas.Peer) (*mongodbatlas.Peer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePeeringConnection", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Peer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePeeringConnection indicates an expected call of CreatePeeringConnection
+func (mr *MockGCPPeeringConnectionProvisionerMockRecorder) CreatePeeringConnection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePeeringConnection", reflect.TypeOf((*MockGCPPeeringConnectionProvisioner)(nil).CreatePeeringConnection), arg0, arg1)
+}
+
+// GCPContainers mocks base method
+func (m *MockGCPPeeringConnectionProvisioner) GCPContainers(arg0 string) ([]mongodbatlas.Container, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GCPContainers", arg0)
+	ret0, _ := ret[0].([]mongodbatlas.Container)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GCPContainers indicates an expected call of GCPContainers
+func (mr *MockGCPPeeringConnectionProvisionerMockRecorder) GCPContainers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GCPContainers", reflect.TypeOf((*MockGCPPeeringConnectionProvisioner)(nil).GCPContainers), arg0)
+}
+
+// PeeringConnection mocks base method
+func (m *MockGCPPeeringConnectionProvisioner) PeeringConnection(arg0, arg1 string) (*mongodbatlas.Peer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeeringConnection", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Peer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeeringConnection indicates an expected call of PeeringConnection
+func (mr *MockGCPPeeringConnectionProvisionerMockRecorder) PeeringConnection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeeringConnection", reflect.TypeOf((*MockGCPPeeringConnectionProvisioner)(nil).PeeringConnection), arg0, arg1)
+}
+
+// PeeringConnections mocks base method
+func (m *MockGCPPeeringConnectionProvisioner) PeeringConnections(arg0 string, arg1 *mongodbatlas.ContainersListOptions) ([]mongodbatlas.Peer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeeringConnections", arg0, arg1)
+	ret0, _ := ret[0].([]mongodbatlas.Peer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeeringConnections indicates an expected call of PeeringConnections
+func (mr *MockGCPPeeringConnectionProvisionerMockRecorder) PeeringConnections(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeeringConnections", reflect.TypeOf((*MockGCPPeeringConnectionProvisioner)(nil).PeeringConnections), arg0, arg1)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: PrivateEndpointLister,PrivateEndpointDescriber,PrivateEndpointCreator,PrivateEndpointDeleter,InterfaceEndpointDescriber,InterfaceEndpointCreator,InterfaceEndpointDeleter,RegionalizedPrivateEndpointSettingUpdater,RegionalizedPrivateEndpointSettingDescriber)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: PrivateEndpointLister,PrivateEndpointDescriber,PrivateEndpointCreator,PrivateEndpointDeleter,InterfaceEndpointDescriber,InterfaceEndpointCreator,InterfaceEndpointDeleter,RegionalizedPrivateEndpointSettingUpdater,RegionalizedPrivateEndpointSettingDescriber,PrivateEndpointServiceFinder,PrivateEndpointProvisioner)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -349,3 +349,139 @@ func (mr *MockRegionalizedPrivateEndpointSettingDescriberMockRecorder) Regionali
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionalizedPrivateEndpointSetting", reflect.TypeOf((*MockRegionalizedPrivateEndpointSettingDescriber)(nil).RegionalizedPrivateEndpointSetting), arg0)
 }
+
+// MockPrivateEndpointServiceFinder is a mock of PrivateEndpointServiceFinder interface
+type MockPrivateEndpointServiceFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivateEndpointServiceFinderMockRecorder
+}
+
+// MockPrivateEndpointServiceFinderMockRecorder is the mock recorder for MockPrivateEndpointServiceFinder
+type MockPrivateEndpointServiceFinderMockRecorder struct {
+	mock *MockPrivateEndpointServiceFinder
+}
+
+// NewMockPrivateEndpointServiceFinder creates a new mock instance
+func NewMockPrivateEndpointServiceFinder(ctrl *gomock.Controller) *MockPrivateEndpointServiceFinder {
+	mock := &MockPrivateEndpointServiceFinder{ctrl: ctrl}
+	mock.recorder = &MockPrivateEndpointServiceFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPrivateEndpointServiceFinder) EXPECT() *MockPrivateEndpointServiceFinderMockRecorder {
+	return m.recorder
+}
+
+// PrivateEndpointService mocks base method
+func (m *MockPrivateEndpointServiceFinder) PrivateEndpointService(arg0, arg1, arg2 string) (*mongodbatlas.PrivateEndpointConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateEndpointService", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.PrivateEndpointConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateEndpointService indicates an expected call of PrivateEndpointService
+func (mr *MockPrivateEndpointServiceFinderMockRecorder) PrivateEndpointService(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateEndpointService", reflect.TypeOf((*MockPrivateEndpointServiceFinder)(nil).PrivateEndpointService), arg0, arg1, arg2)
+}
+
+// MockPrivateEndpointProvisioner is a mock of PrivateEndpointProvisioner interface
+type MockPrivateEndpointProvisioner struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivateEndpointProvisionerMockRecorder
+}
+
+// MockPrivateEndpointProvisionerMockRecorder is the mock recorder for MockPrivateEndpointProvisioner
+type MockPrivateEndpointProvisionerMockRecorder struct {
+	mock *MockPrivateEndpointProvisioner
+}
+
+// NewMockPrivateEndpointProvisioner creates a new mock instance
+func NewMockPrivateEndpointProvisioner(ctrl *gomock.Controller) *MockPrivateEndpointProvisioner {
+	mock := &MockPrivateEndpointProvisioner{ctrl: ctrl}
+	mock.recorder = &MockPrivateEndpointProvisionerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPrivateEndpointProvisioner) EXPECT() *MockPrivateEndpointProvisionerMockRecorder {
+	return m.recorder
+}
+
+// CreateInterfaceEndpoint mocks base method
+func (m *MockPrivateEndpointProvisioner) CreateInterfaceEndpoint(arg0, arg1, arg2 string, arg3 *mongodbatlas.InterfaceEndpointConnection) (*mongodbatlas.InterfaceEndpointConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterfaceEndpoint", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*mongodbatlas.InterfaceEndpointConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterfaceEndpoint indicates an expected call of CreateInterfaceEndpoint
+func (mr *MockPrivateEndpointProvisionerMockRecorder) CreateInterfaceEndpoint(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterfaceEndpoint", reflect.TypeOf((*MockPrivateEndpointProvisioner)(nil).CreateInterfaceEndpoint), arg0, arg1, arg2, arg3)
+}
+
+// CreatePrivateEndpoint mocks base method
+func (m *MockPrivateEndpointProvisioner) CreatePrivateEndpoint(arg0 string, arg1 *mongodbatlas.PrivateEndpointConnection) (*mongodbatlas.PrivateEndpointConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivateEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.PrivateEndpointConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrivateEndpoint indicates an expected call of CreatePrivateEndpoint
+func (mr *MockPrivateEndpointProvisionerMockRecorder) CreatePrivateEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateEndpoint", reflect.TypeOf((*MockPrivateEndpointProvisioner)(nil).CreatePrivateEndpoint), arg0, arg1)
+}
+
+// InterfaceEndpoint mocks base method
+func (m *MockPrivateEndpointProvisioner) InterfaceEndpoint(arg0, arg1, arg2, arg3 string) (*mongodbatlas.InterfaceEndpointConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceEndpoint", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*mongodbatlas.InterfaceEndpointConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceEndpoint indicates an expected call of InterfaceEndpoint
+func (mr *MockPrivateEndpointProvisionerMockRecorder) InterfaceEndpoint(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceEndpoint", reflect.TypeOf((*MockPrivateEndpointProvisioner)(nil).InterfaceEndpoint), arg0, arg1, arg2, arg3)
+}
+
+// PrivateEndpoint mocks base method
+func (m *MockPrivateEndpointProvisioner) PrivateEndpoint(arg0, arg1, arg2 string) (*mongodbatlas.PrivateEndpointConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateEndpoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.PrivateEndpointConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateEndpoint indicates an expected call of PrivateEndpoint
+func (mr *MockPrivateEndpointProvisionerMockRecorder) PrivateEndpoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateEndpoint", reflect.TypeOf((*MockPrivateEndpointProvisioner)(nil).PrivateEndpoint), arg0, arg1, arg2)
+}
+
+// PrivateEndpointService mocks base method
+func (m *MockPrivateEndpointProvisioner) PrivateEndpointService(arg0, arg1, arg2 string) (*mongodbatlas.PrivateEndpointConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateEndpointService", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.PrivateEndpointConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateEndpointService indicates an expected call of PrivateEndpointService
+func (mr *MockPrivateEndpointProvisionerMockRecorder) PrivateEndpointService(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateEndpointService", reflect.TypeOf((*MockPrivateEndpointProvisioner)(nil).PrivateEndpointService), arg0, arg1, arg2)
+}
@@ -1,14 +1,13 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: ProjectIPAccessListDescriber,ProjectIPAccessListLister,ProjectIPAccessListCreator,ProjectIPAccessListDeleter)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: ProjectIPAccessListDescriber,ProjectIPAccessListLister,ProjectIPAccessListCreator,ProjectIPAccessListDeleter,ProjectIPAccessListApplier)
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
-	reflect "reflect"
-
 	gomock "github.com/golang/mock/gomock"
 	mongodbatlas "go.mongodb.org/atlas/mongodbatlas"
+	reflect "reflect"
 )
 
 // MockProjectIPAccessListDescriber is a mock of ProjectIPAccessListDescriber interface
@@ -161,3 +160,70 @@ func (mr *MockProjectIPAccessListDeleterMockRecorder) DeleteProjectIPAccessList(
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectIPAccessList", reflect.TypeOf((*MockProjectIPAccessListDeleter)(nil).DeleteProjectIPAccessList), arg0, arg1)
 }
+
+// MockProjectIPAccessListApplier is a mock of ProjectIPAccessListApplier interface
+type MockProjectIPAccessListApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectIPAccessListApplierMockRecorder
+}
+
+// MockProjectIPAccessListApplierMockRecorder is the mock recorder for MockProjectIPAccessListApplier
+type MockProjectIPAccessListApplierMockRecorder struct {
+	mock *MockProjectIPAccessListApplier
+}
+
+// NewMockProjectIPAccessListApplier creates a new mock instance
+func NewMockProjectIPAccessListApplier(ctrl *gomock.Controller) *MockProjectIPAccessListApplier {
+	mock := &MockProjectIPAccessListApplier{ctrl: ctrl}
+	mock.recorder = &MockProjectIPAccessListApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockProjectIPAccessListApplier) EXPECT() *MockProjectIPAccessListApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateProjectIPAccessList mocks base method
+func (m *MockProjectIPAccessListApplier) CreateProjectIPAccessList(arg0 []*mongodbatlas.ProjectIPAccessList) (*mongodbatlas.ProjectIPAccessLists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectIPAccessList", arg0)
+	ret0, _ := ret[0].(*mongodbatlas.ProjectIPAccessLists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectIPAccessList indicates an expected call of CreateProjectIPAccessList
+func (mr *MockProjectIPAccessListApplierMockRecorder) CreateProjectIPAccessList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectIPAccessList", reflect.TypeOf((*MockProjectIPAccessListApplier)(nil).CreateProjectIPAccessList), arg0)
+}
+
+// DeleteProjectIPAccessList mocks base method
+func (m *MockProjectIPAccessListApplier) DeleteProjectIPAccessList(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectIPAccessList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectIPAccessList indicates an expected call of DeleteProjectIPAccessList
+func (mr *MockProjectIPAccessListApplierMockRecorder) DeleteProjectIPAccessList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectIPAccessList", reflect.TypeOf((*MockProjectIPAccessListApplier)(nil).DeleteProjectIPAccessList), arg0, arg1)
+}
+
+// ProjectIPAccessLists mocks base method
+func (m *MockProjectIPAccessListApplier) ProjectIPAccessLists(arg0 string, arg1 *mongodbatlas.ListOptions) (*mongodbatlas.ProjectIPAccessLists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectIPAccessLists", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.ProjectIPAccessLists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectIPAccessLists indicates an expected call of ProjectIPAccessLists
+func (mr *MockProjectIPAccessListApplierMockRecorder) ProjectIPAccessLists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectIPAccessLists", reflect.TypeOf((*MockProjectIPAccessListApplier)(nil).ProjectIPAccessLists), arg0, arg1)
+}
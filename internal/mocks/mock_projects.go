@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/mongodb/mongocli/internal/store (interfaces: ProjectLister,OrgProjectLister,ProjectCreator,ProjectDeleter,ProjectDescriber,ProjectUsersLister,ProjectUserDeleter,ProjectTeamLister,ProjectTeamAdder,ProjectTeamDeleter)
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: ProjectLister,OrgProjectLister,ProjectCreator,ProjectDeleter,ProjectDescriber,ProjectUsersLister,ProjectUserDeleter,ProjectTeamLister,ProjectTeamAdder,ProjectTeamDeleter,InventoryLister,ProjectProvisioner)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -7,6 +7,7 @@ package mocks
 import (
 	gomock "github.com/golang/mock/gomock"
 	mongodbatlas "go.mongodb.org/atlas/mongodbatlas"
+	opsmngr "go.mongodb.org/ops-manager/opsmngr"
 	reflect "reflect"
 )
 
@@ -401,3 +402,460 @@ func (mr *MockProjectTeamDeleterMockRecorder) DeleteTeamFromProject(arg0, arg1 i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeamFromProject", reflect.TypeOf((*MockProjectTeamDeleter)(nil).DeleteTeamFromProject), arg0, arg1)
 }
+
+// MockInventoryLister is a mock of InventoryLister interface
+type MockInventoryLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryListerMockRecorder
+}
+
+// MockInventoryListerMockRecorder is the mock recorder for MockInventoryLister
+type MockInventoryListerMockRecorder struct {
+	mock *MockInventoryLister
+}
+
+// NewMockInventoryLister creates a new mock instance
+func NewMockInventoryLister(ctrl *gomock.Controller) *MockInventoryLister {
+	mock := &MockInventoryLister{ctrl: ctrl}
+	mock.recorder = &MockInventoryListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInventoryLister) EXPECT() *MockInventoryListerMockRecorder {
+	return m.recorder
+}
+
+// AgentProjectVersions mocks base method
+func (m *MockInventoryLister) AgentProjectVersions(arg0 string) (*opsmngr.AgentVersions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AgentProjectVersions", arg0)
+	ret0, _ := ret[0].(*opsmngr.AgentVersions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AgentProjectVersions indicates an expected call of AgentProjectVersions
+func (mr *MockInventoryListerMockRecorder) AgentProjectVersions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgentProjectVersions", reflect.TypeOf((*MockInventoryLister)(nil).AgentProjectVersions), arg0)
+}
+
+// DatabaseUsers mocks base method
+func (m *MockInventoryLister) DatabaseUsers(arg0 string, arg1 *mongodbatlas.ListOptions) ([]mongodbatlas.DatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUsers", arg0, arg1)
+	ret0, _ := ret[0].([]mongodbatlas.DatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUsers indicates an expected call of DatabaseUsers
+func (mr *MockInventoryListerMockRecorder) DatabaseUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockInventoryLister)(nil).DatabaseUsers), arg0, arg1)
+}
+
+// GetAutomationConfig mocks base method
+func (m *MockInventoryLister) GetAutomationConfig(arg0 string) (*opsmngr.AutomationConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationConfig", arg0)
+	ret0, _ := ret[0].(*opsmngr.AutomationConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutomationConfig indicates an expected call of GetAutomationConfig
+func (mr *MockInventoryListerMockRecorder) GetAutomationConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationConfig", reflect.TypeOf((*MockInventoryLister)(nil).GetAutomationConfig), arg0)
+}
+
+// GetOrgProjects mocks base method
+func (m *MockInventoryLister) GetOrgProjects(arg0 string, arg1 *mongodbatlas.ListOptions) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgProjects", arg0, arg1)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgProjects indicates an expected call of GetOrgProjects
+func (mr *MockInventoryListerMockRecorder) GetOrgProjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgProjects", reflect.TypeOf((*MockInventoryLister)(nil).GetOrgProjects), arg0, arg1)
+}
+
+// Hosts mocks base method
+func (m *MockInventoryLister) Hosts(arg0 string, arg1 *opsmngr.HostListOptions) (*opsmngr.Hosts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hosts", arg0, arg1)
+	ret0, _ := ret[0].(*opsmngr.Hosts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hosts indicates an expected call of Hosts
+func (mr *MockInventoryListerMockRecorder) Hosts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hosts", reflect.TypeOf((*MockInventoryLister)(nil).Hosts), arg0, arg1)
+}
+
+// Integrations mocks base method
+func (m *MockInventoryLister) Integrations(arg0 string) (*mongodbatlas.ThirdPartyIntegrations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Integrations", arg0)
+	ret0, _ := ret[0].(*mongodbatlas.ThirdPartyIntegrations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Integrations indicates an expected call of Integrations
+func (mr *MockInventoryListerMockRecorder) Integrations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Integrations", reflect.TypeOf((*MockInventoryLister)(nil).Integrations), arg0)
+}
+
+// ListAllProjectClusters mocks base method
+func (m *MockInventoryLister) ListAllProjectClusters() (*opsmngr.AllClustersProjects, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllProjectClusters")
+	ret0, _ := ret[0].(*opsmngr.AllClustersProjects)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllProjectClusters indicates an expected call of ListAllProjectClusters
+func (mr *MockInventoryListerMockRecorder) ListAllProjectClusters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllProjectClusters", reflect.TypeOf((*MockInventoryLister)(nil).ListAllProjectClusters))
+}
+
+// Processes mocks base method
+func (m *MockInventoryLister) Processes(arg0 string, arg1 *mongodbatlas.ProcessesListOptions) ([]*mongodbatlas.Process, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Processes", arg0, arg1)
+	ret0, _ := ret[0].([]*mongodbatlas.Process)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Processes indicates an expected call of Processes
+func (mr *MockInventoryListerMockRecorder) Processes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Processes", reflect.TypeOf((*MockInventoryLister)(nil).Processes), arg0, arg1)
+}
+
+// ProjectClusters mocks base method
+func (m *MockInventoryLister) ProjectClusters(arg0 string, arg1 *mongodbatlas.ListOptions) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectClusters", arg0, arg1)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectClusters indicates an expected call of ProjectClusters
+func (mr *MockInventoryListerMockRecorder) ProjectClusters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectClusters", reflect.TypeOf((*MockInventoryLister)(nil).ProjectClusters), arg0, arg1)
+}
+
+// Projects mocks base method
+func (m *MockInventoryLister) Projects(arg0 *mongodbatlas.ListOptions) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Projects", arg0)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Projects indicates an expected call of Projects
+func (mr *MockInventoryListerMockRecorder) Projects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Projects", reflect.TypeOf((*MockInventoryLister)(nil).Projects), arg0)
+}
+
+// MockProjectProvisioner is a mock of ProjectProvisioner interface
+type MockProjectProvisioner struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectProvisionerMockRecorder
+}
+
+// MockProjectProvisionerMockRecorder is the mock recorder for MockProjectProvisioner
+type MockProjectProvisionerMockRecorder struct {
+	mock *MockProjectProvisioner
+}
+
+// NewMockProjectProvisioner creates a new mock instance
+func NewMockProjectProvisioner(ctrl *gomock.Controller) *MockProjectProvisioner {
+	mock := &MockProjectProvisioner{ctrl: ctrl}
+	mock.recorder = &MockProjectProvisionerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockProjectProvisioner) EXPECT() *MockProjectProvisionerMockRecorder {
+	return m.recorder
+}
+
+// AddTeamsToProject mocks base method
+func (m *MockProjectProvisioner) AddTeamsToProject(arg0 string, arg1 []*mongodbatlas.ProjectTeam) (*mongodbatlas.TeamsAssigned, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamsToProject", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.TeamsAssigned)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTeamsToProject indicates an expected call of AddTeamsToProject
+func (mr *MockProjectProvisionerMockRecorder) AddTeamsToProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamsToProject", reflect.TypeOf((*MockProjectProvisioner)(nil).AddTeamsToProject), arg0, arg1)
+}
+
+// ClearMaintenanceWindow mocks base method
+func (m *MockProjectProvisioner) ClearMaintenanceWindow(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearMaintenanceWindow", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearMaintenanceWindow indicates an expected call of ClearMaintenanceWindow
+func (mr *MockProjectProvisionerMockRecorder) ClearMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearMaintenanceWindow", reflect.TypeOf((*MockProjectProvisioner)(nil).ClearMaintenanceWindow), arg0)
+}
+
+// CreateAlertConfiguration mocks base method
+func (m *MockProjectProvisioner) CreateAlertConfiguration(arg0 *mongodbatlas.AlertConfiguration) (*mongodbatlas.AlertConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertConfiguration", arg0)
+	ret0, _ := ret[0].(*mongodbatlas.AlertConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertConfiguration indicates an expected call of CreateAlertConfiguration
+func (mr *MockProjectProvisionerMockRecorder) CreateAlertConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertConfiguration", reflect.TypeOf((*MockProjectProvisioner)(nil).CreateAlertConfiguration), arg0)
+}
+
+// CreateDatabaseRole mocks base method
+func (m *MockProjectProvisioner) CreateDatabaseRole(arg0 string, arg1 *mongodbatlas.CustomDBRole) (*mongodbatlas.CustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabaseRole", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.CustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabaseRole indicates an expected call of CreateDatabaseRole
+func (mr *MockProjectProvisionerMockRecorder) CreateDatabaseRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabaseRole", reflect.TypeOf((*MockProjectProvisioner)(nil).CreateDatabaseRole), arg0, arg1)
+}
+
+// CreateDatabaseUser mocks base method
+func (m *MockProjectProvisioner) CreateDatabaseUser(arg0 *mongodbatlas.DatabaseUser) (*mongodbatlas.DatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabaseUser", arg0)
+	ret0, _ := ret[0].(*mongodbatlas.DatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabaseUser indicates an expected call of CreateDatabaseUser
+func (mr *MockProjectProvisionerMockRecorder) CreateDatabaseUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabaseUser", reflect.TypeOf((*MockProjectProvisioner)(nil).CreateDatabaseUser), arg0)
+}
+
+// CreateIntegration mocks base method
+func (m *MockProjectProvisioner) CreateIntegration(arg0, arg1 string, arg2 *mongodbatlas.ThirdPartyIntegration) (*mongodbatlas.ThirdPartyIntegrations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIntegration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*mongodbatlas.ThirdPartyIntegrations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIntegration indicates an expected call of CreateIntegration
+func (mr *MockProjectProvisionerMockRecorder) CreateIntegration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIntegration", reflect.TypeOf((*MockProjectProvisioner)(nil).CreateIntegration), arg0, arg1, arg2)
+}
+
+// CreateProject mocks base method
+func (m *MockProjectProvisioner) CreateProject(arg0, arg1 string) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", arg0, arg1)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject
+func (mr *MockProjectProvisionerMockRecorder) CreateProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectProvisioner)(nil).CreateProject), arg0, arg1)
+}
+
+// CreateProjectIPAccessList mocks base method
+func (m *MockProjectProvisioner) CreateProjectIPAccessList(arg0 []*mongodbatlas.ProjectIPAccessList) (*mongodbatlas.ProjectIPAccessLists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectIPAccessList", arg0)
+	ret0, _ := ret[0].(*mongodbatlas.ProjectIPAccessLists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectIPAccessList indicates an expected call of CreateProjectIPAccessList
+func (mr *MockProjectProvisionerMockRecorder) CreateProjectIPAccessList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectIPAccessList", reflect.TypeOf((*MockProjectProvisioner)(nil).CreateProjectIPAccessList), arg0)
+}
+
+// DeleteAlertConfiguration mocks base method
+func (m *MockProjectProvisioner) DeleteAlertConfiguration(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertConfiguration indicates an expected call of DeleteAlertConfiguration
+func (mr *MockProjectProvisionerMockRecorder) DeleteAlertConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertConfiguration", reflect.TypeOf((*MockProjectProvisioner)(nil).DeleteAlertConfiguration), arg0, arg1)
+}
+
+// DeleteDatabaseRole mocks base method
+func (m *MockProjectProvisioner) DeleteDatabaseRole(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabaseRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabaseRole indicates an expected call of DeleteDatabaseRole
+func (mr *MockProjectProvisionerMockRecorder) DeleteDatabaseRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseRole", reflect.TypeOf((*MockProjectProvisioner)(nil).DeleteDatabaseRole), arg0, arg1)
+}
+
+// DeleteDatabaseUser mocks base method
+func (m *MockProjectProvisioner) DeleteDatabaseUser(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabaseUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabaseUser indicates an expected call of DeleteDatabaseUser
+func (mr *MockProjectProvisionerMockRecorder) DeleteDatabaseUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseUser", reflect.TypeOf((*MockProjectProvisioner)(nil).DeleteDatabaseUser), arg0, arg1, arg2)
+}
+
+// DeleteIntegration mocks base method
+func (m *MockProjectProvisioner) DeleteIntegration(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIntegration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIntegration indicates an expected call of DeleteIntegration
+func (mr *MockProjectProvisionerMockRecorder) DeleteIntegration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIntegration", reflect.TypeOf((*MockProjectProvisioner)(nil).DeleteIntegration), arg0, arg1)
+}
+
+// DeleteProject mocks base method
+func (m *MockProjectProvisioner) DeleteProject(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject
+func (mr *MockProjectProvisionerMockRecorder) DeleteProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectProvisioner)(nil).DeleteProject), arg0)
+}
+
+// DeleteProjectIPAccessList mocks base method
+func (m *MockProjectProvisioner) DeleteProjectIPAccessList(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectIPAccessList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectIPAccessList indicates an expected call of DeleteProjectIPAccessList
+func (mr *MockProjectProvisionerMockRecorder) DeleteProjectIPAccessList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectIPAccessList", reflect.TypeOf((*MockProjectProvisioner)(nil).DeleteProjectIPAccessList), arg0, arg1)
+}
+
+// DeleteTeamFromProject mocks base method
+func (m *MockProjectProvisioner) DeleteTeamFromProject(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeamFromProject", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeamFromProject indicates an expected call of DeleteTeamFromProject
+func (mr *MockProjectProvisionerMockRecorder) DeleteTeamFromProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeamFromProject", reflect.TypeOf((*MockProjectProvisioner)(nil).DeleteTeamFromProject), arg0, arg1)
+}
+
+// TeamByID mocks base method
+func (m *MockProjectProvisioner) TeamByID(arg0, arg1 string) (*mongodbatlas.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TeamByID", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TeamByID indicates an expected call of TeamByID
+func (mr *MockProjectProvisionerMockRecorder) TeamByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TeamByID", reflect.TypeOf((*MockProjectProvisioner)(nil).TeamByID), arg0, arg1)
+}
+
+// TeamByName mocks base method
+func (m *MockProjectProvisioner) TeamByName(arg0, arg1 string) (*mongodbatlas.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TeamByName", arg0, arg1)
+	ret0, _ := ret[0].(*mongodbatlas.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TeamByName indicates an expected call of TeamByName
+func (mr *MockProjectProvisionerMockRecorder) TeamByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TeamByName", reflect.TypeOf((*MockProjectProvisioner)(nil).TeamByName), arg0, arg1)
+}
+
+// UpdateMaintenanceWindow mocks base method
+func (m *MockProjectProvisioner) UpdateMaintenanceWindow(arg0 string, arg1 *mongodbatlas.MaintenanceWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMaintenanceWindow indicates an expected call of UpdateMaintenanceWindow
+func (mr *MockProjectProvisionerMockRecorder) UpdateMaintenanceWindow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindow", reflect.TypeOf((*MockProjectProvisioner)(nil).UpdateMaintenanceWindow), arg0, arg1)
+}
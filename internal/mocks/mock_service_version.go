@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/mongodb/mongocli/internal/store (interfaces: ServiceVersionGetter)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockServiceVersionGetter is a mock of ServiceVersionGetter interface
+type MockServiceVersionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceVersionGetterMockRecorder
+}
+
+// MockServiceVersionGetterMockRecorder is the mock recorder for MockServiceVersionGetter
+type MockServiceVersionGetterMockRecorder struct {
+	mock *MockServiceVersionGetter
+}
+
+// NewMockServiceVersionGetter creates a new mock instance
+func NewMockServiceVersionGetter(ctrl *gomock.Controller) *MockServiceVersionGetter {
+	mock := &MockServiceVersionGetter{ctrl: ctrl}
+	mock.recorder = &MockServiceVersionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockServiceVersionGetter) EXPECT() *MockServiceVersionGetterMockRecorder {
+	return m.recorder
+}
+
+// ServiceVersion mocks base method
+func (m *MockServiceVersionGetter) ServiceVersion() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceVersion")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceVersion indicates an expected call of ServiceVersion
+func (mr *MockServiceVersionGetterMockRecorder) ServiceVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceVersion", reflect.TypeOf((*MockServiceVersionGetter)(nil).ServiceVersion))
+}
@@ -14,19 +14,30 @@
 package mongosh
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"os/signal"
 	"runtime"
-	"syscall"
 )
 
 const (
 	mongoShLinux   = "mongosh"
 	mongoShWindows = "mongosh.exe"
 	windows        = "windows"
+	exeSuffix      = ".exe"
+	defaultAuthDB  = "admin"
 )
 
+// Credentials authenticate the shell once connected
+type Credentials struct {
+	Username string
+	Password string
+	AuthDB   string
+}
+
 func FindBinaryInPath() string {
 	binary := mongoShLinux
 	if runtime.GOOS == windows {
@@ -40,16 +51,84 @@ func FindBinaryInPath() string {
 	return ""
 }
 
+// LookPath returns the path of the shell, like mongo or mongosh
+func LookPath(shell string) (string, error) {
+	binary := shell
+	if runtime.GOOS == windows {
+		binary += exeSuffix
+	}
+	path, err := exec.LookPath(binary)
+	if err != nil {
+		return "", fmt.Errorf("%s not found in your PATH", shell)
+	}
+	return path, nil
+}
+
 func Run(binary, username, password, mongoURI string) error {
-	args := []string{"mongosh", "-u", username, "-p", password, mongoURI}
-	env := os.Environ()
-	err := syscall.Exec(binary, args, env)
+	return Connect(binary, mongoURI, &Credentials{Username: username, Password: password, AuthDB: defaultAuthDB}, nil)
+}
 
-	if err != nil {
-		return err
+// Connect opens the shell on the connection string, args are passed to the shell as they are.
+// The password is never on the command line, it's in a script readable only by the current user
+// that the shell runs before turning interactive, and the script is removed once the shell exits.
+func Connect(binary, mongoURI string, c *Credentials, args []string) error {
+	script := ""
+	if c != nil && c.Password != "" {
+		f, err := ioutil.TempFile("", "mongocli-auth-*.js")
+		if err != nil {
+			return err
+		}
+		script = f.Name()
+		defer os.Remove(script)
+		_, err = f.WriteString(authScript(c))
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
 	}
 
-	return nil
+	cmd := exec.Command(binary, shellArgs(mongoURI, c, script, args)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	// interrupts belong to the shell, which gets them from the terminal as well, the script must still be removed when it exits.
+	// They're caught rather than ignored, an ignored interrupt would be inherited by the shell
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+	return cmd.Run()
+}
+
+// shellArgs returns the arguments of the shell, without a script the shell prompts for the password of the user
+func shellArgs(mongoURI string, c *Credentials, script string, args []string) []string {
+	out := []string{mongoURI}
+	if script != "" {
+		out = append(out, "--shell")
+		out = append(out, args...)
+		return append(out, script)
+	}
+	if c != nil && c.Username != "" {
+		out = append(out, "--username", c.Username, "--authenticationDatabase", authDB(c))
+	}
+	return append(out, args...)
+}
+
+// authScript authenticates the connection of the shell, JSON strings are valid JavaScript strings
+func authScript(c *Credentials) string {
+	db, _ := json.Marshal(authDB(c))
+	username, _ := json.Marshal(c.Username)
+	password, _ := json.Marshal(c.Password)
+	return fmt.Sprintf("db.getSiblingDB(%s).auth(%s, %s);\n", db, username, password)
+}
+
+func authDB(c *Credentials) string {
+	if c.AuthDB == "" {
+		return defaultAuthDB
+	}
+	return c.AuthDB
 }
 
 func ValidateUniqueUsername(val interface{}) error {
@@ -0,0 +1,50 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package mongosh
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShellArgs(t *testing.T) {
+	c := &Credentials{Username: "user", Password: "secret"}
+	got := shellArgs("mongodb+srv://cluster0.mongodb.net", c, "/tmp/auth.js", []string{"--quiet"})
+	want := []string{"mongodb+srv://cluster0.mongodb.net", "--shell", "--quiet", "/tmp/auth.js"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	for _, arg := range got {
+		if arg == c.Password {
+			t.Fatal("the password must not be an argument of the shell")
+		}
+	}
+
+	got = shellArgs("mongodb://host0:27017", &Credentials{Username: "user", AuthDB: "test"}, "", nil)
+	want = []string{"mongodb://host0:27017", "--username", "user", "--authenticationDatabase", "test"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAuthScript(t *testing.T) {
+	got := authScript(&Credentials{Username: "user", Password: `se"cret`})
+	want := `db.getSiblingDB("admin").auth("user", "se\"cret");` + "\n"
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -48,7 +47,7 @@ func (s *Store) CreateCloudProviderAccessRole(groupID, provider string) (*atlas.
 		req := &atlas.CloudProviderAccessRoleRequest{
 			ProviderName: provider,
 		}
-		result, _, err := s.client.(*atlas.Client).CloudProviderAccess.CreateRole(context.Background(), groupID, req)
+		result, _, err := s.client.(*atlas.Client).CloudProviderAccess.CreateRole(s.ctx, groupID, req)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -59,7 +58,7 @@ func (s *Store) CreateCloudProviderAccessRole(groupID, provider string) (*atlas.
 func (s *Store) CloudProviderAccessRoles(groupID string) (*atlas.CloudProviderAccessRoles, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CloudProviderAccess.ListRoles(context.Background(), groupID)
+		result, _, err := s.client.(*atlas.Client).CloudProviderAccess.ListRoles(s.ctx, groupID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -70,7 +69,7 @@ func (s *Store) CloudProviderAccessRoles(groupID string) (*atlas.CloudProviderAc
 func (s *Store) DeauthorizeCloudProviderAccessRoles(req *atlas.CloudProviderDeauthorizationRequest) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).CloudProviderAccess.DeauthorizeRole(context.Background(), req)
+		_, err := s.client.(*atlas.Client).CloudProviderAccess.DeauthorizeRole(s.ctx, req)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -81,7 +80,7 @@ func (s *Store) DeauthorizeCloudProviderAccessRoles(req *atlas.CloudProviderDeau
 func (s *Store) AuthorizeCloudProviderAccessRole(groupID, roleID string, req *atlas.CloudProviderAuthorizationRequest) (*atlas.AWSIAMRole, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CloudProviderAccess.AuthorizeRole(context.Background(), groupID, roleID, req)
+		result, _, err := s.client.(*atlas.Client).CloudProviderAccess.AuthorizeRole(s.ctx, groupID, roleID, req)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
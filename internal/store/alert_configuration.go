@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -49,10 +48,10 @@ type MatcherFieldsLister interface {
 func (s *Store) AlertConfigurations(projectID string, opts *atlas.ListOptions) ([]atlas.AlertConfiguration, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).AlertConfigurations.List(context.Background(), projectID, opts)
+		result, _, err := s.client.(*atlas.Client).AlertConfigurations.List(s.ctx, projectID, opts)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).AlertConfigurations.List(context.Background(), projectID, opts)
+		result, _, err := s.client.(*opsmngr.Client).AlertConfigurations.List(s.ctx, projectID, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -63,10 +62,10 @@ func (s *Store) AlertConfigurations(projectID string, opts *atlas.ListOptions) (
 func (s *Store) CreateAlertConfiguration(alertConfig *atlas.AlertConfiguration) (*atlas.AlertConfiguration, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).AlertConfigurations.Create(context.Background(), alertConfig.GroupID, alertConfig)
+		result, _, err := s.client.(*atlas.Client).AlertConfigurations.Create(s.ctx, alertConfig.GroupID, alertConfig)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).AlertConfigurations.Create(context.Background(), alertConfig.GroupID, alertConfig)
+		result, _, err := s.client.(*opsmngr.Client).AlertConfigurations.Create(s.ctx, alertConfig.GroupID, alertConfig)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -77,10 +76,10 @@ func (s *Store) CreateAlertConfiguration(alertConfig *atlas.AlertConfiguration)
 func (s *Store) DeleteAlertConfiguration(projectID, id string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).AlertConfigurations.Delete(context.Background(), projectID, id)
+		_, err := s.client.(*atlas.Client).AlertConfigurations.Delete(s.ctx, projectID, id)
 		return err
 	case config.OpsManagerService, config.CloudManagerService:
-		_, err := s.client.(*opsmngr.Client).AlertConfigurations.Delete(context.Background(), projectID, id)
+		_, err := s.client.(*opsmngr.Client).AlertConfigurations.Delete(s.ctx, projectID, id)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -91,10 +90,10 @@ func (s *Store) DeleteAlertConfiguration(projectID, id string) error {
 func (s *Store) MatcherFields() ([]string, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).AlertConfigurations.ListMatcherFields(context.Background())
+		result, _, err := s.client.(*atlas.Client).AlertConfigurations.ListMatcherFields(s.ctx)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).AlertConfigurations.ListMatcherFields(context.Background())
+		result, _, err := s.client.(*opsmngr.Client).AlertConfigurations.ListMatcherFields(s.ctx)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -104,10 +103,10 @@ func (s *Store) MatcherFields() ([]string, error) {
 func (s *Store) UpdateAlertConfiguration(alertConfig *atlas.AlertConfiguration) (*atlas.AlertConfiguration, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).AlertConfigurations.Update(context.Background(), alertConfig.GroupID, alertConfig.ID, alertConfig)
+		result, _, err := s.client.(*atlas.Client).AlertConfigurations.Update(s.ctx, alertConfig.GroupID, alertConfig.ID, alertConfig)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).AlertConfigurations.Update(context.Background(), alertConfig.GroupID, alertConfig.ID, alertConfig)
+		result, _, err := s.client.(*opsmngr.Client).AlertConfigurations.Update(s.ctx, alertConfig.GroupID, alertConfig.ID, alertConfig)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -15,14 +15,13 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
 	"go.mongodb.org/ops-manager/opsmngr"
 )
 
-//go:generate mockgen -destination=../mocks/mock_automation.go -package=mocks github.com/mongodb/mongocli/internal/store AutomationGetter,AutomationUpdater,AutomationStatusGetter,AutomationPatcher,CloudManagerClustersLister,CloudManagerClustersDescriber,CloudManagerClustersDeleter
+//go:generate mockgen -destination=../mocks/mock_automation.go -package=mocks github.com/mongodb/mongocli/internal/store AutomationGetter,AutomationUpdater,AutomationStatusGetter,AutomationPatcher,CloudManagerClustersLister,CloudManagerClustersDescriber,CloudManagerClustersDeleter,CloudManagerClustersUpgrader
 
 type AutomationGetter interface {
 	GetAutomationConfig(string) (*opsmngr.AutomationConfig, error)
@@ -63,10 +62,16 @@ type CloudManagerClustersDeleter interface {
 	AutomationPatcher
 }
 
+type CloudManagerClustersUpgrader interface {
+	AutomationPatcher
+	AutomationStatusGetter
+	ServiceVersionGetter
+}
+
 func (s *Store) GetAutomationStatus(projectID string) (*opsmngr.AutomationStatus, error) {
 	switch s.service {
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Automation.GetStatus(context.Background(), projectID)
+		result, _, err := s.client.(*opsmngr.Client).Automation.GetStatus(s.ctx, projectID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -77,7 +82,7 @@ func (s *Store) GetAutomationStatus(projectID string) (*opsmngr.AutomationStatus
 func (s *Store) GetAutomationConfig(projectID string) (*opsmngr.AutomationConfig, error) {
 	switch s.service {
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Automation.GetConfig(context.Background(), projectID)
+		result, _, err := s.client.(*opsmngr.Client).Automation.GetConfig(s.ctx, projectID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -88,7 +93,7 @@ func (s *Store) GetAutomationConfig(projectID string) (*opsmngr.AutomationConfig
 func (s *Store) UpdateAutomationConfig(projectID string, automationConfig *opsmngr.AutomationConfig) error {
 	switch s.service {
 	case config.CloudManagerService, config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).Automation.UpdateConfig(context.Background(), projectID, automationConfig)
+		_, err := s.client.(*opsmngr.Client).Automation.UpdateConfig(s.ctx, projectID, automationConfig)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -15,15 +15,15 @@
 package store
 
 import (
-	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/mongodb/mongocli/internal/config"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
 	"go.mongodb.org/ops-manager/opsmngr"
 )
 
-//go:generate mockgen -destination=../mocks/mock_backup_config.go -package=mocks github.com/mongodb/mongocli/internal/store BackupConfigGetter,BackupConfigLister,BackupConfigUpdater
+//go:generate mockgen -destination=../mocks/mock_backup_config.go -package=mocks github.com/mongodb/mongocli/internal/store BackupConfigGetter,BackupConfigLister,BackupConfigUpdater,BackupReporter,BackupApplier
 
 type BackupConfigGetter interface {
 	GetBackupConfig(string, string) (*opsmngr.BackupConfig, error)
@@ -37,11 +37,29 @@ type BackupConfigUpdater interface {
 	UpdateBackupConfig(*opsmngr.BackupConfig) (*opsmngr.BackupConfig, error)
 }
 
+type BackupApplier interface {
+	ClusterLister
+	BackupConfigLister
+	BackupConfigUpdater
+	SnapshotScheduleDescriber
+	SnapshotScheduleUpdater
+}
+
+type BackupReporter interface {
+	ProjectLister
+	ClusterLister
+	BackupConfigLister
+	SnapshotScheduleDescriber
+	ContinuousSnapshotsLister
+	SnapshotsLister
+	BackupPolicyDescriber
+}
+
 // GetBackupConfig encapsulates the logic to manage different cloud providers
 func (s *Store) GetBackupConfig(projectID, clusterID string) (*opsmngr.BackupConfig, error) {
 	switch s.service {
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).BackupConfigs.Get(context.Background(), projectID, clusterID)
+		result, _, err := s.client.(*opsmngr.Client).BackupConfigs.Get(s.ctx, projectID, clusterID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -52,7 +70,7 @@ func (s *Store) GetBackupConfig(projectID, clusterID string) (*opsmngr.BackupCon
 func (s *Store) ListBackupConfigs(projectID string, options *atlas.ListOptions) (*opsmngr.BackupConfigs, error) {
 	switch s.service {
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).BackupConfigs.List(context.Background(), projectID, options)
+		result, _, err := s.client.(*opsmngr.Client).BackupConfigs.List(s.ctx, projectID, options)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -63,9 +81,32 @@ func (s *Store) ListBackupConfigs(projectID string, options *atlas.ListOptions)
 func (s *Store) UpdateBackupConfig(backupConfig *opsmngr.BackupConfig) (*opsmngr.BackupConfig, error) {
 	switch s.service {
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).BackupConfigs.Update(context.Background(), backupConfig.GroupID, backupConfig.ClusterID, backupConfig)
+		if backupConfig.ExcludedNamespaces != nil && len(backupConfig.ExcludedNamespaces) == 0 {
+			return s.clearExcludedNamespaces(backupConfig)
+		}
+		result, _, err := s.client.(*opsmngr.Client).BackupConfigs.Update(s.ctx, backupConfig.GroupID, backupConfig.ClusterID, backupConfig)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
 	}
 }
+
+// backupConfigUpdate always sends the excluded namespaces, the client omits an empty list
+// so it can't clear them.
+type backupConfigUpdate struct {
+	*opsmngr.BackupConfig
+	ExcludedNamespaces []string `json:"excludedNamespaces"`
+}
+
+// clearExcludedNamespaces updates the backup config with an empty list of excluded namespaces
+func (s *Store) clearExcludedNamespaces(backupConfig *opsmngr.BackupConfig) (*opsmngr.BackupConfig, error) {
+	client := s.client.(*opsmngr.Client)
+	path := fmt.Sprintf("groups/%s/backupConfigs/%s", backupConfig.GroupID, backupConfig.ClusterID)
+	req, err := client.NewRequest(s.ctx, http.MethodPatch, path, &backupConfigUpdate{BackupConfig: backupConfig, ExcludedNamespaces: []string{}})
+	if err != nil {
+		return nil, err
+	}
+	result := new(opsmngr.BackupConfig)
+	_, err = client.Do(s.ctx, req, result)
+	return result, err
+}
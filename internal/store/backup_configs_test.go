@@ -0,0 +1,62 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package store
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/mongocli/internal/config"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+func TestStore_UpdateBackupConfig(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		_, _ = w.Write([]byte(`{"groupId":"1","clusterId":"2"}`))
+	}))
+	defer srv.Close()
+
+	client, err := opsmngr.New(srv.Client(), opsmngr.SetBaseURL(srv.URL+"/"))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	s := &Store{service: config.OpsManagerService, client: client, ctx: context.Background()}
+
+	t.Run("clears the excluded namespaces", func(t *testing.T) {
+		if _, err := s.UpdateBackupConfig(&opsmngr.BackupConfig{GroupID: "1", ClusterID: "2", ExcludedNamespaces: []string{}}); err != nil {
+			t.Fatalf("UpdateBackupConfig() unexpected error: %v", err)
+		}
+		if !strings.Contains(body, `"excludedNamespaces":[]`) {
+			t.Errorf("body = %s; want an empty list of excluded namespaces", body)
+		}
+	})
+	t.Run("leaves the excluded namespaces", func(t *testing.T) {
+		if _, err := s.UpdateBackupConfig(&opsmngr.BackupConfig{GroupID: "1", ClusterID: "2", StatusName: "STARTED"}); err != nil {
+			t.Fatalf("UpdateBackupConfig() unexpected error: %v", err)
+		}
+		if strings.Contains(body, "excludedNamespaces") {
+			t.Errorf("body = %s; want no excluded namespaces", body)
+		}
+	})
+}
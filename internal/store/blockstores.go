@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -23,7 +22,7 @@ import (
 	"go.mongodb.org/ops-manager/opsmngr"
 )
 
-//go:generate mockgen -destination=../mocks/mock_backup_blockstores.go -package=mocks github.com/mongodb/mongocli/internal/store BlockstoresLister,BlockstoresDescriber,BlockstoresCreator,BlockstoresUpdater,BlockstoresDeleter
+//go:generate mockgen -destination=../mocks/mock_backup_blockstores.go -package=mocks github.com/mongodb/mongocli/internal/store BlockstoresLister,BlockstoresDescriber,BlockstoresCreator,BlockstoresUpdater,BlockstoresDeleter,BackupInfrastructureExporter,BackupInfrastructureApplier
 
 type BlockstoresLister interface {
 	ListBlockstores(*atlas.ListOptions) (*opsmngr.BackupStores, error)
@@ -45,11 +44,33 @@ type BlockstoresDeleter interface {
 	DeleteBlockstore(string) error
 }
 
+type BackupInfrastructureExporter interface {
+	BlockstoresLister
+	S3BlockstoresLister
+	FileSystemsLister
+	OplogsLister
+	SyncsLister
+}
+
+type BackupInfrastructureApplier interface {
+	BackupInfrastructureExporter
+	BlockstoresCreator
+	BlockstoresUpdater
+	S3BlockstoresCreator
+	S3BlockstoresUpdater
+	FileSystemsCreator
+	FileSystemsUpdater
+	OplogsCreator
+	OplogsUpdater
+	SyncsCreator
+	SyncsUpdater
+}
+
 // ListBlockstore encapsulates the logic to manage different cloud providers
 func (s *Store) ListBlockstores(options *atlas.ListOptions) (*opsmngr.BackupStores, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).BlockstoreConfig.List(context.Background(), options)
+		result, _, err := s.client.(*opsmngr.Client).BlockstoreConfig.List(s.ctx, options)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -60,7 +81,7 @@ func (s *Store) ListBlockstores(options *atlas.ListOptions) (*opsmngr.BackupStor
 func (s *Store) DescribeBlockstore(blockstoreID string) (*opsmngr.BackupStore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).BlockstoreConfig.Get(context.Background(), blockstoreID)
+		result, _, err := s.client.(*opsmngr.Client).BlockstoreConfig.Get(s.ctx, blockstoreID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -71,7 +92,7 @@ func (s *Store) DescribeBlockstore(blockstoreID string) (*opsmngr.BackupStore, e
 func (s *Store) CreateBlockstore(blockstore *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).BlockstoreConfig.Create(context.Background(), blockstore)
+		result, _, err := s.client.(*opsmngr.Client).BlockstoreConfig.Create(s.ctx, blockstore)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -82,7 +103,7 @@ func (s *Store) CreateBlockstore(blockstore *opsmngr.BackupStore) (*opsmngr.Back
 func (s *Store) UpdateBlockstore(blockstore *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).BlockstoreConfig.Update(context.Background(), blockstore.ID, blockstore)
+		result, _, err := s.client.(*opsmngr.Client).BlockstoreConfig.Update(s.ctx, blockstore.ID, blockstore)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -93,7 +114,7 @@ func (s *Store) UpdateBlockstore(blockstore *opsmngr.BackupStore) (*opsmngr.Back
 func (s *Store) DeleteBlockstore(blockstoreID string) error {
 	switch s.service {
 	case config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).BlockstoreConfig.Delete(context.Background(), blockstoreID)
+		_, err := s.client.(*opsmngr.Client).BlockstoreConfig.Delete(s.ctx, blockstoreID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
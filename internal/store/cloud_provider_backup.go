@@ -15,14 +15,20 @@
 package store
 
 import (
-	"context"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/mongodb/mongocli/internal/config"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
-//go:generate mockgen -destination=../mocks/mock_cloud_provider_backup.go -package=mocks github.com/mongodb/mongocli/internal/store RestoreJobsLister,RestoreJobsCreator,SnapshotsLister,SnapshotsCreator,SnapshotsDescriber,SnapshotsDeleter
+//go:generate mockgen -destination=../mocks/mock_cloud_provider_backup.go -package=mocks github.com/mongodb/mongocli/internal/store RestoreJobsLister,RestoreJobsCreator,RestoreJobsDescriber,RestoreArchiveDownloader,BackupPolicyDescriber,RestoreJobsStarter,SnapshotsLister,SnapshotsCreator,SnapshotsDescriber,SnapshotsDeleter,SnapshotsPruner
 
 type RestoreJobsLister interface {
 	RestoreJobs(string, string, *atlas.ListOptions) (*atlas.CloudProviderSnapshotRestoreJobs, error)
@@ -32,6 +38,27 @@ type RestoreJobsCreator interface {
 	CreateRestoreJobs(string, string, *atlas.CloudProviderSnapshotRestoreJob) (*atlas.CloudProviderSnapshotRestoreJob, error)
 }
 
+type RestoreJobsDescriber interface {
+	RestoreJob(string, string, string) (*atlas.CloudProviderSnapshotRestoreJob, error)
+}
+
+type RestoreArchiveDownloader interface {
+	DownloadRestoreArchive(string, int64) (*RestoreArchive, error)
+}
+
+type BackupPolicyDescriber interface {
+	BackupPolicy(string, string) (*atlas.CloudProviderSnapshotBackupPolicy, error)
+}
+
+type RestoreJobsStarter interface {
+	RestoreJobsCreator
+	RestoreJobsDescriber
+	RestoreArchiveDownloader
+	AtlasClusterDescriber
+	SnapshotsLister
+	BackupPolicyDescriber
+}
+
 type SnapshotsLister interface {
 	Snapshots(string, string, *atlas.ListOptions) (*atlas.CloudProviderSnapshots, error)
 }
@@ -48,6 +75,11 @@ type SnapshotsDeleter interface {
 	DeleteSnapshot(string, string, string) error
 }
 
+type SnapshotsPruner interface {
+	SnapshotsLister
+	SnapshotsDeleter
+}
+
 // SnapshotRestoreJobs encapsulates the logic to manage different cloud providers
 func (s *Store) RestoreJobs(projectID, clusterName string, opts *atlas.ListOptions) (*atlas.CloudProviderSnapshotRestoreJobs, error) {
 	o := &atlas.SnapshotReqPathParameters{
@@ -56,7 +88,7 @@ func (s *Store) RestoreJobs(projectID, clusterName string, opts *atlas.ListOptio
 	}
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshotRestoreJobs.List(context.Background(), o, opts)
+		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshotRestoreJobs.List(s.ctx, o, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -71,13 +103,128 @@ func (s *Store) CreateRestoreJobs(projectID, clusterName string, request *atlas.
 	}
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshotRestoreJobs.Create(context.Background(), o, request)
+		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshotRestoreJobs.Create(s.ctx, o, request)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
 	}
 }
 
+// RestoreJob encapsulates the logic to manage different cloud providers
+func (s *Store) RestoreJob(projectID, clusterName, jobID string) (*atlas.CloudProviderSnapshotRestoreJob, error) {
+	o := &atlas.SnapshotReqPathParameters{
+		GroupID:     projectID,
+		ClusterName: clusterName,
+		JobID:       jobID,
+	}
+	switch s.service {
+	case config.CloudService:
+		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshotRestoreJobs.Get(s.ctx, o)
+		return result, err
+	default:
+		return nil, fmt.Errorf("unsupported service: %s", s.service)
+	}
+}
+
+// BackupPolicy encapsulates the logic to manage different cloud providers
+func (s *Store) BackupPolicy(projectID, clusterName string) (*atlas.CloudProviderSnapshotBackupPolicy, error) {
+	switch s.service {
+	case config.CloudService:
+		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshotBackupPolicies.Get(s.ctx, projectID, clusterName)
+		return result, err
+	default:
+		return nil, fmt.Errorf("unsupported service: %s", s.service)
+	}
+}
+
+// RestoreArchive is the body of a restore archive download.
+// Offset is where the body starts in the archive and Size is the size
+// of the whole archive, or -1 when the server doesn't report it.
+// MD5 is the hex checksum of the whole archive when the server reports it.
+type RestoreArchive struct {
+	io.ReadCloser
+	Offset int64
+	Size   int64
+	MD5    string
+}
+
+var (
+	contentRangeRe = regexp.MustCompile(`^bytes (?:\d+-\d+|\*)/(\d+)$`)
+	md5ETagRe      = regexp.MustCompile(`^"([0-9a-f]{32})"$`)
+)
+
+// DownloadRestoreArchive requests the archive at the delivery URL of a download restore job
+// starting from offset, servers that don't support ranges send the whole archive again.
+func (s *Store) DownloadRestoreArchive(url string, offset int64) (*RestoreArchive, error) {
+	switch s.service {
+	case config.CloudService:
+		req, err := http.NewRequestWithContext(s.ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		if offset > 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+		resp, err := s.downloadClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		return newRestoreArchive(resp, offset)
+	default:
+		return nil, fmt.Errorf("unsupported service: %s", s.service)
+	}
+}
+
+func newRestoreArchive(resp *http.Response, offset int64) (*RestoreArchive, error) {
+	a := &RestoreArchive{ReadCloser: resp.Body, Size: -1, MD5: archiveMD5(resp.Header, resp.StatusCode == http.StatusOK)}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		a.Size = resp.ContentLength
+	case http.StatusPartialContent:
+		a.Offset = offset
+		a.Size = contentRangeSize(resp.Header.Get("Content-Range"))
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the archive was already complete
+		a.Offset = offset
+		a.Size = contentRangeSize(resp.Header.Get("Content-Range"))
+		_ = resp.Body.Close()
+		if a.Size != offset {
+			return nil, fmt.Errorf("can't resume download at byte %d of %d", offset, a.Size)
+		}
+		a.ReadCloser = http.NoBody
+	default:
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("downloading %s failed: %s", resp.Request.URL.Host, resp.Status)
+	}
+	return a, nil
+}
+
+func contentRangeSize(v string) int64 {
+	m := contentRangeRe.FindStringSubmatch(v)
+	if m == nil {
+		return -1
+	}
+	size, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return size
+}
+
+// archiveMD5 returns the checksum from Content-MD5, only when the body is the whole archive,
+// or from an ETag that is a plain MD5, multipart uploads have ETags that aren't a checksum of the content.
+func archiveMD5(h http.Header, whole bool) string {
+	if v := h.Get("Content-MD5"); v != "" && whole {
+		if b, err := base64.StdEncoding.DecodeString(v); err == nil {
+			return hex.EncodeToString(b)
+		}
+	}
+	if m := md5ETagRe.FindStringSubmatch(strings.ToLower(h.Get("ETag"))); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
 // CreateSnapshot encapsulates the logic to manage different cloud providers
 func (s *Store) CreateSnapshot(projectID, clusterName string, request *atlas.CloudProviderSnapshot) (*atlas.CloudProviderSnapshot, error) {
 	o := &atlas.SnapshotReqPathParameters{
@@ -86,7 +233,7 @@ func (s *Store) CreateSnapshot(projectID, clusterName string, request *atlas.Clo
 	}
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshots.Create(context.Background(), o, request)
+		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshots.Create(s.ctx, o, request)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -101,7 +248,7 @@ func (s *Store) Snapshots(projectID, clusterName string, opts *atlas.ListOptions
 	}
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshots.GetAllCloudProviderSnapshots(context.Background(), o, opts)
+		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshots.GetAllCloudProviderSnapshots(s.ctx, o, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -117,7 +264,7 @@ func (s *Store) Snapshot(projectID, clusterName, snapshotID string) (*atlas.Clou
 	}
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshots.GetOneCloudProviderSnapshot(context.Background(), o)
+		result, _, err := s.client.(*atlas.Client).CloudProviderSnapshots.GetOneCloudProviderSnapshot(s.ctx, o)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -133,7 +280,7 @@ func (s *Store) DeleteSnapshot(projectID, clusterName, snapshotID string) error
 	}
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).CloudProviderSnapshots.Delete(context.Background(), o)
+		_, err := s.client.(*atlas.Client).CloudProviderSnapshots.Delete(s.ctx, o)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -0,0 +1,126 @@
+// Copyright 2020 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package store
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/config"
+)
+
+func TestNewRestoreArchive(t *testing.T) {
+	newResponse := func(status int, h http.Header) *http.Response {
+		req, _ := http.NewRequest(http.MethodGet, "https://restore.example.com/restore.tar.gz", nil)
+		return &http.Response{
+			StatusCode:    status,
+			Status:        http.StatusText(status),
+			Header:        h,
+			Body:          ioutil.NopCloser(strings.NewReader("archive")),
+			ContentLength: 7,
+			Request:       req,
+		}
+	}
+
+	t.Run("whole archive", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("Content-MD5", "rL0Y20zC+Fzt72VPzMSk2A==")
+		a, err := newRestoreArchive(newResponse(http.StatusOK, h), 3)
+		if err != nil {
+			t.Fatalf("newRestoreArchive() unexpected error: %v", err)
+		}
+		if a.Offset != 0 || a.Size != 7 || a.MD5 != "acbd18db4cc2f85cedef654fccc4a4d8" {
+			t.Errorf("newRestoreArchive() = %+v; want the whole archive with its md5", a)
+		}
+	})
+	t.Run("resumed", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("Content-Range", "bytes 3-9/10")
+		h.Set("Content-MD5", "rL0Y20zC+Fzt72VPzMSk2A==")
+		h.Set("ETag", `"multipart-etag-2"`)
+		a, err := newRestoreArchive(newResponse(http.StatusPartialContent, h), 3)
+		if err != nil {
+			t.Fatalf("newRestoreArchive() unexpected error: %v", err)
+		}
+		if a.Offset != 3 || a.Size != 10 || a.MD5 != "" {
+			t.Errorf("newRestoreArchive() = %+v; want offset 3 of 10 without md5", a)
+		}
+	})
+	t.Run("already complete", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("Content-Range", "bytes */10")
+		a, err := newRestoreArchive(newResponse(http.StatusRequestedRangeNotSatisfiable, h), 10)
+		if err != nil {
+			t.Fatalf("newRestoreArchive() unexpected error: %v", err)
+		}
+		if a.ReadCloser != http.NoBody {
+			t.Error("newRestoreArchive() want an empty body")
+		}
+	})
+	t.Run("failed", func(t *testing.T) {
+		if _, err := newRestoreArchive(newResponse(http.StatusForbidden, http.Header{}), 0); err == nil {
+			t.Fatal("newRestoreArchive() expected an error")
+		}
+	})
+}
+
+type downloadConfig struct {
+	testConfig
+}
+
+func (c *downloadConfig) Service() string          { return config.CloudService }
+func (c *downloadConfig) OpsManagerURL() string    { return "" }
+func (c *downloadConfig) PublicAPIKey() string     { return "public" }
+func (c *downloadConfig) PrivateAPIKey() string    { return "private" }
+func (c *downloadConfig) Debug() bool              { return false }
+func (c *downloadConfig) TraceFile() string        { return "" }
+func (c *downloadConfig) RetryMaxAttempts() int    { return 1 }
+func (c *downloadConfig) RetryNonIdempotent() bool { return false }
+
+func TestStore_DownloadRestoreArchive(t *testing.T) {
+	const chunks = 4
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		for i := 0; i < chunks; i++ {
+			_, _ = w.Write([]byte("chunk"))
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer srv.Close()
+
+	s, err := New(&downloadConfig{testConfig{requestTimeout: 20 * time.Millisecond}})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	a, err := s.DownloadRestoreArchive(srv.URL, 0)
+	if err != nil {
+		t.Fatalf("DownloadRestoreArchive() unexpected error: %v", err)
+	}
+	defer a.Close()
+	got, err := ioutil.ReadAll(a)
+	if err != nil {
+		t.Fatalf("ReadAll() unexpected error: %v", err)
+	}
+	if want := strings.Repeat("chunk", chunks); string(got) != want {
+		t.Errorf("DownloadRestoreArchive() = %q; want %q", got, want)
+	}
+}
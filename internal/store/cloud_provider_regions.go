@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -36,7 +35,7 @@ func (s *Store) CloudProviderRegions(projectID, tier string, providerName []*str
 	}
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CloudProviderRegions.List(context.Background(), projectID, options)
+		result, _, err := s.client.(*atlas.Client).CloudProviderRegions.List(s.ctx, projectID, options)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
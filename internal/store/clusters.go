@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -86,7 +85,7 @@ type AtlasClusterQuickStarter interface {
 func (s *Store) AddSampleData(groupID, clusterName string) (*atlas.SampleDatasetJob, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Clusters.LoadSampleDataset(context.Background(), groupID, clusterName)
+		result, _, err := s.client.(*atlas.Client).Clusters.LoadSampleDataset(s.ctx, groupID, clusterName)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -97,7 +96,7 @@ func (s *Store) AddSampleData(groupID, clusterName string) (*atlas.SampleDataset
 func (s *Store) SampleDataStatus(groupID, id string) (*atlas.SampleDatasetJob, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Clusters.GetSampleDatasetStatus(context.Background(), groupID, id)
+		result, _, err := s.client.(*atlas.Client).Clusters.GetSampleDatasetStatus(s.ctx, groupID, id)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -108,7 +107,7 @@ func (s *Store) SampleDataStatus(groupID, id string) (*atlas.SampleDatasetJob, e
 func (s *Store) CreateCluster(cluster *atlas.Cluster) (*atlas.Cluster, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Clusters.Create(context.Background(), cluster.GroupID, cluster)
+		result, _, err := s.client.(*atlas.Client).Clusters.Create(s.ctx, cluster.GroupID, cluster)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -119,7 +118,7 @@ func (s *Store) CreateCluster(cluster *atlas.Cluster) (*atlas.Cluster, error) {
 func (s *Store) UpdateCluster(projectID, name string, cluster *atlas.Cluster) (*atlas.Cluster, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Clusters.Update(context.Background(), projectID, name, cluster)
+		result, _, err := s.client.(*atlas.Client).Clusters.Update(s.ctx, projectID, name, cluster)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -148,7 +147,7 @@ func (s *Store) StartCluster(projectID, name string) (*atlas.Cluster, error) {
 func (s *Store) DeleteCluster(projectID, name string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).Clusters.Delete(context.Background(), projectID, name)
+		_, err := s.client.(*atlas.Client).Clusters.Delete(s.ctx, projectID, name)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -159,10 +158,10 @@ func (s *Store) DeleteCluster(projectID, name string) error {
 func (s *Store) ProjectClusters(projectID string, opts *atlas.ListOptions) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Clusters.List(context.Background(), projectID, opts)
+		result, _, err := s.client.(*atlas.Client).Clusters.List(s.ctx, projectID, opts)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Clusters.List(context.Background(), projectID, opts)
+		result, _, err := s.client.(*opsmngr.Client).Clusters.List(s.ctx, projectID, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -173,7 +172,7 @@ func (s *Store) ProjectClusters(projectID string, opts *atlas.ListOptions) (inte
 func (s *Store) AtlasCluster(projectID, name string) (*atlas.Cluster, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Clusters.Get(context.Background(), projectID, name)
+		result, _, err := s.client.(*atlas.Client).Clusters.Get(s.ctx, projectID, name)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -184,7 +183,7 @@ func (s *Store) AtlasCluster(projectID, name string) (*atlas.Cluster, error) {
 func (s *Store) OpsManagerCluster(projectID, name string) (*opsmngr.Cluster, error) {
 	switch s.service {
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Clusters.Get(context.Background(), projectID, name)
+		result, _, err := s.client.(*opsmngr.Client).Clusters.Get(s.ctx, projectID, name)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -195,7 +194,7 @@ func (s *Store) OpsManagerCluster(projectID, name string) (*opsmngr.Cluster, err
 func (s *Store) ListAllProjectClusters() (*opsmngr.AllClustersProjects, error) {
 	switch s.service {
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Clusters.ListAll(context.Background())
+		result, _, err := s.client.(*opsmngr.Client).Clusters.ListAll(s.ctx)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -15,14 +15,13 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
-//go:generate mockgen -destination=../mocks/mock_database_roles.go -package=mocks github.com/mongodb/mongocli/internal/store DatabaseRoleLister,DatabaseRoleCreator,DatabaseRoleDeleter,DatabaseRoleUpdater,DatabaseRoleDescriber
+//go:generate mockgen -destination=../mocks/mock_database_roles.go -package=mocks github.com/mongodb/mongocli/internal/store DatabaseRoleLister,DatabaseRoleCreator,DatabaseRoleDeleter,DatabaseRoleUpdater,DatabaseRoleDescriber,DatabaseRoleApplier
 
 type DatabaseRoleLister interface {
 	DatabaseRoles(string, *atlas.ListOptions) (*[]atlas.CustomDBRole, error)
@@ -45,11 +44,18 @@ type DatabaseRoleDescriber interface {
 	DatabaseRole(string, string) (*atlas.CustomDBRole, error)
 }
 
+type DatabaseRoleApplier interface {
+	DatabaseRoleLister
+	DatabaseRoleCreator
+	DatabaseRoleUpdater
+	DatabaseRoleDeleter
+}
+
 // CreateDatabaseRole encapsulate the logic to manage different cloud providers
 func (s *Store) CreateDatabaseRole(groupID string, role *atlas.CustomDBRole) (*atlas.CustomDBRole, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CustomDBRoles.Create(context.Background(), groupID, role)
+		result, _, err := s.client.(*atlas.Client).CustomDBRoles.Create(s.ctx, groupID, role)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -60,7 +66,7 @@ func (s *Store) CreateDatabaseRole(groupID string, role *atlas.CustomDBRole) (*a
 func (s *Store) DeleteDatabaseRole(groupID, roleName string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).CustomDBRoles.Delete(context.Background(), groupID, roleName)
+		_, err := s.client.(*atlas.Client).CustomDBRoles.Delete(s.ctx, groupID, roleName)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -71,7 +77,7 @@ func (s *Store) DeleteDatabaseRole(groupID, roleName string) error {
 func (s *Store) DatabaseRoles(projectID string, opts *atlas.ListOptions) (*[]atlas.CustomDBRole, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CustomDBRoles.List(context.Background(), projectID, opts)
+		result, _, err := s.client.(*atlas.Client).CustomDBRoles.List(s.ctx, projectID, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -82,7 +88,7 @@ func (s *Store) DatabaseRoles(projectID string, opts *atlas.ListOptions) (*[]atl
 func (s *Store) UpdateDatabaseRole(groupID, roleName string, role *atlas.CustomDBRole) (*atlas.CustomDBRole, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CustomDBRoles.Update(context.Background(), groupID, roleName, role)
+		result, _, err := s.client.(*atlas.Client).CustomDBRoles.Update(s.ctx, groupID, roleName, role)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -93,7 +99,7 @@ func (s *Store) UpdateDatabaseRole(groupID, roleName string, role *atlas.CustomD
 func (s *Store) DatabaseRole(groupID, roleName string) (*atlas.CustomDBRole, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).CustomDBRoles.Get(context.Background(), groupID, roleName)
+		result, _, err := s.client.(*atlas.Client).CustomDBRoles.Get(s.ctx, groupID, roleName)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
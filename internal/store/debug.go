@@ -0,0 +1,382 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mongodb/mongocli/internal/config"
+	"github.com/mongodb/mongocli/internal/version"
+)
+
+const (
+	redacted        = "<redacted>"
+	maxLoggedBody   = 64 * 1024
+	tracePerm       = 0600
+	traceHARVersion = "1.2"
+)
+
+var (
+	sensitiveHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+	}
+	sensitiveFields = []string{
+		"password",
+		"secret",
+		"privatekey",
+		"token",
+	}
+)
+
+// debugTransport is an http.RoundTripper that logs every exchange to a writer
+// and optionally records it to a HAR-like trace file.
+type debugTransport struct {
+	transport http.RoundTripper
+	w         io.Writer
+	trace     *tracer
+}
+
+// withDebug wraps a transport with debug logging and tracing
+// when the configuration requests any of them.
+func withDebug(c Config, t http.RoundTripper) http.RoundTripper {
+	if !c.Debug() && c.TraceFile() == "" {
+		return t
+	}
+	if t == nil {
+		t = http.DefaultTransport
+	}
+	d := &debugTransport{transport: t}
+	if c.Debug() {
+		d.w = os.Stderr
+	}
+	if f := c.TraceFile(); f != "" {
+		d.trace = traceFor(f)
+	}
+	return d
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	reqBody, err := captureRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	t.logf("--> %s %s\n", req.Method, req.URL.Redacted())
+	t.logHeaders(req.Header)
+	t.logBody(reqBody)
+
+	resp, rtErr := t.transport.RoundTrip(req)
+	elapsed := time.Since(start)
+	if rtErr != nil {
+		t.logf("<-- %s %s failed after %s: %v\n", req.Method, req.URL.Redacted(), elapsed.Round(time.Millisecond), rtErr)
+		t.record(start, elapsed, req, reqBody, nil, nil)
+		return nil, rtErr
+	}
+
+	respBody, err := captureResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	t.logf("<-- %s %s (%s)\n", resp.Status, req.URL.Redacted(), elapsed.Round(time.Millisecond))
+	t.logHeaders(resp.Header)
+	t.logBody(respBody)
+	t.record(start, elapsed, req, reqBody, resp, respBody)
+
+	return resp, nil
+}
+
+func (t *debugTransport) logf(format string, a ...interface{}) {
+	if t.w == nil {
+		return
+	}
+	_, _ = fmt.Fprintf(t.w, format, a...)
+}
+
+func (t *debugTransport) logHeaders(h http.Header) {
+	if t.w == nil {
+		return
+	}
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, v := range redactHeader(name, h[name]) {
+			t.logf("%s: %s\n", name, v)
+		}
+	}
+}
+
+func (t *debugTransport) logBody(body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	t.logf("%s\n", redactBody(body))
+}
+
+func (t *debugTransport) record(start time.Time, elapsed time.Duration, req *http.Request, reqBody []byte, resp *http.Response, respBody []byte) {
+	if t.trace == nil {
+		return
+	}
+	e := harEntry{
+		StartedDateTime: start.UTC().Format(time.RFC3339Nano),
+		Time:            elapsed.Milliseconds(),
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.Redacted(),
+			HTTPVersion: req.Proto,
+			Headers:     harHeaders(req.Header),
+		},
+	}
+	for k, vs := range req.URL.Query() {
+		for _, v := range vs {
+			e.Request.QueryString = append(e.Request.QueryString, harNameValue{Name: k, Value: v})
+		}
+	}
+	if len(reqBody) > 0 {
+		e.Request.PostData = &harContent{
+			MimeType: req.Header.Get("Content-Type"),
+			Text:     redactBody(reqBody),
+		}
+	}
+	if resp != nil {
+		e.Response = &harResponse{
+			Status:      resp.StatusCode,
+			StatusText:  http.StatusText(resp.StatusCode),
+			HTTPVersion: resp.Proto,
+			Headers:     harHeaders(resp.Header),
+			Content: harContent{
+				Size:     len(respBody),
+				MimeType: resp.Header.Get("Content-Type"),
+				Text:     redactBody(respBody),
+			},
+		}
+	}
+	t.trace.add(e)
+}
+
+// captureRequestBody reads the request body, if any, and replaces it so it can be sent.
+func captureRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	b, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// captureResponseBody reads JSON response bodies and replaces them so they can be consumed,
+// other content types such as archives or logs are left untouched.
+func captureResponseBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil || !isJSON(resp.Header.Get("Content-Type")) {
+		return nil, nil
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+func isJSON(contentType string) bool {
+	return strings.Contains(contentType, "json")
+}
+
+func redactHeader(name string, values []string) []string {
+	for _, h := range sensitiveHeaders {
+		if strings.EqualFold(h, name) {
+			return []string{redacted}
+		}
+	}
+	return values
+}
+
+// redactBody masks the value of any sensitive field of a JSON document,
+// non JSON bodies are returned as is.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return truncate(string(body))
+	}
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return truncate(string(body))
+	}
+	return truncate(string(b))
+}
+
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, e := range val {
+			if isSensitive(k) {
+				val[k] = redacted
+				continue
+			}
+			val[k] = redactValue(e)
+		}
+	case []interface{}:
+		for i, e := range val {
+			val[i] = redactValue(e)
+		}
+	}
+	return v
+}
+
+func isSensitive(field string) bool {
+	field = strings.ToLower(field)
+	for _, s := range sensitiveFields {
+		if strings.Contains(field, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(s string) string {
+	if len(s) <= maxLoggedBody {
+		return s
+	}
+	return s[:maxLoggedBody] + "...(truncated)"
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString,omitempty"`
+	PostData    *harContent    `json:"postData,omitempty"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+}
+
+type harEntry struct {
+	StartedDateTime string       `json:"startedDateTime"`
+	Time            int64        `json:"time"`
+	Request         harRequest   `json:"request"`
+	Response        *harResponse `json:"response,omitempty"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+func harHeaders(h http.Header) []harNameValue {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := make([]harNameValue, 0, len(h))
+	for _, name := range names {
+		for _, v := range redactHeader(name, h[name]) {
+			result = append(result, harNameValue{Name: name, Value: v})
+		}
+	}
+	return result
+}
+
+// tracer records exchanges to a file, the file is rewritten after every exchange
+// so the trace is complete even if the command fails midway.
+type tracer struct {
+	mu       sync.Mutex
+	filename string
+	log      harLog
+}
+
+var (
+	tracersMu sync.Mutex
+	tracers   = map[string]*tracer{}
+)
+
+// traceFor returns the tracer for a file, so every client in the process shares the same trace.
+func traceFor(filename string) *tracer {
+	tracersMu.Lock()
+	defer tracersMu.Unlock()
+	if t, ok := tracers[filename]; ok {
+		return t
+	}
+	t := &tracer{
+		filename: filename,
+		log: harLog{
+			Version: traceHARVersion,
+			Creator: harCreator{Name: config.ToolName, Version: version.Version},
+			Entries: []harEntry{},
+		},
+	}
+	tracers[filename] = t
+	return t
+}
+
+func (t *tracer) add(e harEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.log.Entries = append(t.log.Entries, e)
+	b, err := json.MarshalIndent(map[string]harLog{"log": t.log}, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := ioutil.WriteFile(t.filename, b, tracePerm); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "could not write trace file %s: %v\n", t.filename, err)
+	}
+}
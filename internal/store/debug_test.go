@@ -0,0 +1,103 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := []byte(`{"username":"test","password":"secret","nested":[{"privateKey":"key","name":"n"}]}`)
+	got := redactBody(body)
+	if strings.Contains(got, `"secret"`) || strings.Contains(got, `"key"`) {
+		t.Errorf("redactBody() = %s, sensitive values not redacted", got)
+	}
+	if !strings.Contains(got, `"username":"test"`) || !strings.Contains(got, `"name":"n"`) {
+		t.Errorf("redactBody() = %s, non sensitive values redacted", got)
+	}
+	if got := redactBody([]byte("plain text")); got != "plain text" {
+		t.Errorf("redactBody() = %s, want plain text", got)
+	}
+}
+
+func TestDebugTransport_RoundTrip(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","password":"pwd"}`))
+	}))
+	defer srv.Close()
+
+	buf := new(bytes.Buffer)
+	traceFile := filepath.Join(t.TempDir(), "trace.json")
+	tr := &debugTransport{
+		transport: http.DefaultTransport,
+		w:         buf,
+		trace:     traceFor(traceFile),
+	}
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/api?pageNum=1", strings.NewReader(`{"password":"pwd"}`))
+	if err != nil {
+		t.Fatalf("NewRequest() unexpected error: %v", err)
+	}
+	req.Header.Set("Authorization", "Digest username=user")
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() unexpected error: %v", err)
+	}
+	if string(b) != `{"id":"1","password":"pwd"}` {
+		t.Errorf("response body = %s, want it unchanged", b)
+	}
+
+	logged := buf.String()
+	if !strings.Contains(logged, "--> POST "+srv.URL) || !strings.Contains(logged, "<-- 200 OK") {
+		t.Errorf("log = %s, missing request or response line", logged)
+	}
+	if strings.Contains(logged, "pwd") || strings.Contains(logged, "username=user") {
+		t.Errorf("log = %s, credentials not redacted", logged)
+	}
+
+	data, err := ioutil.ReadFile(traceFile)
+	if err != nil {
+		t.Fatalf("ReadFile() unexpected error: %v", err)
+	}
+	var trace map[string]harLog
+	if err := json.Unmarshal(data, &trace); err != nil {
+		t.Fatalf("Unmarshal() unexpected error: %v", err)
+	}
+	entries := trace["log"].Entries
+	if len(entries) != 1 {
+		t.Fatalf("trace entries = %d, want 1", len(entries))
+	}
+	if entries[0].Response == nil || entries[0].Response.Status != http.StatusOK {
+		t.Errorf("trace response = %v, want status 200", entries[0].Response)
+	}
+	if strings.Contains(string(data), "pwd") {
+		t.Errorf("trace = %s, credentials not redacted", data)
+	}
+}
@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 	"io"
 
@@ -33,7 +32,7 @@ type ArchivesDownloader interface {
 func (s *Store) DownloadArchive(groupID string, opts *opsmngr.DiagnosticsListOpts, out io.Writer) error {
 	switch s.service {
 	case config.OpsManagerService, config.CloudManagerService:
-		_, err := s.client.(*opsmngr.Client).Diagnostics.Get(context.Background(), groupID, opts, out)
+		_, err := s.client.(*opsmngr.Client).Diagnostics.Get(s.ctx, groupID, opts, out)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -45,7 +44,7 @@ type GlobalAPIKeyWhitelistDeleter interface {
 func (s *Store) GlobalAPIKeyWhitelists(opts *atlas.ListOptions) (*opsmngr.GlobalWhitelistAPIKeys, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).GlobalAPIKeysWhitelist.List(context.Background(), opts)
+		result, _, err := s.client.(*opsmngr.Client).GlobalAPIKeysWhitelist.List(s.ctx, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -56,7 +55,7 @@ func (s *Store) GlobalAPIKeyWhitelists(opts *atlas.ListOptions) (*opsmngr.Global
 func (s *Store) GlobalAPIKeyWhitelist(id string) (*opsmngr.GlobalWhitelistAPIKey, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).GlobalAPIKeysWhitelist.Get(context.Background(), id)
+		result, _, err := s.client.(*opsmngr.Client).GlobalAPIKeysWhitelist.Get(s.ctx, id)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -67,7 +66,7 @@ func (s *Store) GlobalAPIKeyWhitelist(id string) (*opsmngr.GlobalWhitelistAPIKey
 func (s *Store) CreateGlobalAPIKeyWhitelist(opts *opsmngr.WhitelistAPIKeysReq) (*opsmngr.GlobalWhitelistAPIKey, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).GlobalAPIKeysWhitelist.Create(context.Background(), opts)
+		result, _, err := s.client.(*opsmngr.Client).GlobalAPIKeysWhitelist.Create(s.ctx, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -78,7 +77,7 @@ func (s *Store) CreateGlobalAPIKeyWhitelist(opts *opsmngr.WhitelistAPIKeysReq) (
 func (s *Store) DeleteGlobalAPIKeyWhitelist(id string) error {
 	switch s.service {
 	case config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).GlobalAPIKeysWhitelist.Delete(context.Background(), id)
+		_, err := s.client.(*opsmngr.Client).GlobalAPIKeysWhitelist.Delete(s.ctx, id)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
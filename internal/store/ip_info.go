@@ -14,13 +14,14 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+//go:generate mockgen -destination=../mocks/mock_ip_info.go -package=mocks github.com/mongodb/mongocli/internal/store IPInfoDescriber
+
 type IPInfoDescriber interface {
 	IPInfo() (*atlas.IPInfo, error)
 }
@@ -29,7 +30,7 @@ type IPInfoDescriber interface {
 func (s *Store) IPInfo() (*atlas.IPInfo, error) {
 	switch s.service {
 	case config.CloudService:
-		resp, _, err := s.client.(*atlas.Client).IPInfo.Get(context.Background())
+		resp, _, err := s.client.(*atlas.Client).IPInfo.Get(s.ctx)
 		return resp, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -15,14 +15,13 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
-//go:generate mockgen -destination=../mocks/mock_ldap_configurations.go -package=mocks github.com/mongodb/mongocli/internal/store LDAPConfigurationVerifier,LDAPConfigurationDescriber,LDAPConfigurationSaver,LDAPConfigurationDeleter,LDAPConfigurationGetter
+//go:generate mockgen -destination=../mocks/mock_ldap_configurations.go -package=mocks github.com/mongodb/mongocli/internal/store LDAPConfigurationVerifier,LDAPConfigurationDescriber,LDAPConfigurationSaver,LDAPConfigurationDeleter,LDAPConfigurationGetter,LDAPConfigurationApplier
 
 type LDAPConfigurationVerifier interface {
 	VerifyLDAPConfiguration(string, *atlas.LDAP) (*atlas.LDAPConfiguration, error)
@@ -44,11 +43,17 @@ type LDAPConfigurationGetter interface {
 	GetLDAPConfiguration(string) (*atlas.LDAPConfiguration, error)
 }
 
+type LDAPConfigurationApplier interface {
+	LDAPConfigurationVerifier
+	LDAPConfigurationDescriber
+	LDAPConfigurationSaver
+}
+
 // VerifyLDAPConfiguration encapsulates the logic to manage different cloud providers
 func (s *Store) VerifyLDAPConfiguration(projectID string, ldap *atlas.LDAP) (*atlas.LDAPConfiguration, error) {
 	switch s.service {
 	case config.CloudService:
-		resp, _, err := s.client.(*atlas.Client).LDAPConfigurations.Verify(context.Background(), projectID, ldap)
+		resp, _, err := s.client.(*atlas.Client).LDAPConfigurations.Verify(s.ctx, projectID, ldap)
 		return resp, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -59,7 +64,7 @@ func (s *Store) VerifyLDAPConfiguration(projectID string, ldap *atlas.LDAP) (*at
 func (s *Store) GetStatusLDAPConfiguration(projectID, requestID string) (*atlas.LDAPConfiguration, error) {
 	switch s.service {
 	case config.CloudService:
-		resp, _, err := s.client.(*atlas.Client).LDAPConfigurations.GetStatus(context.Background(), projectID, requestID)
+		resp, _, err := s.client.(*atlas.Client).LDAPConfigurations.GetStatus(s.ctx, projectID, requestID)
 		return resp, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -70,7 +75,7 @@ func (s *Store) GetStatusLDAPConfiguration(projectID, requestID string) (*atlas.
 func (s *Store) SaveLDAPConfiguration(projectID string, ldap *atlas.LDAPConfiguration) (*atlas.LDAPConfiguration, error) {
 	switch s.service {
 	case config.CloudService:
-		resp, _, err := s.client.(*atlas.Client).LDAPConfigurations.Save(context.Background(), projectID, ldap)
+		resp, _, err := s.client.(*atlas.Client).LDAPConfigurations.Save(s.ctx, projectID, ldap)
 		return resp, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -81,7 +86,7 @@ func (s *Store) SaveLDAPConfiguration(projectID string, ldap *atlas.LDAPConfigur
 func (s *Store) DeleteLDAPConfiguration(projectID string) error {
 	switch s.service {
 	case config.CloudService:
-		_, _, err := s.client.(*atlas.Client).LDAPConfigurations.Delete(context.Background(), projectID)
+		_, _, err := s.client.(*atlas.Client).LDAPConfigurations.Delete(s.ctx, projectID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -92,7 +97,7 @@ func (s *Store) DeleteLDAPConfiguration(projectID string) error {
 func (s *Store) GetLDAPConfiguration(projectID string) (*atlas.LDAPConfiguration, error) {
 	switch s.service {
 	case config.CloudService:
-		resp, _, err := s.client.(*atlas.Client).LDAPConfigurations.Get(context.Background(), projectID)
+		resp, _, err := s.client.(*atlas.Client).LDAPConfigurations.Get(s.ctx, projectID)
 		return resp, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 	"io"
 
@@ -50,7 +49,7 @@ type LogJobDeleter interface {
 func (s *Store) LogCollectionJobs(groupID string, opts *opsmngr.LogListOptions) (*opsmngr.LogCollectionJobs, error) {
 	switch s.service {
 	case config.OpsManagerService, config.CloudManagerService:
-		log, _, err := s.client.(*opsmngr.Client).LogCollections.List(context.Background(), groupID, opts)
+		log, _, err := s.client.(*opsmngr.Client).LogCollections.List(s.ctx, groupID, opts)
 		return log, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -61,7 +60,7 @@ func (s *Store) LogCollectionJobs(groupID string, opts *opsmngr.LogListOptions)
 func (s *Store) DeleteCollectionJob(groupID, logID string) error {
 	switch s.service {
 	case config.OpsManagerService, config.CloudManagerService:
-		_, err := s.client.(*opsmngr.Client).LogCollections.Delete(context.Background(), groupID, logID)
+		_, err := s.client.(*opsmngr.Client).LogCollections.Delete(s.ctx, groupID, logID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -72,7 +71,7 @@ func (s *Store) DeleteCollectionJob(groupID, logID string) error {
 func (s *Store) Collect(groupID string, newLog *opsmngr.LogCollectionJob) (*opsmngr.LogCollectionJob, error) {
 	switch s.service {
 	case config.OpsManagerService, config.CloudManagerService:
-		log, _, err := s.client.(*opsmngr.Client).LogCollections.Create(context.Background(), groupID, newLog)
+		log, _, err := s.client.(*opsmngr.Client).LogCollections.Create(s.ctx, groupID, newLog)
 		return log, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -83,7 +82,7 @@ func (s *Store) Collect(groupID string, newLog *opsmngr.LogCollectionJob) (*opsm
 func (s *Store) DownloadLog(groupID, host, name string, out io.Writer, opts *atlas.DateRangetOptions) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).Logs.Get(context.Background(), groupID, host, name, out, opts)
+		_, err := s.client.(*atlas.Client).Logs.Get(s.ctx, groupID, host, name, out, opts)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -94,7 +93,7 @@ func (s *Store) DownloadLog(groupID, host, name string, out io.Writer, opts *atl
 func (s *Store) DownloadLogJob(groupID, jobID string, out io.Writer) error {
 	switch s.service {
 	case config.OpsManagerService, config.CloudManagerService:
-		_, err := s.client.(*opsmngr.Client).Logs.Download(context.Background(), groupID, jobID, out)
+		_, err := s.client.(*opsmngr.Client).Logs.Download(s.ctx, groupID, jobID, out)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
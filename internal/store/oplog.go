@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -49,7 +48,7 @@ type OplogsDeleter interface {
 func (s *Store) ListOplogs(options *atlas.ListOptions) (*opsmngr.BackupStores, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).OplogStoreConfig.List(context.Background(), options)
+		result, _, err := s.client.(*opsmngr.Client).OplogStoreConfig.List(s.ctx, options)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -60,7 +59,7 @@ func (s *Store) ListOplogs(options *atlas.ListOptions) (*opsmngr.BackupStores, e
 func (s *Store) GetOplog(oplogID string) (*opsmngr.BackupStore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).OplogStoreConfig.Get(context.Background(), oplogID)
+		result, _, err := s.client.(*opsmngr.Client).OplogStoreConfig.Get(s.ctx, oplogID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -71,7 +70,7 @@ func (s *Store) GetOplog(oplogID string) (*opsmngr.BackupStore, error) {
 func (s *Store) CreateOplog(oplog *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).OplogStoreConfig.Create(context.Background(), oplog)
+		result, _, err := s.client.(*opsmngr.Client).OplogStoreConfig.Create(s.ctx, oplog)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -82,7 +81,7 @@ func (s *Store) CreateOplog(oplog *opsmngr.BackupStore) (*opsmngr.BackupStore, e
 func (s *Store) UpdateOplog(oplogID string, oplog *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).OplogStoreConfig.Update(context.Background(), oplogID, oplog)
+		result, _, err := s.client.(*opsmngr.Client).OplogStoreConfig.Update(s.ctx, oplogID, oplog)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -93,7 +92,7 @@ func (s *Store) UpdateOplog(oplogID string, oplog *opsmngr.BackupStore) (*opsmng
 func (s *Store) DeleteOplog(oplogID string) error {
 	switch s.service {
 	case config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).OplogStoreConfig.Delete(context.Background(), oplogID)
+		_, err := s.client.(*opsmngr.Client).OplogStoreConfig.Delete(s.ctx, oplogID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
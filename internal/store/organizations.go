@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -45,10 +44,10 @@ type OrganizationDeleter interface {
 func (s *Store) Organizations(opts *atlas.OrganizationsListOptions) (*atlas.Organizations, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Organizations.List(context.Background(), opts)
+		result, _, err := s.client.(*atlas.Client).Organizations.List(s.ctx, opts)
 		return result, err
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Organizations.List(context.Background(), opts)
+		result, _, err := s.client.(*opsmngr.Client).Organizations.List(s.ctx, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -59,10 +58,10 @@ func (s *Store) Organizations(opts *atlas.OrganizationsListOptions) (*atlas.Orga
 func (s *Store) Organization(id string) (*atlas.Organization, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Organizations.Get(context.Background(), id)
+		result, _, err := s.client.(*atlas.Client).Organizations.Get(s.ctx, id)
 		return result, err
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Organizations.Get(context.Background(), id)
+		result, _, err := s.client.(*opsmngr.Client).Organizations.Get(s.ctx, id)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -74,7 +73,7 @@ func (s *Store) CreateOrganization(name string) (*atlas.Organization, error) {
 	switch s.service {
 	case config.CloudManagerService, config.OpsManagerService:
 		org := &atlas.Organization{Name: name}
-		result, _, err := s.client.(*opsmngr.Client).Organizations.Create(context.Background(), org)
+		result, _, err := s.client.(*opsmngr.Client).Organizations.Create(s.ctx, org)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -85,10 +84,10 @@ func (s *Store) CreateOrganization(name string) (*atlas.Organization, error) {
 func (s *Store) DeleteOrganization(id string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).Organizations.Delete(context.Background(), id)
+		_, err := s.client.(*atlas.Client).Organizations.Delete(s.ctx, id)
 		return err
 	case config.CloudManagerService, config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).Organizations.Delete(context.Background(), id)
+		_, err := s.client.(*opsmngr.Client).Organizations.Delete(s.ctx, id)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
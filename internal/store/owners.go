@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -37,7 +36,7 @@ func (s *Store) CreateOwner(u *opsmngr.User, ips []string) (*opsmngr.CreateUserR
 			opts = &opsmngr.WhitelistOpts{Whitelist: ips}
 		}
 
-		result, _, err := s.client.(*opsmngr.Client).UnauthUsers.CreateFirstUser(context.Background(), u, opts)
+		result, _, err := s.client.(*opsmngr.Client).UnauthUsers.CreateFirstUser(s.ctx, u, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -15,14 +15,13 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
-//go:generate mockgen -destination=../mocks/mock_peering_connections.go -package=mocks github.com/mongodb/mongocli/internal/store PeeringConnectionLister,PeeringConnectionDescriber,PeeringConnectionDeleter,AzurePeeringConnectionCreator,AWSPeeringConnectionCreator,GCPPeeringConnectionCreator,PeeringConnectionCreator
+//go:generate mockgen -destination=../mocks/mock_peering_connections.go -package=mocks github.com/mongodb/mongocli/internal/store PeeringConnectionLister,PeeringConnectionDescriber,PeeringConnectionDeleter,AzurePeeringConnectionCreator,AWSPeeringConnectionCreator,GCPPeeringConnectionCreator,PeeringConnectionCreator,AWSPeeringConnectionProvisioner,AzurePeeringConnectionProvisioner,GCPPeeringConnectionProvisioner
 
 type PeeringConnectionLister interface {
 	PeeringConnections(string, *atlas.ContainersListOptions) ([]atlas.Peer, error)
@@ -52,6 +51,25 @@ type GCPPeeringConnectionCreator interface {
 	PeeringConnectionCreator
 }
 
+type AWSPeeringConnectionProvisioner interface {
+	AWSPeeringConnectionCreator
+	PeeringConnectionLister
+	PeeringConnectionDescriber
+	ProjectIPAccessListCreator
+}
+
+type AzurePeeringConnectionProvisioner interface {
+	AzurePeeringConnectionCreator
+	PeeringConnectionLister
+	PeeringConnectionDescriber
+}
+
+type GCPPeeringConnectionProvisioner interface {
+	GCPPeeringConnectionCreator
+	PeeringConnectionLister
+	PeeringConnectionDescriber
+}
+
 type PeeringConnectionDeleter interface {
 	DeletePeeringConnection(string, string) error
 }
@@ -60,7 +78,7 @@ type PeeringConnectionDeleter interface {
 func (s *Store) PeeringConnections(projectID string, opts *atlas.ContainersListOptions) ([]atlas.Peer, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Peers.List(context.Background(), projectID, opts)
+		result, _, err := s.client.(*atlas.Client).Peers.List(s.ctx, projectID, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -71,7 +89,7 @@ func (s *Store) PeeringConnections(projectID string, opts *atlas.ContainersListO
 func (s *Store) PeeringConnection(projectID, peerID string) (*atlas.Peer, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Peers.Get(context.Background(), projectID, peerID)
+		result, _, err := s.client.(*atlas.Client).Peers.Get(s.ctx, projectID, peerID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -82,7 +100,7 @@ func (s *Store) PeeringConnection(projectID, peerID string) (*atlas.Peer, error)
 func (s *Store) DeletePeeringConnection(projectID, peerID string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).Peers.Delete(context.Background(), projectID, peerID)
+		_, err := s.client.(*atlas.Client).Peers.Delete(s.ctx, projectID, peerID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -93,7 +111,7 @@ func (s *Store) DeletePeeringConnection(projectID, peerID string) error {
 func (s *Store) CreatePeeringConnection(projectID string, peer *atlas.Peer) (*atlas.Peer, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Peers.Create(context.Background(), projectID, peer)
+		result, _, err := s.client.(*atlas.Client).Peers.Create(s.ctx, projectID, peer)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -15,14 +15,15 @@
 package store
 
 import (
-	"context"
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/mongodb/mongocli/internal/config"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
-//go:generate mockgen -destination=../mocks/mock_private_endpoints.go -package=mocks github.com/mongodb/mongocli/internal/store PrivateEndpointLister,PrivateEndpointDescriber,PrivateEndpointCreator,PrivateEndpointDeleter,InterfaceEndpointDescriber,InterfaceEndpointCreator,InterfaceEndpointDeleter,RegionalizedPrivateEndpointSettingUpdater,RegionalizedPrivateEndpointSettingDescriber
+//go:generate mockgen -destination=../mocks/mock_private_endpoints.go -package=mocks github.com/mongodb/mongocli/internal/store PrivateEndpointLister,PrivateEndpointDescriber,PrivateEndpointCreator,PrivateEndpointDeleter,InterfaceEndpointDescriber,InterfaceEndpointCreator,InterfaceEndpointDeleter,RegionalizedPrivateEndpointSettingUpdater,RegionalizedPrivateEndpointSettingDescriber,PrivateEndpointServiceFinder,PrivateEndpointProvisioner
 
 type PrivateEndpointLister interface {
 	PrivateEndpoints(string, string, *atlas.ListOptions) ([]atlas.PrivateEndpointConnection, error)
@@ -52,6 +53,18 @@ type InterfaceEndpointDeleter interface {
 	DeleteInterfaceEndpoint(string, string, string, string) error
 }
 
+type PrivateEndpointServiceFinder interface {
+	PrivateEndpointService(string, string, string) (*atlas.PrivateEndpointConnection, error)
+}
+
+type PrivateEndpointProvisioner interface {
+	PrivateEndpointServiceFinder
+	PrivateEndpointDescriber
+	PrivateEndpointCreator
+	InterfaceEndpointDescriber
+	InterfaceEndpointCreator
+}
+
 type RegionalizedPrivateEndpointSettingUpdater interface {
 	UpdateRegionalizedPrivateEndpointSetting(string, bool) (*atlas.RegionalizedPrivateEndpointSetting, error)
 }
@@ -64,7 +77,7 @@ type RegionalizedPrivateEndpointSettingDescriber interface {
 func (s *Store) PrivateEndpoints(projectID, provider string, opts *atlas.ListOptions) ([]atlas.PrivateEndpointConnection, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.List(context.Background(), projectID, provider, opts)
+		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.List(s.ctx, projectID, provider, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -75,18 +88,56 @@ func (s *Store) PrivateEndpoints(projectID, provider string, opts *atlas.ListOpt
 func (s *Store) PrivateEndpoint(projectID, provider, privateLinkID string) (*atlas.PrivateEndpointConnection, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.Get(context.Background(), projectID, provider, privateLinkID)
+		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.Get(s.ctx, projectID, provider, privateLinkID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
 	}
 }
 
+// privateEndpointService is a private endpoint service as listed, with the name of its region
+type privateEndpointService struct {
+	atlas.PrivateEndpointConnection
+	RegionName string `json:"regionName,omitempty"`
+}
+
+// PrivateEndpointService returns the private endpoint service of the region, nil when there's none.
+// Listed services only have a regionName, which atlas.PrivateEndpointConnection doesn't decode
+func (s *Store) PrivateEndpointService(projectID, provider, region string) (*atlas.PrivateEndpointConnection, error) {
+	switch s.service {
+	case config.CloudService:
+		client := s.client.(*atlas.Client)
+		path := fmt.Sprintf("groups/%s/privateEndpoint/%s/endpointService", projectID, provider)
+		req, err := client.NewRequest(s.ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result []privateEndpointService
+		if _, err := client.Do(s.ctx, req, &result); err != nil {
+			return nil, err
+		}
+		for i := range result {
+			if sameRegion(result[i].RegionName, region) || sameRegion(result[i].Region, region) {
+				return &result[i].PrivateEndpointConnection, nil
+			}
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported service: %s", s.service)
+	}
+}
+
+// sameRegion compares region names of both the provider and Atlas, like us-east-1 and US_EAST_1
+func sameRegion(a, b string) bool {
+	normalize := strings.NewReplacer("-", "", "_", "")
+	return a != "" && strings.EqualFold(normalize.Replace(a), normalize.Replace(b))
+}
+
 // CreatePrivateEndpoint encapsulates the logic to manage different cloud providers
 func (s *Store) CreatePrivateEndpoint(projectID string, r *atlas.PrivateEndpointConnection) (*atlas.PrivateEndpointConnection, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.Create(context.Background(), projectID, r)
+		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.Create(s.ctx, projectID, r)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -97,7 +148,7 @@ func (s *Store) CreatePrivateEndpoint(projectID string, r *atlas.PrivateEndpoint
 func (s *Store) DeletePrivateEndpoint(projectID, provider, privateLinkID string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).PrivateEndpoints.Delete(context.Background(), projectID, provider, privateLinkID)
+		_, err := s.client.(*atlas.Client).PrivateEndpoints.Delete(s.ctx, projectID, provider, privateLinkID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -108,7 +159,7 @@ func (s *Store) DeletePrivateEndpoint(projectID, provider, privateLinkID string)
 func (s *Store) CreateInterfaceEndpoint(projectID, provider, endpointServiceID string, createRequest *atlas.InterfaceEndpointConnection) (*atlas.InterfaceEndpointConnection, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.AddOnePrivateEndpoint(context.Background(), projectID, provider, endpointServiceID, createRequest)
+		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.AddOnePrivateEndpoint(s.ctx, projectID, provider, endpointServiceID, createRequest)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -119,7 +170,7 @@ func (s *Store) CreateInterfaceEndpoint(projectID, provider, endpointServiceID s
 func (s *Store) InterfaceEndpoint(projectID, cloudProvider, endpointServiceID, privateEndpointID string) (*atlas.InterfaceEndpointConnection, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.GetOnePrivateEndpoint(context.Background(), projectID, cloudProvider, endpointServiceID, privateEndpointID)
+		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.GetOnePrivateEndpoint(s.ctx, projectID, cloudProvider, endpointServiceID, privateEndpointID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -130,7 +181,7 @@ func (s *Store) InterfaceEndpoint(projectID, cloudProvider, endpointServiceID, p
 func (s *Store) DeleteInterfaceEndpoint(projectID, provider, endpointServiceID, privateEndpointID string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).PrivateEndpoints.DeleteOnePrivateEndpoint(context.Background(), projectID, provider, endpointServiceID, privateEndpointID)
+		_, err := s.client.(*atlas.Client).PrivateEndpoints.DeleteOnePrivateEndpoint(s.ctx, projectID, provider, endpointServiceID, privateEndpointID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -141,7 +192,7 @@ func (s *Store) DeleteInterfaceEndpoint(projectID, provider, endpointServiceID,
 func (s *Store) UpdateRegionalizedPrivateEndpointSetting(projectID string, enabled bool) (*atlas.RegionalizedPrivateEndpointSetting, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.UpdateRegionalizedPrivateEndpointSetting(context.Background(), projectID, enabled)
+		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.UpdateRegionalizedPrivateEndpointSetting(s.ctx, projectID, enabled)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -152,7 +203,7 @@ func (s *Store) UpdateRegionalizedPrivateEndpointSetting(projectID string, enabl
 func (s *Store) RegionalizedPrivateEndpointSetting(projectID string) (*atlas.RegionalizedPrivateEndpointSetting, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.GetRegionalizedPrivateEndpointSetting(context.Background(), projectID)
+		result, _, err := s.client.(*atlas.Client).PrivateEndpoints.GetRegionalizedPrivateEndpointSetting(s.ctx, projectID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
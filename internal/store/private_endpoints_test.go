@@ -0,0 +1,54 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package store
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mongodb/mongocli/internal/config"
+	atlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestStore_PrivateEndpointService(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/1/privateEndpoint/AWS/endpointService" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`[{"id":"a","regionName":"eu-west-1"},{"id":"b","regionName":"us-east-1"}]`))
+	}))
+	defer srv.Close()
+
+	client, err := atlas.New(srv.Client(), atlas.SetBaseURL(srv.URL+"/"))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	s := &Store{service: config.CloudService, client: client, ctx: context.Background()}
+
+	r, err := s.PrivateEndpointService("1", "AWS", "US_EAST_1")
+	if err != nil {
+		t.Fatalf("PrivateEndpointService() unexpected error: %v", err)
+	}
+	if r == nil || r.ID != "b" {
+		t.Errorf("got %v, want the service of us-east-1", r)
+	}
+	if r, err = s.PrivateEndpointService("1", "AWS", "ap-south-1"); err != nil || r != nil {
+		t.Errorf("got %v, %v, want no service", r, err)
+	}
+}
@@ -15,14 +15,13 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
-//go:generate mockgen -destination=../mocks/mock_project_ip_access_lists.go -package=mocks github.com/mongodb/mongocli/internal/store ProjectIPAccessListDescriber,ProjectIPAccessListLister,ProjectIPAccessListCreator,ProjectIPAccessListDeleter
+//go:generate mockgen -destination=../mocks/mock_project_ip_access_lists.go -package=mocks github.com/mongodb/mongocli/internal/store ProjectIPAccessListDescriber,ProjectIPAccessListLister,ProjectIPAccessListCreator,ProjectIPAccessListDeleter,ProjectIPAccessListApplier
 
 type ProjectIPAccessListDescriber interface {
 	IPAccessList(string, string) (*atlas.ProjectIPAccessList, error)
@@ -39,11 +38,17 @@ type ProjectIPAccessListDeleter interface {
 	DeleteProjectIPAccessList(string, string) error
 }
 
+type ProjectIPAccessListApplier interface {
+	ProjectIPAccessListLister
+	ProjectIPAccessListCreator
+	ProjectIPAccessListDeleter
+}
+
 // CreateProjectIPAccessList encapsulate the logic to manage different cloud providers
 func (s *Store) CreateProjectIPAccessList(entries []*atlas.ProjectIPAccessList) (*atlas.ProjectIPAccessLists, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).ProjectIPAccessList.Create(context.Background(), entries[0].GroupID, entries)
+		result, _, err := s.client.(*atlas.Client).ProjectIPAccessList.Create(s.ctx, entries[0].GroupID, entries)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -54,7 +59,7 @@ func (s *Store) CreateProjectIPAccessList(entries []*atlas.ProjectIPAccessList)
 func (s *Store) DeleteProjectIPAccessList(projectID, entry string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).ProjectIPAccessList.Delete(context.Background(), projectID, entry)
+		_, err := s.client.(*atlas.Client).ProjectIPAccessList.Delete(s.ctx, projectID, entry)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -65,7 +70,7 @@ func (s *Store) DeleteProjectIPAccessList(projectID, entry string) error {
 func (s *Store) ProjectIPAccessLists(projectID string, opts *atlas.ListOptions) (*atlas.ProjectIPAccessLists, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).ProjectIPAccessList.List(context.Background(), projectID, opts)
+		result, _, err := s.client.(*atlas.Client).ProjectIPAccessList.List(s.ctx, projectID, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -76,7 +81,7 @@ func (s *Store) ProjectIPAccessLists(projectID string, opts *atlas.ListOptions)
 func (s *Store) IPAccessList(projectID, name string) (*atlas.ProjectIPAccessList, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).ProjectIPAccessList.Get(context.Background(), projectID, name)
+		result, _, err := s.client.(*atlas.Client).ProjectIPAccessList.Get(s.ctx, projectID, name)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -23,7 +22,7 @@ import (
 	"go.mongodb.org/ops-manager/opsmngr"
 )
 
-//go:generate mockgen -destination=../mocks/mock_projects.go -package=mocks github.com/mongodb/mongocli/internal/store ProjectLister,OrgProjectLister,ProjectCreator,ProjectDeleter,ProjectDescriber,ProjectUsersLister,ProjectUserDeleter,ProjectTeamLister,ProjectTeamAdder,ProjectTeamDeleter
+//go:generate mockgen -destination=../mocks/mock_projects.go -package=mocks github.com/mongodb/mongocli/internal/store ProjectLister,OrgProjectLister,ProjectCreator,ProjectDeleter,ProjectDescriber,ProjectUsersLister,ProjectUserDeleter,ProjectTeamLister,ProjectTeamAdder,ProjectTeamDeleter,InventoryLister,ProjectProvisioner
 
 type ProjectLister interface {
 	Projects(*atlas.ListOptions) (interface{}, error)
@@ -66,14 +65,46 @@ type ProjectTeamDeleter interface {
 	DeleteTeamFromProject(string, string) error
 }
 
+type ProjectProvisioner interface {
+	ProjectCreator
+	ProjectDeleter
+	TeamDescriber
+	ProjectTeamAdder
+	ProjectTeamDeleter
+	DatabaseRoleCreator
+	DatabaseRoleDeleter
+	DatabaseUserCreator
+	DatabaseUserDeleter
+	ProjectIPAccessListCreator
+	ProjectIPAccessListDeleter
+	MaintenanceWindowUpdater
+	MaintenanceWindowClearer
+	IntegrationCreator
+	IntegrationDeleter
+	AlertConfigurationCreator
+	AlertConfigurationDeleter
+}
+
+type InventoryLister interface {
+	ProjectLister
+	ClusterLister
+	AllClusterLister
+	ProcessLister
+	HostLister
+	DatabaseUserLister
+	AutomationGetter
+	IntegrationLister
+	AgentProjectVersionsLister
+}
+
 // Projects encapsulates the logic to manage different cloud providers
 func (s *Store) Projects(opts *atlas.ListOptions) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Projects.GetAllProjects(context.Background(), opts)
+		result, _, err := s.client.(*atlas.Client).Projects.GetAllProjects(s.ctx, opts)
 		return result, err
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Projects.List(context.Background(), opts)
+		result, _, err := s.client.(*opsmngr.Client).Projects.List(s.ctx, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -83,8 +114,11 @@ func (s *Store) Projects(opts *atlas.ListOptions) (interface{}, error) {
 // GetOrgProjects encapsulates the logic to manage different cloud providers
 func (s *Store) GetOrgProjects(orgID string, opts *atlas.ListOptions) (interface{}, error) {
 	switch s.service {
+	case config.CloudService:
+		result, _, err := s.client.(*atlas.Client).Organizations.Projects(s.ctx, orgID, opts)
+		return result, err
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Organizations.Projects(context.Background(), orgID, opts)
+		result, _, err := s.client.(*opsmngr.Client).Organizations.Projects(s.ctx, orgID, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -95,10 +129,10 @@ func (s *Store) GetOrgProjects(orgID string, opts *atlas.ListOptions) (interface
 func (s *Store) Project(id string) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Projects.GetOneProject(context.Background(), id)
+		result, _, err := s.client.(*atlas.Client).Projects.GetOneProject(s.ctx, id)
 		return result, err
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Projects.Get(context.Background(), id)
+		result, _, err := s.client.(*opsmngr.Client).Projects.Get(s.ctx, id)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -110,11 +144,11 @@ func (s *Store) CreateProject(name, orgID string) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
 		project := &atlas.Project{Name: name, OrgID: orgID}
-		result, _, err := s.client.(*atlas.Client).Projects.Create(context.Background(), project)
+		result, _, err := s.client.(*atlas.Client).Projects.Create(s.ctx, project)
 		return result, err
 	case config.CloudManagerService, config.OpsManagerService:
 		project := &opsmngr.Project{Name: name, OrgID: orgID}
-		result, _, err := s.client.(*opsmngr.Client).Projects.Create(context.Background(), project)
+		result, _, err := s.client.(*opsmngr.Client).Projects.Create(s.ctx, project)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -125,10 +159,10 @@ func (s *Store) CreateProject(name, orgID string) (interface{}, error) {
 func (s *Store) DeleteProject(projectID string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).Projects.Delete(context.Background(), projectID)
+		_, err := s.client.(*atlas.Client).Projects.Delete(s.ctx, projectID)
 		return err
 	case config.CloudManagerService, config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).Projects.Delete(context.Background(), projectID)
+		_, err := s.client.(*opsmngr.Client).Projects.Delete(s.ctx, projectID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -139,10 +173,10 @@ func (s *Store) DeleteProject(projectID string) error {
 func (s *Store) ProjectUsers(projectID string, opts *atlas.ListOptions) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).AtlasUsers.List(context.Background(), projectID, opts)
+		result, _, err := s.client.(*atlas.Client).AtlasUsers.List(s.ctx, projectID, opts)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Projects.ListUsers(context.Background(), projectID, opts)
+		result, _, err := s.client.(*opsmngr.Client).Projects.ListUsers(s.ctx, projectID, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -153,10 +187,10 @@ func (s *Store) ProjectUsers(projectID string, opts *atlas.ListOptions) (interfa
 func (s *Store) DeleteUserFromProject(projectID, userID string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).Projects.RemoveUserFromProject(context.Background(), projectID, userID)
+		_, err := s.client.(*atlas.Client).Projects.RemoveUserFromProject(s.ctx, projectID, userID)
 		return err
 	case config.CloudManagerService, config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).Projects.RemoveUser(context.Background(), projectID, userID)
+		_, err := s.client.(*opsmngr.Client).Projects.RemoveUser(s.ctx, projectID, userID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -167,10 +201,10 @@ func (s *Store) DeleteUserFromProject(projectID, userID string) error {
 func (s *Store) ProjectTeams(projectID string) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Projects.GetProjectTeamsAssigned(context.Background(), projectID)
+		result, _, err := s.client.(*atlas.Client).Projects.GetProjectTeamsAssigned(s.ctx, projectID)
 		return result, err
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Projects.GetTeams(context.Background(), projectID, nil)
+		result, _, err := s.client.(*opsmngr.Client).Projects.GetTeams(s.ctx, projectID, nil)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -181,10 +215,10 @@ func (s *Store) ProjectTeams(projectID string) (interface{}, error) {
 func (s *Store) AddTeamsToProject(projectID string, teams []*atlas.ProjectTeam) (*atlas.TeamsAssigned, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Projects.AddTeamsToProject(context.Background(), projectID, teams)
+		result, _, err := s.client.(*atlas.Client).Projects.AddTeamsToProject(s.ctx, projectID, teams)
 		return result, err
 	case config.CloudManagerService, config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Projects.AddTeamsToProject(context.Background(), projectID, teams)
+		result, _, err := s.client.(*opsmngr.Client).Projects.AddTeamsToProject(s.ctx, projectID, teams)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -195,10 +229,10 @@ func (s *Store) AddTeamsToProject(projectID string, teams []*atlas.ProjectTeam)
 func (s *Store) DeleteTeamFromProject(projectID, teamID string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).Teams.RemoveTeamFromProject(context.Background(), projectID, teamID)
+		_, err := s.client.(*atlas.Client).Teams.RemoveTeamFromProject(s.ctx, projectID, teamID)
 		return err
 	case config.CloudManagerService, config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).Teams.RemoveTeamFromProject(context.Background(), projectID, teamID)
+		_, err := s.client.(*opsmngr.Client).Teams.RemoveTeamFromProject(s.ctx, projectID, teamID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
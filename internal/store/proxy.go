@@ -0,0 +1,86 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// newProxy returns the proxy configured for the profile,
+// falling back to HTTP_PROXY, HTTPS_PROXY and NO_PROXY when none is set.
+func newProxy(c Config) (func(*http.Request) (*url.URL, error), error) {
+	proxy := c.HTTPProxy()
+	if proxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	proxyURL, err := url.Parse(proxy)
+	if err != nil || proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL '%s'", proxy)
+	}
+	noProxy := parseNoProxy(c.NoProxy())
+	return func(req *http.Request) (*url.URL, error) {
+		if noProxy.match(req.URL) {
+			return nil, nil
+		}
+		return proxyURL, nil
+	}, nil
+}
+
+// noProxyList follows the usual NO_PROXY conventions,
+// entries can be "*", host names, domain suffixes (".example.com"), IP addresses or CIDR ranges,
+// optionally followed by a port.
+type noProxyList []string
+
+func parseNoProxy(v string) noProxyList {
+	var entries noProxyList
+	for _, e := range strings.Split(v, ",") {
+		if e = strings.ToLower(strings.TrimSpace(e)); e != "" {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+func (l noProxyList) match(u *url.URL) bool {
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	ip := net.ParseIP(host)
+	for _, e := range l {
+		if e == "*" {
+			return true
+		}
+		if _, ipNet, err := net.ParseCIDR(e); err == nil {
+			if ip != nil && ipNet.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		entryHost, entryPort := e, ""
+		if h, p, err := net.SplitHostPort(e); err == nil {
+			entryHost, entryPort = h, p
+		}
+		if entryPort != "" && entryPort != port {
+			continue
+		}
+		if host == strings.TrimPrefix(entryHost, ".") || strings.HasSuffix(host, "."+strings.TrimPrefix(entryHost, ".")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package store
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestNoProxyList_match(t *testing.T) {
+	l := parseNoProxy("localhost, .example.com,10.0.0.0/8,internal:8080")
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{url: "http://localhost/api", want: true},
+		{url: "https://om.example.com/api", want: true},
+		{url: "https://example.com/api", want: true},
+		{url: "https://notexample.com/api", want: false},
+		{url: "http://10.1.2.3:8080/api", want: true},
+		{url: "http://192.168.1.1/api", want: false},
+		{url: "http://internal:8080/api", want: true},
+		{url: "http://internal:9090/api", want: false},
+	}
+	for _, tt := range tests {
+		rawURL := tt.url
+		want := tt.want
+		t.Run(rawURL, func(t *testing.T) {
+			u, err := url.Parse(rawURL)
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+			if got := l.match(u); got != want {
+				t.Errorf("match() = %v; want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"net/http"
+	"sync"
+)
+
+// requestLog records the API requests sent by the running command,
+// so an interruption can report which ones completed and which ones were cut off.
+type requestLog struct {
+	mu        sync.Mutex
+	completed int
+	canceled  []string
+}
+
+var requests = &requestLog{}
+
+// Requests returns how many requests got a response and the requests canceled before getting one.
+func Requests() (completed int, canceled []string) {
+	requests.mu.Lock()
+	defer requests.mu.Unlock()
+	return requests.completed, append([]string(nil), requests.canceled...)
+}
+
+// logTransport is an http.RoundTripper that records the outcome of every request in the request log.
+type logTransport struct {
+	transport http.RoundTripper
+	log       *requestLog
+}
+
+func withRequestLog(t http.RoundTripper) http.RoundTripper {
+	if t == nil {
+		t = http.DefaultTransport
+	}
+	return &logTransport{transport: t, log: requests}
+}
+
+func (t *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	t.log.mu.Lock()
+	defer t.log.mu.Unlock()
+	switch {
+	case err == nil:
+		t.log.completed++
+	case req.Context().Err() != nil:
+		t.log.canceled = append(t.log.canceled, req.Method+" "+req.URL.Path)
+	}
+	return resp, err
+}
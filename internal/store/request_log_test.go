@@ -0,0 +1,64 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package store
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLogTransport_RoundTrip(t *testing.T) {
+	stall := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/stall" {
+			close(stall)
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	log := &requestLog{}
+	tr := &logTransport{transport: http.DefaultTransport, log: log}
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/done", nil)
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stall
+		cancel()
+	}()
+	req, _ = http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/stall", nil)
+	if _, err := tr.RoundTrip(req); err == nil {
+		t.Fatal("RoundTrip() expected an error for a canceled request")
+	}
+
+	if log.completed != 1 {
+		t.Errorf("completed = %d, want 1", log.completed)
+	}
+	if len(log.canceled) != 1 || log.canceled[0] != "POST /stall" {
+		t.Errorf("canceled = %v, want [POST /stall]", log.canceled)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	retryBaseWait      = 1 * time.Second
+	retryMaxWait       = 30 * time.Second
+	maxRetryAfter      = 1 * time.Minute
+	maxDrainSize       = 4 << 10
+)
+
+// retryTransport is an http.RoundTripper that retries transient failures,
+// such as rate limits, unavailable servers or reset connections,
+// with exponential backoff and full jitter.
+type retryTransport struct {
+	transport     http.RoundTripper
+	maxAttempts   int
+	nonIdempotent bool
+	baseWait      time.Duration
+	maxWait       time.Duration
+	sleep         func(*http.Request, time.Duration) error
+}
+
+// withRetry wraps a transport with the retry policy of the configuration.
+func withRetry(c Config, t http.RoundTripper) http.RoundTripper {
+	maxAttempts := c.RetryMaxAttempts()
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	if maxAttempts == 1 {
+		return t
+	}
+	return &retryTransport{
+		transport:     t,
+		maxAttempts:   maxAttempts,
+		nonIdempotent: c.RetryNonIdempotent(),
+		baseWait:      retryBaseWait,
+		maxWait:       retryMaxWait,
+		sleep:         sleep,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.canRetry(req) {
+		return t.transport.RoundTrip(req)
+	}
+	getBody, err := rewindableBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		r := req.Clone(req.Context())
+		if getBody != nil {
+			if r.Body, err = getBody(); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := t.transport.RoundTrip(r)
+		if attempt >= t.maxAttempts || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				wait = retryAfter
+			}
+			drain(resp)
+		}
+		if err := t.sleep(req, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// canRetry returns true for idempotent methods,
+// POST and PATCH are only retried when explicitly enabled.
+func (t *retryTransport) canRetry(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost, http.MethodPatch:
+		return t.nonIdempotent
+	default:
+		return false
+	}
+}
+
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	wait := t.baseWait << uint(attempt-1)
+	if wait <= 0 || wait > t.maxWait {
+		wait = t.maxWait
+	}
+	return time.Duration(rand.Int63n(int64(wait) + 1)) //nolint:gosec // jitter doesn't need a secure source
+}
+
+// rewindableBody returns a function providing a fresh copy of the request body for every attempt.
+func rewindableBody(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		return req.GetBody, nil
+	}
+	b, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+	return func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(b)), nil
+	}, nil
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return isTransientError(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func isTransientError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// parseRetryAfter parses a Retry-After header in either seconds or HTTP date format.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(v); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(v); err == nil {
+		wait = time.Until(date)
+	} else {
+		return 0, false
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > maxRetryAfter {
+		wait = maxRetryAfter
+	}
+	return wait, true
+}
+
+// drain reads a bit of the body so the connection can be reused, then closes it.
+func drain(resp *http.Response) {
+	if resp.Body == nil {
+		return
+	}
+	_, _ = io.CopyN(ioutil.Discard, resp.Body, maxDrainSize)
+	_ = resp.Body.Close()
+}
+
+// sleep waits for the given duration unless the request is canceled first.
+func sleep(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package store
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRetryTransport(maxAttempts int, nonIdempotent bool, waits *[]time.Duration) *retryTransport {
+	return &retryTransport{
+		transport:     http.DefaultTransport,
+		maxAttempts:   maxAttempts,
+		nonIdempotent: nonIdempotent,
+		baseWait:      time.Millisecond,
+		maxWait:       time.Millisecond,
+		sleep: func(_ *http.Request, d time.Duration) error {
+			*waits = append(*waits, d)
+			return nil
+		},
+	}
+}
+
+func TestRetryTransport_RoundTrip(t *testing.T) {
+	t.Run("retries until success honoring Retry-After", func(t *testing.T) {
+		calls := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.Header().Set("Retry-After", "2")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			b, _ := ioutil.ReadAll(r.Body)
+			_, _ = w.Write(b)
+		}))
+		defer srv.Close()
+
+		var waits []time.Duration
+		tr := testRetryTransport(3, false, &waits)
+		req, _ := http.NewRequest(http.MethodPut, srv.URL, strings.NewReader("body"))
+		resp, err := tr.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip() unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK || string(b) != "body" {
+			t.Errorf("RoundTrip() = %d %s, want 200 body", resp.StatusCode, b)
+		}
+		if calls != 3 {
+			t.Errorf("calls = %d, want 3", calls)
+		}
+		if len(waits) != 2 || waits[0] != 2*time.Second {
+			t.Errorf("waits = %v, want two waits of 2s", waits)
+		}
+	})
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		calls := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		var waits []time.Duration
+		tr := testRetryTransport(2, false, &waits)
+		req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+		resp, err := tr.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip() unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("RoundTrip() = %d, want 503", resp.StatusCode)
+		}
+		if calls != 2 {
+			t.Errorf("calls = %d, want 2", calls)
+		}
+	})
+	t.Run("doesn't retry POST by default", func(t *testing.T) {
+		calls := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		var waits []time.Duration
+		tr := testRetryTransport(3, false, &waits)
+		req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("body"))
+		resp, err := tr.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip() unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1", calls)
+		}
+	})
+	t.Run("doesn't retry client errors", func(t *testing.T) {
+		calls := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer srv.Close()
+
+		var waits []time.Duration
+		tr := testRetryTransport(3, true, &waits)
+		req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("body"))
+		resp, err := tr.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip() unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1", calls)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{value: "", want: 0, wantOK: false},
+		{value: "5", want: 5 * time.Second, wantOK: true},
+		{value: "3600", want: maxRetryAfter, wantOK: true},
+		{value: "Mon, 02 Jan 2006 15:04:05 GMT", want: 0, wantOK: true},
+		{value: "invalid", want: 0, wantOK: false},
+	}
+	for _, tt := range tests {
+		value := tt.value
+		want := tt.want
+		wantOK := tt.wantOK
+		t.Run(value, func(t *testing.T) {
+			got, ok := parseRetryAfter(value)
+			if got != want || ok != wantOK {
+				t.Errorf("parseRetryAfter() = %v, %v; want %v, %v", got, ok, want, wantOK)
+			}
+		})
+	}
+}
@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -49,7 +48,7 @@ type S3BlockstoresDescriber interface {
 func (s *Store) ListS3Blockstores(options *atlas.ListOptions) (*opsmngr.S3Blockstores, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.List(context.Background(), options)
+		result, _, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.List(s.ctx, options)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -60,7 +59,7 @@ func (s *Store) ListS3Blockstores(options *atlas.ListOptions) (*opsmngr.S3Blocks
 func (s *Store) DeleteS3Blockstore(blockstoreID string) error {
 	switch s.service {
 	case config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.Delete(context.Background(), blockstoreID)
+		_, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.Delete(s.ctx, blockstoreID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -71,7 +70,7 @@ func (s *Store) DeleteS3Blockstore(blockstoreID string) error {
 func (s *Store) CreateS3Blockstores(blockstore *opsmngr.S3Blockstore) (*opsmngr.S3Blockstore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.Create(context.Background(), blockstore)
+		result, _, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.Create(s.ctx, blockstore)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -82,7 +81,7 @@ func (s *Store) CreateS3Blockstores(blockstore *opsmngr.S3Blockstore) (*opsmngr.
 func (s *Store) UpdateS3Blockstores(blockstoreID string, blockstore *opsmngr.S3Blockstore) (*opsmngr.S3Blockstore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.Update(context.Background(), blockstoreID, blockstore)
+		result, _, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.Update(s.ctx, blockstoreID, blockstore)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -93,7 +92,7 @@ func (s *Store) UpdateS3Blockstores(blockstoreID string, blockstore *opsmngr.S3B
 func (s *Store) GetS3Blockstore(blockstoreID string) (*opsmngr.S3Blockstore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.Get(context.Background(), blockstoreID)
+		result, _, err := s.client.(*opsmngr.Client).S3BlockstoreConfig.Get(s.ctx, blockstoreID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
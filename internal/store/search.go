@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -48,7 +47,7 @@ type SearchIndexDeleter interface {
 func (s *Store) SearchIndexes(projectID, clusterName, dbName, collName string, opts *atlas.ListOptions) ([]*atlas.SearchIndex, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Search.ListIndexes(context.Background(), projectID, clusterName, dbName, collName, opts)
+		result, _, err := s.client.(*atlas.Client).Search.ListIndexes(s.ctx, projectID, clusterName, dbName, collName, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -59,7 +58,7 @@ func (s *Store) SearchIndexes(projectID, clusterName, dbName, collName string, o
 func (s *Store) CreateSearchIndexes(projectID, clusterName string, index *atlas.SearchIndex) (*atlas.SearchIndex, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Search.CreateIndex(context.Background(), projectID, clusterName, index)
+		result, _, err := s.client.(*atlas.Client).Search.CreateIndex(s.ctx, projectID, clusterName, index)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -70,7 +69,7 @@ func (s *Store) CreateSearchIndexes(projectID, clusterName string, index *atlas.
 func (s *Store) SearchIndex(projectID, clusterName, indexID string) (*atlas.SearchIndex, error) {
 	switch s.service {
 	case config.CloudService:
-		index, _, err := s.client.(*atlas.Client).Search.GetIndex(context.Background(), projectID, clusterName, indexID)
+		index, _, err := s.client.(*atlas.Client).Search.GetIndex(s.ctx, projectID, clusterName, indexID)
 		return index, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -81,7 +80,7 @@ func (s *Store) SearchIndex(projectID, clusterName, indexID string) (*atlas.Sear
 func (s *Store) UpdateSearchIndexes(projectID, clusterName, indexID string, index *atlas.SearchIndex) (*atlas.SearchIndex, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Search.UpdateIndex(context.Background(), projectID, clusterName, indexID, index)
+		result, _, err := s.client.(*atlas.Client).Search.UpdateIndex(s.ctx, projectID, clusterName, indexID, index)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -92,7 +91,7 @@ func (s *Store) UpdateSearchIndexes(projectID, clusterName, indexID string, inde
 func (s *Store) DeleteSearchIndex(projectID, clusterName, indexID string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).Search.DeleteIndex(context.Background(), projectID, clusterName, indexID)
+		_, err := s.client.(*atlas.Client).Search.DeleteIndex(s.ctx, projectID, clusterName, indexID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
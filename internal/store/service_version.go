@@ -0,0 +1,62 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mongodb/mongocli/internal/config"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+//go:generate mockgen -destination=../mocks/mock_service_version.go -package=mocks github.com/mongodb/mongocli/internal/store ServiceVersionGetter
+
+const serviceVersionHeader = "X-MongoDB-Service-Version"
+
+type ServiceVersionGetter interface {
+	ServiceVersion() (string, error)
+}
+
+// ServiceVersion returns the version of Ops Manager, reported by every response of its API
+func (s *Store) ServiceVersion() (string, error) {
+	switch s.service {
+	case config.OpsManagerService:
+		client := s.client.(*opsmngr.Client)
+		req, err := client.NewRequest(s.ctx, http.MethodGet, "", nil)
+		if err != nil {
+			return "", err
+		}
+		resp, err := client.Do(s.ctx, req, nil)
+		if err != nil {
+			return "", err
+		}
+		return parseServiceVersion(resp.Header.Get(serviceVersionHeader))
+	default:
+		return "", fmt.Errorf("unsupported service: %s", s.service)
+	}
+}
+
+// parseServiceVersion returns the version of a header like gitHash=a1b2c3; versionString=4.4.12.100.20210406T1640Z
+func parseServiceVersion(header string) (string, error) {
+	for _, part := range strings.Split(header, ";") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) == 2 && kv[0] == "versionString" && kv[1] != "" {
+			return kv[1], nil
+		}
+	}
+	return "", fmt.Errorf("no version in the %s header", serviceVersionHeader)
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build unit
+
+package store
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mongodb/mongocli/internal/config"
+	"go.mongodb.org/ops-manager/opsmngr"
+)
+
+func TestStore_ServiceVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(serviceVersionHeader, "gitHash=a1b2c3; versionString=4.4.12.100.20210406T1640Z")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	client, err := opsmngr.New(srv.Client(), opsmngr.SetBaseURL(srv.URL+"/"))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	s := &Store{service: config.OpsManagerService, client: client, ctx: context.Background()}
+	got, err := s.ServiceVersion()
+	if err != nil {
+		t.Fatalf("ServiceVersion() unexpected error: %v", err)
+	}
+	if want := "4.4.12.100.20210406T1640Z"; got != want {
+		t.Errorf("ServiceVersion() = %s; want %s", got, want)
+	}
+}
+
+func TestParseServiceVersion(t *testing.T) {
+	if _, err := parseServiceVersion("gitHash=a1b2c3"); err == nil {
+		t.Error("parseServiceVersion() expected an error for a header without version")
+	}
+}
@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -36,7 +35,7 @@ type SnapshotScheduleUpdater interface {
 func (s *Store) GetSnapshotSchedule(projectID, clusterID string) (*opsmngr.SnapshotSchedule, error) {
 	switch s.service {
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).SnapshotSchedule.Get(context.Background(), projectID, clusterID)
+		result, _, err := s.client.(*opsmngr.Client).SnapshotSchedule.Get(s.ctx, projectID, clusterID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -47,7 +46,7 @@ func (s *Store) GetSnapshotSchedule(projectID, clusterID string) (*opsmngr.Snaps
 func (s *Store) UpdateSnapshotSchedule(projectID, clusterID string, snapshotSchedule *opsmngr.SnapshotSchedule) (*opsmngr.SnapshotSchedule, error) {
 	switch s.service {
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).SnapshotSchedule.Update(context.Background(), projectID, clusterID, snapshotSchedule)
+		result, _, err := s.client.(*opsmngr.Client).SnapshotSchedule.Update(s.ctx, projectID, clusterID, snapshotSchedule)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
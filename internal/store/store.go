@@ -15,8 +15,10 @@
 package store
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -47,64 +49,32 @@ const (
 )
 
 type Store struct {
-	service       string
-	baseURL       string
-	caCertificate string
-	skipVerify    string
-	client        interface{}
+	service        string
+	baseURL        string
+	caCertificate  string
+	skipVerify     string
+	client         interface{}
+	downloadClient *http.Client
+	ctx            context.Context
 }
 
-var defaultTransport = &http.Transport{
-	DialContext: (&net.Dialer{
-		Timeout:   timeout,
-		KeepAlive: keepAlive,
-	}).DialContext,
-	MaxIdleConns:          maxIdleConns,
-	MaxIdleConnsPerHost:   maxIdleConnsPerHost,
-	Proxy:                 http.ProxyFromEnvironment,
-	IdleConnTimeout:       idleConnTimeout,
-	ExpectContinueTimeout: expectContinueTimeout,
-}
+// Option configures a Store
+type Option func(s *Store)
 
-func customCATransport(ca []byte) http.RoundTripper {
-	caCertPool := x509.NewCertPool()
-	caCertPool.AppendCertsFromPEM(ca)
-	tlsClientConfig := &tls.Config{ //nolint:gosec // we let users set custom certificates
-		InsecureSkipVerify: false,
-		RootCAs:            caCertPool,
-	}
-	return &http.Transport{
-		ResponseHeaderTimeout: responseHeaderTimeout,
-		TLSHandshakeTimeout:   tlsHandshakeTimeout,
-		DialContext: (&net.Dialer{
-			Timeout:   timeout,
-			KeepAlive: keepAlive,
-		}).DialContext,
-		MaxIdleConns:          maxIdleConns,
-		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
-		Proxy:                 http.ProxyFromEnvironment,
-		IdleConnTimeout:       idleConnTimeout,
-		ExpectContinueTimeout: expectContinueTimeout,
-		TLSClientConfig:       tlsClientConfig,
+// WithContext sets the context used for every request made by the Store,
+// canceling it cancels any in-flight request
+func WithContext(ctx context.Context) Option {
+	return func(s *Store) {
+		s.ctx = ctx
 	}
 }
 
-func skipVerifyTransport() http.RoundTripper {
-	tlsClientConfig := &tls.Config{InsecureSkipVerify: true} //nolint:gosec // this is optional for some users
-	return &http.Transport{
-		ResponseHeaderTimeout: responseHeaderTimeout,
-		TLSHandshakeTimeout:   tlsHandshakeTimeout,
-		DialContext: (&net.Dialer{
-			Timeout:   timeout,
-			KeepAlive: keepAlive,
-		}).DialContext,
-		MaxIdleConns:          maxIdleConns,
-		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
-		Proxy:                 http.ProxyFromEnvironment,
-		IdleConnTimeout:       idleConnTimeout,
-		ExpectContinueTimeout: expectContinueTimeout,
-		TLSClientConfig:       tlsClientConfig,
+func newStore(opts ...Option) *Store {
+	s := &Store{ctx: context.Background()}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 type Config interface {
@@ -114,49 +84,135 @@ type Config interface {
 	OpsManagerURL() string
 	OpsManagerCACertificate() string
 	OpsManagerSkipVerify() string
+	OpsManagerClientCertificate() string
+	OpsManagerClientCertificateKey() string
 	OpsManagerVersionManifestURL() string
+	RequestTimeout() time.Duration
+	HTTPProxy() string
+	NoProxy() string
+	Debug() bool
+	TraceFile() string
+	RetryMaxAttempts() int
+	RetryNonIdempotent() bool
 }
 
-func authenticatedClient(c Config) (*http.Client, error) {
-	t := &digest.Transport{
-		Username: c.PublicAPIKey(),
-		Password: c.PrivateAPIKey(),
+// newTransport returns a transport with the timeouts, proxy and TLS settings of the profile
+func newTransport(c Config) (*http.Transport, error) {
+	tlsClientConfig, err := newTLSConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := newProxy(c)
+	if err != nil {
+		return nil, err
+	}
+	headerTimeout := responseHeaderTimeout
+	if requestTimeout := c.RequestTimeout(); requestTimeout > 0 {
+		headerTimeout = requestTimeout
 	}
+	return &http.Transport{
+		ResponseHeaderTimeout: headerTimeout,
+		TLSHandshakeTimeout:   tlsHandshakeTimeout,
+		DialContext: (&net.Dialer{
+			Timeout:   timeout,
+			KeepAlive: keepAlive,
+		}).DialContext,
+		MaxIdleConns:          maxIdleConns,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		Proxy:                 proxy,
+		IdleConnTimeout:       idleConnTimeout,
+		ExpectContinueTimeout: expectContinueTimeout,
+		TLSClientConfig:       tlsClientConfig,
+	}, nil
+}
+
+// newTLSConfig returns the TLS settings for custom certificate authorities,
+// client certificates (mTLS) or skipping verification, nil if none are set
+func newTLSConfig(c Config) (*tls.Config, error) {
+	var tlsClientConfig *tls.Config
 	if caCertificate := c.OpsManagerCACertificate(); caCertificate != "" {
 		dat, err := ioutil.ReadFile(caCertificate)
 		if err != nil {
 			return nil, err
 		}
-		t.Transport = customCATransport(dat)
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(dat)
+		tlsClientConfig = &tls.Config{ //nolint:gosec // we let users set custom certificates
+			InsecureSkipVerify: false,
+			RootCAs:            caCertPool,
+		}
 	} else if skipVerify := c.OpsManagerSkipVerify(); skipVerify == yes {
-		t.Transport = skipVerifyTransport()
-	} else {
-		t.Transport = http.DefaultTransport
+		tlsClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // this is optional for some users
 	}
 
-	return t.Client()
+	clientCertificate := c.OpsManagerClientCertificate()
+	clientCertificateKey := c.OpsManagerClientCertificateKey()
+	if clientCertificate == "" && clientCertificateKey == "" {
+		return tlsClientConfig, nil
+	}
+	if clientCertificate == "" || clientCertificateKey == "" {
+		return nil, errors.New("both a client certificate and its key are required for mutual TLS")
+	}
+	cert, err := tls.LoadX509KeyPair(clientCertificate, clientCertificateKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not load client certificate: %w", err)
+	}
+	if tlsClientConfig == nil {
+		tlsClientConfig = &tls.Config{} //nolint:gosec // we rely on the default minimum version
+	}
+	tlsClientConfig.Certificates = []tls.Certificate{cert}
+	return tlsClientConfig, nil
+}
+
+func authenticatedClient(c Config) (*http.Client, error) {
+	tr, err := newTransport(c)
+	if err != nil {
+		return nil, err
+	}
+	return digestClient(c, withRequestTimeout(c, tr))
+}
+
+// authenticatedDownloadClient returns an authenticated client without the request timeout,
+// downloads are only bounded by the context of the Store as they can take longer than any single request
+func authenticatedDownloadClient(c Config) (*http.Client, error) {
+	tr, err := newTransport(c)
+	if err != nil {
+		return nil, err
+	}
+	return digestClient(c, tr)
+}
+
+func digestClient(c Config, tr http.RoundTripper) (*http.Client, error) {
+	t := &digest.Transport{
+		Username:  c.PublicAPIKey(),
+		Password:  c.PrivateAPIKey(),
+		Transport: withDebug(c, tr),
+	}
+
+	client, err := t.Client()
+	if err != nil {
+		return nil, err
+	}
+	client.Transport = withRequestLog(withRetry(c, client.Transport))
+
+	return client, nil
 }
 
 func defaultClient(c Config) (*http.Client, error) {
-	client := http.DefaultClient
-	if caCertificate := c.OpsManagerCACertificate(); caCertificate != "" {
-		dat, err := ioutil.ReadFile(caCertificate)
-		if err != nil {
-			return nil, err
-		}
-		client.Transport = customCATransport(dat)
-	} else if skipVerify := c.OpsManagerSkipVerify(); skipVerify == yes {
-		client.Transport = skipVerifyTransport()
-	} else {
-		client.Transport = defaultTransport
+	tr, err := newTransport(c)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{
+		Transport: withRequestLog(withRetry(c, withDebug(c, withRequestTimeout(c, tr)))),
 	}
 
 	return client, nil
 }
 
 // New get the appropriate client for the profile/service selected
-func New(c Config) (*Store, error) {
-	s := new(Store)
+func New(c Config, opts ...Option) (*Store, error) {
+	s := newStore(opts...)
 	s.service = c.Service()
 
 	if configURL := c.OpsManagerURL(); configURL != "" {
@@ -172,6 +228,9 @@ func New(c Config) (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.downloadClient, err = authenticatedDownloadClient(c); err != nil {
+		return nil, err
+	}
 
 	switch s.service {
 	case config.CloudService:
@@ -186,8 +245,8 @@ func New(c Config) (*Store, error) {
 }
 
 // NewUnauthenticated a client to interact with the Ops Manager APIs that don't require authentication
-func NewUnauthenticated(c Config) (*Store, error) {
-	s := new(Store)
+func NewUnauthenticated(c Config, opts ...Option) (*Store, error) {
+	s := newStore(opts...)
 	s.service = c.Service()
 
 	if s.service != config.OpsManagerService {
@@ -213,8 +272,8 @@ func NewUnauthenticated(c Config) (*Store, error) {
 }
 
 // NewVersionManifest ets the appropriate client for the manifest version page
-func NewVersionManifest(c Config) (*Store, error) {
-	s := new(Store)
+func NewVersionManifest(c Config, opts ...Option) (*Store, error) {
+	s := newStore(opts...)
 	s.service = c.Service()
 	if s.service != config.OpsManagerService {
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -238,8 +297,8 @@ func NewVersionManifest(c Config) (*Store, error) {
 }
 
 // NewPrivateUnauth gets the appropriate client for the atlas private api
-func NewPrivateUnauth(c Config) (*Store, error) {
-	s := new(Store)
+func NewPrivateUnauth(c Config, opts ...Option) (*Store, error) {
+	s := newStore(opts...)
 	s.service = c.Service()
 	if s.service != config.CloudService {
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -263,8 +322,8 @@ func NewPrivateUnauth(c Config) (*Store, error) {
 }
 
 // NewPrivate gets the appropriate client for the atlas private api with authentication
-func NewPrivate(c Config) (*Store, error) {
-	s := new(Store)
+func NewPrivate(c Config, opts ...Option) (*Store, error) {
+	s := newStore(opts...)
 	s.service = c.Service()
 	if s.service != config.CloudService {
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -17,8 +17,11 @@
 package store
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"go.mongodb.org/atlas/mongodbatlas"
 	"go.mongodb.org/ops-manager/opsmngr"
@@ -44,3 +47,68 @@ func TestStore_apiPath(t *testing.T) {
 		}
 	})
 }
+
+type testConfig struct {
+	Config
+	caCertificate        string
+	skipVerify           string
+	clientCertificate    string
+	clientCertificateKey string
+	requestTimeout       time.Duration
+	proxy                string
+	noProxy              string
+}
+
+func (c *testConfig) OpsManagerCACertificate() string        { return c.caCertificate }
+func (c *testConfig) OpsManagerSkipVerify() string           { return c.skipVerify }
+func (c *testConfig) OpsManagerClientCertificate() string    { return c.clientCertificate }
+func (c *testConfig) OpsManagerClientCertificateKey() string { return c.clientCertificateKey }
+func (c *testConfig) RequestTimeout() time.Duration          { return c.requestTimeout }
+func (c *testConfig) HTTPProxy() string                      { return c.proxy }
+func (c *testConfig) NoProxy() string                        { return c.noProxy }
+
+func TestNewTransport(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		tr, err := newTransport(&testConfig{})
+		if err != nil {
+			t.Fatalf("newTransport() unexpected error: %v", err)
+		}
+		if tr.ResponseHeaderTimeout != responseHeaderTimeout {
+			t.Errorf("ResponseHeaderTimeout = %v; want %v", tr.ResponseHeaderTimeout, responseHeaderTimeout)
+		}
+		if tr.TLSClientConfig != nil {
+			t.Errorf("TLSClientConfig = %v; want nil", tr.TLSClientConfig)
+		}
+	})
+	t.Run("request timeout and skip verify", func(t *testing.T) {
+		tr, err := newTransport(&testConfig{requestTimeout: 10 * time.Second, skipVerify: yes})
+		if err != nil {
+			t.Fatalf("newTransport() unexpected error: %v", err)
+		}
+		if tr.ResponseHeaderTimeout != 10*time.Second {
+			t.Errorf("ResponseHeaderTimeout = %v; want 10s", tr.ResponseHeaderTimeout)
+		}
+		if tr.TLSClientConfig == nil || !tr.TLSClientConfig.InsecureSkipVerify {
+			t.Errorf("TLSClientConfig = %v; want InsecureSkipVerify", tr.TLSClientConfig)
+		}
+	})
+	t.Run("client certificate without key", func(t *testing.T) {
+		if _, err := newTransport(&testConfig{clientCertificate: "cert.pem"}); err == nil {
+			t.Error("newTransport() expected an error but got none")
+		}
+	})
+	t.Run("explicit proxy", func(t *testing.T) {
+		tr, err := newTransport(&testConfig{proxy: "http://proxy.example.com:3128", noProxy: "localhost,.internal.example.com"})
+		if err != nil {
+			t.Fatalf("newTransport() unexpected error: %v", err)
+		}
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.mongodb.com/api", nil)
+		if u, _ := tr.Proxy(req); u == nil || u.Host != "proxy.example.com:3128" {
+			t.Errorf("Proxy() = %v; want proxy.example.com:3128", u)
+		}
+		req = httptest.NewRequest(http.MethodGet, "https://om.internal.example.com/api", nil)
+		if u, _ := tr.Proxy(req); u != nil {
+			t.Errorf("Proxy() = %v; want no proxy", u)
+		}
+	})
+}
@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -49,7 +48,7 @@ type SyncsDeleter interface {
 func (s *Store) ListSyncs(options *atlas.ListOptions) (*opsmngr.BackupStores, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).SyncStoreConfig.List(context.Background(), options)
+		result, _, err := s.client.(*opsmngr.Client).SyncStoreConfig.List(s.ctx, options)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -60,7 +59,7 @@ func (s *Store) ListSyncs(options *atlas.ListOptions) (*opsmngr.BackupStores, er
 func (s *Store) GetSync(syncID string) (*opsmngr.BackupStore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).SyncStoreConfig.Get(context.Background(), syncID)
+		result, _, err := s.client.(*opsmngr.Client).SyncStoreConfig.Get(s.ctx, syncID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -71,7 +70,7 @@ func (s *Store) GetSync(syncID string) (*opsmngr.BackupStore, error) {
 func (s *Store) CreateSync(sync *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).SyncStoreConfig.Create(context.Background(), sync)
+		result, _, err := s.client.(*opsmngr.Client).SyncStoreConfig.Create(s.ctx, sync)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -82,7 +81,7 @@ func (s *Store) CreateSync(sync *opsmngr.BackupStore) (*opsmngr.BackupStore, err
 func (s *Store) UpdateSync(syncID string, sync *opsmngr.BackupStore) (*opsmngr.BackupStore, error) {
 	switch s.service {
 	case config.OpsManagerService:
-		result, _, err := s.client.(*opsmngr.Client).SyncStoreConfig.Update(context.Background(), syncID, sync)
+		result, _, err := s.client.(*opsmngr.Client).SyncStoreConfig.Update(s.ctx, syncID, sync)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -93,7 +92,7 @@ func (s *Store) UpdateSync(syncID string, sync *opsmngr.BackupStore) (*opsmngr.B
 func (s *Store) DeleteSync(syncID string) error {
 	switch s.service {
 	case config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).SyncStoreConfig.Delete(context.Background(), syncID)
+		_, err := s.client.(*opsmngr.Client).SyncStoreConfig.Delete(s.ctx, syncID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -65,10 +64,10 @@ func (s *Store) CreateUser(user *UserRequest) (interface{}, error) {
 			Password:     user.Password,
 			Country:      user.Country,
 		}
-		result, _, err := s.client.(*atlas.Client).AtlasUsers.Create(context.Background(), atlasUser)
+		result, _, err := s.client.(*atlas.Client).AtlasUsers.Create(s.ctx, atlasUser)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Users.Create(context.Background(), user.User)
+		result, _, err := s.client.(*opsmngr.Client).Users.Create(s.ctx, user.User)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -79,10 +78,10 @@ func (s *Store) CreateUser(user *UserRequest) (interface{}, error) {
 func (s *Store) UserByID(userID string) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).AtlasUsers.Get(context.Background(), userID)
+		result, _, err := s.client.(*atlas.Client).AtlasUsers.Get(s.ctx, userID)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Users.Get(context.Background(), userID)
+		result, _, err := s.client.(*opsmngr.Client).Users.Get(s.ctx, userID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -93,10 +92,10 @@ func (s *Store) UserByID(userID string) (interface{}, error) {
 func (s *Store) UserByName(username string) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).AtlasUsers.GetByName(context.Background(), username)
+		result, _, err := s.client.(*atlas.Client).AtlasUsers.GetByName(s.ctx, username)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Users.GetByName(context.Background(), username)
+		result, _, err := s.client.(*opsmngr.Client).Users.GetByName(s.ctx, username)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -107,7 +106,7 @@ func (s *Store) UserByName(username string) (interface{}, error) {
 func (s *Store) DeleteUser(userID string) error {
 	switch s.service {
 	case config.OpsManagerService:
-		_, err := s.client.(*opsmngr.Client).Users.Delete(context.Background(), userID)
+		_, err := s.client.(*opsmngr.Client).Users.Delete(s.ctx, userID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -118,10 +117,10 @@ func (s *Store) DeleteUser(userID string) error {
 func (s *Store) OrganizationUsers(organizationID string, opts *atlas.ListOptions) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Organizations.Users(context.Background(), organizationID, opts)
+		result, _, err := s.client.(*atlas.Client).Organizations.Users(s.ctx, organizationID, opts)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Organizations.ListUsers(context.Background(), organizationID, opts)
+		result, _, err := s.client.(*opsmngr.Client).Organizations.ListUsers(s.ctx, organizationID, opts)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -132,10 +131,10 @@ func (s *Store) OrganizationUsers(organizationID string, opts *atlas.ListOptions
 func (s *Store) TeamUsers(orgID, teamID string) (interface{}, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).Teams.GetTeamUsersAssigned(context.Background(), orgID, teamID)
+		result, _, err := s.client.(*atlas.Client).Teams.GetTeamUsersAssigned(s.ctx, orgID, teamID)
 		return result, err
 	case config.OpsManagerService, config.CloudManagerService:
-		result, _, err := s.client.(*opsmngr.Client).Teams.GetTeamUsersAssigned(context.Background(), orgID, teamID)
+		result, _, err := s.client.(*opsmngr.Client).Teams.GetTeamUsersAssigned(s.ctx, orgID, teamID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
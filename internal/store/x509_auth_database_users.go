@@ -15,7 +15,6 @@
 package store
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/mongodb/mongocli/internal/config"
@@ -46,7 +45,7 @@ type X509CertificateStore interface {
 func (s *Store) X509Configuration(projectID string) (*atlas.CustomerX509, error) {
 	switch s.service {
 	case config.CloudService:
-		result, _, err := s.client.(*atlas.Client).X509AuthDBUsers.GetCurrentX509Conf(context.Background(), projectID)
+		result, _, err := s.client.(*atlas.Client).X509AuthDBUsers.GetCurrentX509Conf(s.ctx, projectID)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -58,7 +57,7 @@ func (s *Store) SaveX509Configuration(projectID, certificate string) (*atlas.Cus
 	switch s.service {
 	case config.CloudService:
 		userCertificate := &atlas.CustomerX509{Cas: certificate}
-		result, _, err := s.client.(*atlas.Client).X509AuthDBUsers.SaveConfiguration(context.Background(), projectID, userCertificate)
+		result, _, err := s.client.(*atlas.Client).X509AuthDBUsers.SaveConfiguration(s.ctx, projectID, userCertificate)
 		return result, err
 	default:
 		return nil, fmt.Errorf("unsupported service: %s", s.service)
@@ -69,7 +68,7 @@ func (s *Store) SaveX509Configuration(projectID, certificate string) (*atlas.Cus
 func (s *Store) DisableX509Configuration(projectID string) error {
 	switch s.service {
 	case config.CloudService:
-		_, err := s.client.(*atlas.Client).X509AuthDBUsers.DisableCustomerX509(context.Background(), projectID)
+		_, err := s.client.(*atlas.Client).X509AuthDBUsers.DisableCustomerX509(s.ctx, projectID)
 		return err
 	default:
 		return fmt.Errorf("unsupported service: %s", s.service)
@@ -17,7 +17,18 @@ package usage
 const (
 	ProjectID                       = "Project ID to use. Overrides configuration file or environment variable settings."
 	OrgID                           = "Organization ID to use. Overrides configuration file or environment variable settings."
+	AllProjects                     = "Run the command for every project of the organization, results are tagged with their project."
+	ProjectNamePattern              = "Only run the command for projects with a name matching this pattern, for example prod-*. Requires --allProjects."
+	ProjectTag                      = "Only run the command for projects with this tag. Requires --allProjects. Cloud Manager and Ops Manager only."
+	InventoryProjectNamePattern     = "Only include projects with a name matching this pattern, for example prod-*."
+	InventoryProjectTag             = "Only include projects with this tag. Cloud Manager and Ops Manager only."
+	InventoryDiff                   = "JSON file with a previous inventory, saved with --output json, to list what changed since."
 	Profile                         = "Profile to use from your configuration file."
+	Debug                           = "Log HTTP requests and responses to stderr, with credentials redacted. You can also set MCLI_DEBUG."
+	Timeout                         = "Maximum time the command can run for, for example 30s or 5m. In-flight requests are canceled when it expires."
+	WatchInterval                   = "Time to wait between the first checks, for example 10s. Defaults to 4s and doubles after each check up to 30s."
+	WatchState                      = "State to wait for, for example IDLE, PAUSED or DELETED."
+	TraceFile                       = "File where a HAR-like JSON trace of all HTTP requests and responses is written, with credentials redacted. You can also set MCLI_TRACE_FILE."
 	Members                         = "Number of members in the replica set."
 	Shards                          = "Number of shards in the cluster."
 	ProcessName                     = "The unique identifier for the host of a MongoDB process in the following format: {hostname}:{port}."
@@ -40,7 +51,7 @@ const (
 	Forever                         = "Acknowledge an alert “forever”."
 	Status                          = "Alert's status."
 	Until                           = "Acknowledged until a date."
-	ConnectionStringType            = "When set to 'private' retrieves the Network-peering-endpoint-aware connection string."
+	ConnectionStringType            = "Type of connection string: standard or srv for the SRV one, nonSrv for the one listing the hosts, private for network peering, privateEndpoint or privateEndpointSrv."
 	Limit                           = "Number of items per page."
 	Username                        = "Username of the user."
 	BackupStatus                    = "Current (or desired) status of the backup configuration."
@@ -83,6 +94,31 @@ const (
 	Force                           = "Don't ask for confirmation."
 	ForceFile                       = "Overwrite the destination file."
 	Email                           = "User’s email address."
+	RestoreWait                     = "Wait for the restore job to complete."
+	KeepDaily                       = "Number of most recent days for which to keep the newest on-demand snapshot."
+	KeepWeekly                      = "Number of most recent weeks for which to keep the newest on-demand snapshot."
+	KeepMonthly                     = "Number of most recent months for which to keep the newest on-demand snapshot."
+	PruneDryRun                     = "Print which snapshots would be kept or deleted without deleting any."
+	MaxSnapshotAge                  = "Age after which a cluster without a newer snapshot is reported as a violation, for example 24h."
+	MinRetentionDays                = "Number of days under which the longest snapshot retention of a cluster is reported as a violation."
+	BackupPolicyFile                = "Name of the YAML or JSON file with the backup policy of the clusters of the project."
+	BackupInfrastructureFile        = "Name of the YAML or JSON file with the backup infrastructure, as exported by the export command."
+	BackupInfrastructureOut         = "Optional output filename, if none given the document is printed."
+	UpgradeTo                       = "MongoDB version to upgrade to, a release series like 4.4 upgrades to its latest version."
+	UpgradeManifest                 = "Ops Manager version of the published version manifest to validate the target version against, by default the manifest of the running Ops Manager. The versions available to the project are used when it can't be fetched."
+	AccessListFile                  = "Name of the YAML or JSON file with the IP access list entries of the project."
+	AccessListPrune                 = "Delete the entries of the project that are not in the file."
+	CurrentIPTTL                    = "Duration after which Atlas removes the entry, like 4h, at most 168h. If none given the entry doesn't expire."
+	CredentialOut                   = "File where the new credential is saved as JSON, readable only by you. If none given the password is printed."
+	CredentialHelper                = "Executable that stores the new credential, it runs with the store argument and gets the username, database and password as key=value lines on its standard input."
+	OMDBUsersDeleteAfter            = "Timestamp in ISO 8601 date and time format in UTC after which the expire command deletes the user. The expiry is tracked on this machine."
+	ExpireDryRun                    = "Print the expired users without deleting them."
+	DBUsersFile                     = "Name of the YAML or JSON file with the database users of the project."
+	DBUsersPrune                    = "Delete the database users of the project that are not in the file."
+	DBRolesFile                     = "Name of the YAML or JSON file with the custom database roles of the project."
+	DBRolesPrune                    = "Delete the custom database roles of the project that are not in the file."
+	ApplyDryRun                     = "Print the changes without applying them."
+	RestoreOut                      = "File where the archive of a download restore is saved, it implies --wait. Use a directory for sharded clusters, which have an archive per shard."
 	LogOut                          = "Optional output filename, if none given will use the log name."
 	DiagnoseOut                     = "Optional output filename, if none given will use diagnose-archive.tar.gz."
 	LogStart                        = "Beginning of the period for which to retrieve logs as UNIX Epoch time."
@@ -175,6 +211,25 @@ const (
 	Database                        = "Database name."
 	DatabaseUser                    = "Username of a database user."
 	MonthsUntilExpiration           = "Number of months that the certificate is valid for."
+	CertOut                         = "Directory where the certificate and private key are saved as <username>.pem, readable only by you. If none given the certificate is printed."
+	CertRenewOut                    = "Directory where the renewed certificates are saved as <username>.pem, readable only by you."
+	CertExpiringWithin              = "Only users without a certificate valid past this period, like 30d or 72h. Without a username, every user with Atlas-managed certificates is checked."
+	CertRenewExpiringWithin         = "Renew the certificates of the users whose newest certificate expires within this period, like 30d or 72h."
+	Shell                           = "MongoDB shell to connect with, mongosh or mongo."
+	ConnectType                     = "Type of connection string to connect with: standard or srv for the SRV one, nonSrv, private, privateEndpoint or privateEndpointSrv."
+	CredentialFile                  = "File with the credential of the database user, as saved by rotatePassword --out."
+	ConnectCredentialHelper         = "Executable that returns the password of the database user when run with get, like the one given to rotatePassword."
+	ConnectionStringDBUser          = "Username of the database user added to the connection string."
+	ConnectionStringPasswordEnv     = "Environment variable with the password of the database user added to the connection string."
+	ConnectionStringRetryWrites     = "Value of the retryWrites option of the connection string."
+	ConnectionStringWriteConcern    = "Value of the w option of the connection string, like majority or 1."
+	ConnectionStringReadPreference  = "Value of the readPreference option of the connection string, like primary or secondaryPreferred."
+	ConnectionStringAuthSource      = "Value of the authSource option of the connection string."
+	ConnectionStringTLS             = "Value of the tls option of the connection string, it replaces the ssl option."
+	ConnectionStringFormat          = "Format of the connection string: uri, env for a .env file or kubernetesSecret for a Kubernetes Secret manifest."
+	LDAPFile                        = "Name of the YAML or JSON file with the LDAP configuration. A bind password set to ${VARIABLE} is read from that environment variable."
+	LDAPApplyDryRun                 = "Verify the LDAP configuration without saving it."
+	LDAPApplyForce                  = "Save the LDAP configuration even when its verification fails."
 	Collection                      = "Collection name."
 	Append                          = "The input action and inheritedRoles will be appended to the existing role."
 	PrivilegeAction                 = "List of actions per database and collection, if no database or collections is provided then cluster is assumed"
@@ -238,6 +293,17 @@ const (
 	ReadToken                       = "Your Insights Query Key."
 	RouteTableCidrBlock             = "Peer VPC CIDR block or subnet."
 	VpcID                           = "Unique identifier of the peer VPC."
+	SetupRegion                     = "AWS region of your VPC, like us-east-1."
+	SetupVpcID                      = "Unique identifier of your AWS VPC."
+	SubnetIDs                       = "Unique identifiers of the subnets of your VPC where the interface endpoint is created."
+	SecurityGroupIDs                = "Unique identifiers of the security groups of the interface endpoint, the VPC default security group when none are given."
+	SetupPrivateEndpointID          = "Unique identifier of the interface endpoint created in your VPC, it's added to the private endpoint service once created."
+	RouteTableID                    = "Unique identifier of the route table of your VPC that routes traffic to Atlas."
+	AzureSetupRegion                = "Atlas region of your Azure VNet, like US_EAST_2."
+	AzureSubnet                     = "Name of the subnet of your Azure VNet where the private endpoint is created."
+	AccessGranted                   = "Create the peering connection, once Atlas was granted access to your VNet with the commands printed without this flag."
+	AzureSetupPrivateEndpointID     = "Resource ID of the private endpoint created in your VNet, it's added to the private endpoint service along with its IP address."
+	ProjectTemplate                 = "Name of the YAML or JSON file with the teams, custom roles, database users, access list, maintenance window, integrations and alerts the project is created with."
 	AtlasCIDRBlock                  = "CIDR block that Atlas uses for your clusters."
 	VNet                            = "Name of your Azure VNet."
 	ResourceGroup                   = "Name of your Azure resource group."
@@ -253,6 +319,8 @@ const (
 	ContainerRegions                = "List of Atlas regions where the container resides."
 	FormatOut                       = `Output format.
 Valid values: json|go-template|go-template-file`
+	ReportFormatOut = `Output format.
+Valid values: json|csv|go-template|go-template-file`
 	TargetClusterID = `Unique identifier of the target cluster.
 For use only with automated restore jobs.`
 	TargetClusterName = `Name of the target cluster.
@@ -265,6 +333,9 @@ When paired with oplogInc, they represent the point in time to which your data w
 When paired with oplogTs, they represent the point in time to which your data will be restored.`
 	PointInTimeUTCMillis = `Timestamp in the number of milliseconds that have elapsed since the UNIX epoch that represents the point in time to which your data will be restored.
 This timestamp must be within last 24 hours of the current time.`
+	RestoreAt = `Point in time to which your data will be restored, either in RFC3339 format, for example 2021-04-01T10:15:00Z,
+or relative to the current time, for example -30m or -2h. It is checked against the restorable window of the cluster before the restore starts.
+If you set at, you cannot set oplogInc, oplogTs or pointInTimeUTCMillis.`
 	Expires = `Timestamp in ISO 8601 date and time format after which the URL is no longer available.
 For use only with download restore jobs.`
 	ExpirationHours = `Number of hours the download URL is valid once the restore job is complete.
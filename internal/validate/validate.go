@@ -18,7 +18,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mongodb/mongocli/internal/config"
 	"github.com/mongodb/mongocli/internal/search"
@@ -88,6 +91,47 @@ func ObjectID(s string) error {
 	return nil
 }
 
+// Bool validates a value is either true or false
+func Bool(s string) error {
+	if _, err := strconv.ParseBool(s); err != nil {
+		return fmt.Errorf("the provided value '%s' is not a valid boolean", s)
+	}
+	return nil
+}
+
+// PositiveInt validates a value is an integer greater than zero
+func PositiveInt(s string) error {
+	if v, err := strconv.Atoi(s); err != nil || v <= 0 {
+		return fmt.Errorf("the provided value '%s' is not a positive integer", s)
+	}
+	return nil
+}
+
+// Duration validates a value is a positive duration, such as 30s or 2m
+func Duration(s string) error {
+	if v, err := time.ParseDuration(s); err != nil || v <= 0 {
+		return fmt.Errorf("the provided value '%s' is not a valid duration", s)
+	}
+	return nil
+}
+
+// Path validates a value is an existing file
+func Path(s string) error {
+	if _, err := os.Stat(s); err != nil {
+		return fmt.Errorf("the provided value '%s' is not a valid file: %w", s, err)
+	}
+	return nil
+}
+
+// ProxyURL validates a value is a valid proxy URL, such as http://proxy.example.com:3128
+func ProxyURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5") {
+		return fmt.Errorf("'%s' is not a valid proxy URL", s)
+	}
+	return nil
+}
+
 // Credentials validates public and private API keys have been set
 func Credentials() error {
 	if config.PrivateAPIKey() == "" || config.PublicAPIKey() == "" {